@@ -0,0 +1,169 @@
+package main
+
+import (
+	"fmt"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// checkTypes loads the packages containing the registered types and the leaf types, and makes
+// sure all of them exist and are exported. If serializable_output is set, the registered types must
+// be in one package, whose name is recorded in cfg.typesPackageName.
+func checkTypes(cfg *Config) error {
+	path2names := make(map[string][]string)
+	for _, tc := range cfg.Types {
+		pkgPath, name, _ := splitTypePath(tc.Type)
+		path2names[pkgPath] = append(path2names[pkgPath], name)
+	}
+	if len(cfg.SerializableOutput) != 0 && len(path2names) > 1 {
+		return fmt.Errorf("serializable_output needs all the registered types to be in one package")
+	}
+	typesPkgPath, _, _ := splitTypePath(cfg.Types[0].Type)
+	for leafType := range cfg.LeafTypes {
+		pkgPath, name, _ := splitTypePath(leafType)
+		path2names[pkgPath] = append(path2names[pkgPath], name)
+	}
+	pkgPaths := make([]string, 0, len(path2names))
+	for pkgPath := range path2names {
+		pkgPaths = append(pkgPaths, pkgPath)
+	}
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedName | packages.NeedTypes}, pkgPaths...)
+	if err != nil {
+		return err
+	}
+	if packages.PrintErrors(pkgs) > 0 {
+		return fmt.Errorf("failed to load packages")
+	}
+	for _, pkg := range pkgs {
+		if pkg.PkgPath == typesPkgPath {
+			cfg.typesPackageName = pkg.Name
+		}
+		for _, name := range path2names[pkg.PkgPath] {
+			obj := pkg.Types.Scope().Lookup(name)
+			if obj == nil {
+				return fmt.Errorf("cannot find type %s.%s", pkg.PkgPath, name)
+			}
+			if _, ok := obj.(*types.TypeName); !ok {
+				return fmt.Errorf("%s.%s is not a type", pkg.PkgPath, name)
+			}
+			if !obj.Exported() {
+				return fmt.Errorf("type %s.%s is not exported", pkg.PkgPath, name)
+			}
+		}
+	}
+	return nil
+}
+
+func quoteMap(m map[string]string) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var sb strings.Builder
+	sb.WriteString("map[string]string{\n")
+	for _, k := range keys {
+		sb.WriteString(fmt.Sprintf("\t\t%s: %s,\n", strconv.Quote(k), strconv.Quote(m[k])))
+	}
+	sb.WriteString("\t}")
+	return sb.String()
+}
+
+// genBootstrap returns the source code of a throwaway program, which imports the packages of the
+// registered types and calls codon's generator functions with their runtime type information.
+func genBootstrap(cfg *Config) string {
+	path2pkg := make(map[string]string)
+	pkgPaths := make([]string, 0, len(cfg.Types))
+	for _, tc := range cfg.Types {
+		pkgPath, _, _ := splitTypePath(tc.Type)
+		if _, ok := path2pkg[pkgPath]; !ok {
+			path2pkg[pkgPath] = ""
+			pkgPaths = append(pkgPaths, pkgPath)
+		}
+	}
+	sort.Strings(pkgPaths)
+	var sb strings.Builder
+	sb.WriteString("// Code generated by codongen. DO NOT EDIT.\n\npackage main\n\nimport (\n")
+	sb.WriteString("\t\"bytes\"\n\t\"fmt\"\n\t\"io/ioutil\"\n\t\"os\"\n\n\t\"github.com/coinexchain/codon\"\n")
+	for i, pkgPath := range pkgPaths {
+		path2pkg[pkgPath] = fmt.Sprintf("p%d", i)
+		sb.WriteString(fmt.Sprintf("\tp%d %s\n", i, strconv.Quote(pkgPath)))
+	}
+	sb.WriteString(")\n\n")
+
+	sb.WriteString("func main() {\n")
+	sb.WriteString("\tleafTypes := " + quoteMap(cfg.LeafTypes) + "\n")
+	sb.WriteString("\tignoreImpl := " + quoteMap(cfg.IgnoreImpl) + "\n")
+	sb.WriteString("\tentries := []codon.TypeEntry{\n")
+	for _, tc := range cfg.Types {
+		pkgPath, name, _ := splitTypePath(tc.Type)
+		// derefPtr in codon removes the pointer, so new(T) works for structs, interfaces and other named types
+		sb.WriteString(fmt.Sprintf("\t\t{Alias: %s, Name: %s, Value: new(%s.%s), MagicNum: %d},\n",
+			strconv.Quote(tc.Alias), strconv.Quote(tc.Name), path2pkg[pkgPath], name, tc.MagicNum))
+	}
+	sb.WriteString("\t}\n")
+	sb.WriteString("\textraImports := []string{\n")
+	for _, imp := range cfg.ExtraImports {
+		sb.WriteString(fmt.Sprintf("\t\t%s,\n", strconv.Quote(imp)))
+	}
+	sb.WriteString("\t}\n")
+	if cfg.BridgeLogic {
+		sb.WriteString("\textraImports = append(extraImports, codon.ImportsForBridgeLogic...)\n")
+		sb.WriteString("\textraLogics := codon.BridgeLogic\n")
+	} else {
+		sb.WriteString("\textraLogics := \"\"\n")
+	}
+	sb.WriteString(fmt.Sprintf("\topts := codon.GeneratorOptions{SkipUnknownFields: %v, KeepUnrecognized: %v, AminoCompatible: %v, TwosComplementInts: %v}\n",
+		cfg.SkipUnknownFields, cfg.KeepUnrecognized, cfg.AminoCompatible, cfg.TwosComplementInts))
+	sb.WriteString(fmt.Sprintf("\topts.PackageName = %s\n", strconv.Quote(cfg.PackageName)))
+	sb.WriteString(fmt.Sprintf("\topts.BuildTag = %s\n", strconv.Quote(cfg.BuildTag)))
+	sb.WriteString(fmt.Sprintf("\topts.Banner = %s\n", strconv.Quote(cfg.Banner)))
+	sb.WriteString(fmt.Sprintf("\topts.LockFile = %s\n", strconv.Quote(cfg.LockFile)))
+
+	// all the generated code is buffered, such that no file is written if the generator panics
+	sb.WriteString("\tvar codecBuf bytes.Buffer\n")
+	if len(cfg.FuzzTestOutput) != 0 {
+		sb.WriteString("\tvar fuzzBuf bytes.Buffer\n")
+		sb.WriteString("\topts.FuzzTestWriter = &fuzzBuf\n")
+	}
+	sb.WriteString("\tcheck(codon.GenerateCodecFileWithOptions(&codecBuf, opts, leafTypes, ignoreImpl, entries, extraLogics, extraImports))\n")
+	sb.WriteString(fmt.Sprintf("\twriteFile(%s, &codecBuf)\n", strconv.Quote(cfg.Output)))
+	if len(cfg.FuzzTestOutput) != 0 {
+		sb.WriteString(fmt.Sprintf("\twriteFile(%s, &fuzzBuf)\n", strconv.Quote(cfg.FuzzTestOutput)))
+	}
+	if len(cfg.SerializableOutput) != 0 {
+		// the serializable methods are declared in the package of the types
+		sb.WriteString("\tvar serializableBuf bytes.Buffer\n")
+		sb.WriteString("\tserializableOpts := opts\n")
+		sb.WriteString(fmt.Sprintf("\tserializableOpts.PackageName = %s\n", strconv.Quote(cfg.typesPackageName)))
+		sb.WriteString("\tcheck(codon.GenerateSerializableImplWithOptions(&serializableBuf, serializableOpts, leafTypes, ignoreImpl, entries, extraLogics, extraImports))\n")
+		sb.WriteString(fmt.Sprintf("\twriteFile(%s, &serializableBuf)\n", strconv.Quote(cfg.SerializableOutput)))
+	}
+	if len(cfg.ProtoOutput) != 0 {
+		// DumpProtoFile prints to stdout
+		sb.WriteString(fmt.Sprintf("\tf, err := os.Create(%s)\n", strconv.Quote(cfg.ProtoOutput)))
+		sb.WriteString("\tcheck(err)\n")
+		sb.WriteString("\tstdout := os.Stdout\n\tos.Stdout = f\n")
+		sb.WriteString("\terr = codon.DumpProtoFileWithOptions(opts, leafTypes, ignoreImpl, entries)\n")
+		sb.WriteString("\tos.Stdout = stdout\n\tf.Close()\n")
+		sb.WriteString("\tcheck(err)\n")
+	}
+	sb.WriteString("}\n\n")
+
+	sb.WriteString(`func writeFile(fname string, buf *bytes.Buffer) {
+	check(ioutil.WriteFile(fname, buf.Bytes(), 0644))
+}
+
+func check(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+`)
+	return sb.String()
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v2"
+)
+
+// Config describes one run of codongen. It can be written in YAML, TOML or JSON,
+// and the format is decided by the file's extension.
+type Config struct {
+	// The path of the generated codec file
+	Output string `json:"output" yaml:"output" toml:"output"`
+	// The path of the file generated by GenerateSerializableImpl, optional
+	SerializableOutput string `json:"serializable_output" yaml:"serializable_output" toml:"serializable_output"`
+	// The path of the .proto file dumped by DumpProtoFile, optional
+	ProtoOutput string `json:"proto_output" yaml:"proto_output" toml:"proto_output"`
+	// The path of the generated fuzz test file, optional
+	FuzzTestOutput string `json:"fuzz_test_output" yaml:"fuzz_test_output" toml:"fuzz_test_output"`
+	// The path of the lock file which records the magic numbers, optional
+	LockFile string `json:"lock_file" yaml:"lock_file" toml:"lock_file"`
+
+	// The package name of the codec file and the fuzz test file, "codec" by default. The file of
+	// GenerateSerializableImpl always uses the package name of the registered types, because the
+	// methods must be declared in the types' own package.
+	PackageName string `json:"package_name" yaml:"package_name" toml:"package_name"`
+	// A "//go:build" constraint for the generated files, optional
+	BuildTag string `json:"build_tag" yaml:"build_tag" toml:"build_tag"`
+	// The "Code generated ... DO NOT EDIT." comment at the beginning of the generated files, optional
+	Banner string `json:"banner" yaml:"banner" toml:"banner"`
+
+	SkipUnknownFields bool `json:"skip_unknown_fields" yaml:"skip_unknown_fields" toml:"skip_unknown_fields"`
+	KeepUnrecognized  bool `json:"keep_unrecognized" yaml:"keep_unrecognized" toml:"keep_unrecognized"`
+	// If it is true, go-amino's prefix bytes calculated from the types' names are used instead of the magic numbers
+	AminoCompatible bool `json:"amino_compatible" yaml:"amino_compatible" toml:"amino_compatible"`
+	// If it is true, the signed integers are written as two's complement varints (int32/int64 in the .proto file)
+	// instead of zigzag varints (sint32/sint64)
+	TwosComplementInts bool `json:"twos_complement_ints" yaml:"twos_complement_ints" toml:"twos_complement_ints"`
+
+	// If it is true, codon.BridgeLogic and codon.ImportsForBridgeLogic are put in the generated codec file
+	BridgeLogic bool `json:"bridge_logic" yaml:"bridge_logic" toml:"bridge_logic"`
+	// extra imported packages to put in the generated code, such as `"fmt"` or `sdk "github.com/cosmos/cosmos-sdk/types"`
+	ExtraImports []string `json:"extra_imports" yaml:"extra_imports" toml:"extra_imports"`
+
+	// The types for which we will generate code
+	Types []TypeConfig `json:"types" yaml:"types" toml:"types"`
+	// Key is the full type name, Value is the short type name
+	LeafTypes map[string]string `json:"leaf_types" yaml:"leaf_types" toml:"leaf_types"`
+	// Key is struct's alias and Value is interface's alias
+	IgnoreImpl map[string]string `json:"ignore_impl" yaml:"ignore_impl" toml:"ignore_impl"`
+
+	// The package name of the registered types, which is set by checkTypes
+	typesPackageName string
+}
+
+// TypeConfig is turned into a codon.TypeEntry
+type TypeConfig struct {
+	// The full type name, such as "github.com/cosmos/cosmos-sdk/x/bank.MsgSend"
+	Type string `json:"type" yaml:"type" toml:"type"`
+	// The alias used in the generated code, by default it is the short type name
+	Alias string `json:"alias" yaml:"alias" toml:"alias"`
+	// The name used to calculate the magic number, by default it is the alias
+	Name string `json:"name" yaml:"name" toml:"name"`
+	// The explicit magic number, optional
+	MagicNum uint32 `json:"magic_num" yaml:"magic_num" toml:"magic_num"`
+}
+
+// splits a full type name into the package path and the short type name
+func splitTypePath(typePath string) (string, string, error) {
+	pos := strings.LastIndex(typePath, ".")
+	if pos <= 0 || pos == len(typePath)-1 || strings.LastIndex(typePath, "/") > pos {
+		return "", "", fmt.Errorf("invalid full type name '%s'", typePath)
+	}
+	return typePath[:pos], typePath[pos+1:], nil
+}
+
+func loadConfig(fname string) (*Config, error) {
+	bz, err := ioutil.ReadFile(fname)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &Config{}
+	switch ext := strings.ToLower(filepath.Ext(fname)); ext {
+	case ".yaml", ".yml":
+		err = yaml.UnmarshalStrict(bz, cfg)
+	case ".toml":
+		_, err = toml.Decode(string(bz), cfg)
+	case ".json":
+		err = json.Unmarshal(bz, cfg)
+	default:
+		return nil, fmt.Errorf("unknown config format '%s', it must be .yaml, .yml, .toml or .json", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse %s: %v", fname, err)
+	}
+	if len(cfg.Output) == 0 {
+		return nil, fmt.Errorf("%s: output is not specified", fname)
+	}
+	if len(cfg.Types) == 0 {
+		return nil, fmt.Errorf("%s: no types are specified", fname)
+	}
+	aliases := make(map[string]string, len(cfg.Types))
+	for i := range cfg.Types {
+		tc := &cfg.Types[i]
+		_, name, err := splitTypePath(tc.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", fname, err)
+		}
+		if len(tc.Alias) == 0 {
+			tc.Alias = name
+		}
+		if len(tc.Name) == 0 {
+			tc.Name = tc.Alias
+		}
+		if other, ok := aliases[tc.Alias]; ok {
+			return nil, fmt.Errorf("%s: alias %s is used by both %s and %s", fname, tc.Alias, other, tc.Type)
+		}
+		aliases[tc.Alias] = tc.Type
+	}
+	for leafType := range cfg.LeafTypes {
+		if _, _, err := splitTypePath(leafType); err != nil {
+			return nil, fmt.Errorf("%s: %v", fname, err)
+		}
+	}
+	for structAlias, ifcAlias := range cfg.IgnoreImpl {
+		for _, alias := range []string{structAlias, ifcAlias} {
+			if _, ok := aliases[alias]; !ok {
+				return nil, fmt.Errorf("%s: alias %s in ignore_impl is not registered", fname, alias)
+			}
+		}
+	}
+	if cfg.AminoCompatible && len(cfg.ProtoOutput) != 0 {
+		return nil, fmt.Errorf("%s: proto_output can not be used with amino_compatible", fname)
+	}
+	// the output paths are relative to the config file
+	dir := filepath.Dir(fname)
+	for _, p := range []*string{&cfg.Output, &cfg.SerializableOutput, &cfg.ProtoOutput, &cfg.FuzzTestOutput, &cfg.LockFile} {
+		if len(*p) == 0 {
+			continue
+		}
+		if !filepath.IsAbs(*p) {
+			*p = filepath.Join(dir, *p)
+		}
+		if *p, err = filepath.Abs(*p); err != nil {
+			return nil, err
+		}
+	}
+	return cfg, nil
+}
@@ -0,0 +1,66 @@
+// Command codongen generates the codec source code according to a config file, without any glue code.
+//
+// It must be run inside the module which contains (or requires) the packages of the registered types,
+// because by default it builds and runs a throwaway program importing these packages and codon:
+//
+//	go run github.com/coinexchain/codon/cmd/codongen -config codongen.yaml
+//
+// With the -source flag, the types are analyzed from the source code with go/types instead,
+// and no throwaway program is needed.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+func main() {
+	configFile := flag.String("config", "codongen.yaml", "the config file in YAML, TOML or JSON format")
+	keep := flag.Bool("keep", false, "keep the directory of the throwaway program for debugging")
+	fromSource := flag.Bool("source", false, "analyze the types from the source code instead of using reflection")
+	flag.Parse()
+
+	if err := run(*configFile, *keep, *fromSource); err != nil {
+		fmt.Fprintln(os.Stderr, "codongen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(configFile string, keep, fromSource bool) error {
+	cfg, err := loadConfig(configFile)
+	if err != nil {
+		return err
+	}
+	if err = checkTypes(cfg); err != nil {
+		return err
+	}
+	if fromSource {
+		return generateFromSource(cfg)
+	}
+
+	// The throwaway program must be inside the current module, such that it can import the packages
+	dir, err := ioutil.TempDir(".", "codongen_bootstrap_")
+	if err != nil {
+		return err
+	}
+	if keep {
+		fmt.Fprintln(os.Stderr, "codongen: the throwaway program is kept in", dir)
+	} else {
+		defer os.RemoveAll(dir)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte(genBootstrap(cfg)), 0644)
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command("go", "run", "./"+filepath.Base(dir))
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err = cmd.Run(); err != nil {
+		return fmt.Errorf("failed to run the generator: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+
+	"github.com/coinexchain/codon"
+	"github.com/coinexchain/codon/source"
+)
+
+// generateFromSource analyzes the registered types with the source-based front end,
+// and calls codon's generator functions directly, without a throwaway program
+func generateFromSource(cfg *Config) error {
+	entries := make([]source.Entry, len(cfg.Types))
+	for i, tc := range cfg.Types {
+		entries[i] = source.Entry{Alias: tc.Alias, Name: tc.Name, Type: tc.Type, MagicNum: tc.MagicNum}
+	}
+	typeEntries, err := source.Load("", entries)
+	if err != nil {
+		return err
+	}
+
+	extraImports := cfg.ExtraImports
+	extraLogics := ""
+	if cfg.BridgeLogic {
+		extraImports = append(extraImports, codon.ImportsForBridgeLogic...)
+		extraLogics = codon.BridgeLogic
+	}
+	opts := codon.GeneratorOptions{
+		SkipUnknownFields:  cfg.SkipUnknownFields,
+		KeepUnrecognized:   cfg.KeepUnrecognized,
+		AminoCompatible:    cfg.AminoCompatible,
+		TwosComplementInts: cfg.TwosComplementInts,
+		PackageName:        cfg.PackageName,
+		BuildTag:           cfg.BuildTag,
+		Banner:             cfg.Banner,
+		LockFile:           cfg.LockFile,
+	}
+	// all the generated code is buffered, such that no file is written if the generator panics
+	var codecBuf, fuzzBuf, serializableBuf bytes.Buffer
+	if len(cfg.FuzzTestOutput) != 0 {
+		opts.FuzzTestWriter = &fuzzBuf
+	}
+	err = codon.GenerateCodecFileWithOptions(&codecBuf, opts, cfg.LeafTypes, cfg.IgnoreImpl, typeEntries, extraLogics, extraImports)
+	if err != nil {
+		return err
+	}
+	if len(cfg.SerializableOutput) != 0 {
+		// the serializable methods are declared in the package of the types
+		serializableOpts := opts
+		serializableOpts.PackageName = cfg.typesPackageName
+		err = codon.GenerateSerializableImplWithOptions(&serializableBuf, serializableOpts, cfg.LeafTypes, cfg.IgnoreImpl, typeEntries, extraLogics, extraImports)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = ioutil.WriteFile(cfg.Output, codecBuf.Bytes(), 0644); err != nil {
+		return err
+	}
+	if len(cfg.FuzzTestOutput) != 0 {
+		if err = ioutil.WriteFile(cfg.FuzzTestOutput, fuzzBuf.Bytes(), 0644); err != nil {
+			return err
+		}
+	}
+	if len(cfg.SerializableOutput) != 0 {
+		if err = ioutil.WriteFile(cfg.SerializableOutput, serializableBuf.Bytes(), 0644); err != nil {
+			return err
+		}
+	}
+	if len(cfg.ProtoOutput) != 0 {
+		return dumpProto(cfg, opts, typeEntries)
+	}
+	return nil
+}
+
+// DumpProtoFile prints to stdout, so stdout is redirected to the output file
+func dumpProto(cfg *Config, opts codon.GeneratorOptions, typeEntries []codon.TypeEntry) error {
+	f, err := os.Create(cfg.ProtoOutput)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	stdout := os.Stdout
+	os.Stdout = f
+	defer func() {
+		os.Stdout = stdout
+	}()
+	return codon.DumpProtoFileWithOptions(opts, cfg.LeafTypes, cfg.IgnoreImpl, typeEntries)
+}
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -16,6 +18,27 @@ const (
 	MinMagicNum = 20000
 )
 
+// the packages imported by the generated code
+var defaultImports = []string{`"bufio"`, `"bytes"`, `"encoding/base64"`, `"encoding/binary"`, `"encoding/json"`,
+	`"errors"`, `"fmt"`, `"hash"`, `"io"`, `"math"`, `"reflect"`, `"sort"`, `"strconv"`, `"unsafe"`}
+
+// Writes the import declaration. extraImports may contain the default ones, such as "io" in
+// ImportsForBridgeLogic. If code is not empty, only the default packages which it refers to are imported.
+func writeImports(w io.Writer, extraImports []string, code string) {
+	w.Write([]byte("import (\n"))
+	imported := make(map[string]bool)
+	for i, p := range append(extraImports, defaultImports...) {
+		name := strings.Trim(p, `"`)
+		name = name[strings.LastIndex(name, "/")+1:]
+		used := len(code) == 0 || i < len(extraImports) || regexp.MustCompile(`\b`+name+`\.`).MatchString(code)
+		if !imported[p] && used {
+			imported[p] = true
+			w.Write([]byte(p + "\n"))
+		}
+	}
+	w.Write([]byte(")\n"))
+}
+
 func calcMagicNum(lines []string) uint32 {
 	h := sha256.New()
 	for _, line := range lines {
@@ -31,22 +54,143 @@ func calcMagicNum(lines []string) uint32 {
 	return uint32(val64)
 }
 
+// Returns go-amino's prefix bytes of a name registered with RegisterConcrete, as a big-endian number.
+// The sha256 hash of the name, after skipping the leading zero bytes, begins with three disambiguation
+// bytes. After them and the following zero bytes, the next four bytes are the prefix bytes.
+func calcAminoPrefix(name string) uint32 {
+	bz := sha256.Sum256([]byte(name))
+	hash := bz[:]
+	for hash[0] == 0x00 {
+		hash = hash[1:]
+	}
+	hash = hash[3:]
+	for hash[0] == 0x00 {
+		hash = hash[1:]
+	}
+	return binary.BigEndian.Uint32(hash[:4])
+}
+
+// Returns the field numbers of a struct's fields. A field number can be pinned with a struct tag
+// like `codon:"5"` or `protobuf:"bytes,5,opt,name=foo"`, otherwise it is the field's position plus one.
+// XXX_unrecognized is not counted, and its number is left as zero.
+func getFieldNums(t Type) []int {
+	nums := make([]int, t.NumField())
+	num2name := make(map[int]string, t.NumField())
+	pos := 0
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if isUnrecognizedField(field) {
+			continue
+		}
+		pos++
+		nums[i] = pos
+		if tag, ok := field.Tag.Lookup("codon"); ok {
+			n, err := strconv.Atoi(tag)
+			if err != nil {
+				panic(fmt.Sprintf("Invalid codon tag '%s' of %s.%s", tag, t.Name(), field.Name))
+			}
+			nums[i] = n
+		} else if tag, ok := field.Tag.Lookup("protobuf"); ok {
+			found := false
+			for _, part := range strings.Split(tag, ",") {
+				if n, err := strconv.Atoi(part); err == nil {
+					nums[i] = n
+					found = true
+					break
+				}
+			}
+			if !found {
+				panic(fmt.Sprintf("Cannot find field number in protobuf tag '%s' of %s.%s", tag, t.Name(), field.Name))
+			}
+		}
+		if nums[i] <= 0 || nums[i] > MaxFieldNum {
+			panic(fmt.Sprintf("Field number %d of %s.%s is out of range", nums[i], t.Name(), field.Name))
+		}
+		if other, ok := num2name[nums[i]]; ok {
+			panic(fmt.Sprintf("Field number %d is used by both %s.%s and %s.%s", nums[i], t.Name(), other, t.Name(), field.Name))
+		}
+		num2name[nums[i]] = field.Name
+	}
+	return nums
+}
+
 type TypeEntry struct {
 	Alias string
-	Name string
+	Name  string
 	Value interface{}
+	// If it is not nil, it is used instead of Value's runtime type. The source-based front end sets it.
+	Type Type
+	// If it is not zero, it is used instead of the magic number calculated from Alias and Name,
+	// such that the encoded bytes do not change when the type is renamed
+	MagicNum uint32
+}
+
+func (entry TypeEntry) getType() Type {
+	if entry.Type != nil {
+		return entry.Type
+	}
+	return derefPtr(entry.Value)
 }
 
 func writeLines(w io.Writer, lines []string) {
+	sb, _ := w.(*sourceBuffer)
 	for _, line := range lines {
 		if len(line) == 0 {
 			continue
 		}
+		if strings.Contains(line, markPrefix) {
+			if strings.HasPrefix(line, markPrefix) && !strings.Contains(line, "\n") {
+				if sb != nil {
+					sb.mark(line)
+				}
+			} else { // the marks are inside a multi-line expression
+				writeLines(w, strings.Split(line, "\n"))
+			}
+			continue
+		}
 		w.Write([]byte(line))
 		w.Write([]byte("\n"))
 	}
 }
 
+// The member with this name keeps the unknown fields skipped during decoding, it must be a byte slice
+const UnrecognizedFieldName = "XXX_unrecognized"
+
+// Options for the generated code
+type GeneratorOptions struct {
+	// If it is true, the decoders skip unknown fields according to their wire types,
+	// instead of returning "Unknown Field" errors
+	SkipUnknownFields bool
+	// If it is true, the skipped unknown fields are appended to the XXX_unrecognized member (if any)
+	// of the decoded struct, and they are written back unchanged when the struct is encoded again
+	KeepUnrecognized bool
+	// If it is not nil, a test file (usually named codec_fuzz_test.go) is written to it, which contains
+	// a native Go fuzzing target FuzzDecode<Alias> for each registered type
+	FuzzTestWriter io.Writer
+	// The package name of the generated files, DefaultPackageName if empty
+	PackageName string
+	// If it is not empty, it is written as a "//go:build" constraint, such as "!nocodon"
+	BuildTag string
+	// The comment at the beginning of the generated files, DefaultBanner if empty. It should match
+	// the "Code generated ... DO NOT EDIT." convention, such that tools can recognize generated files
+	Banner string
+	// If it is not empty, it is the path of the lock file (usually named codon.lock) which records the
+	// magic numbers. The generation fails if a locked number would change or a new one collides with
+	// a locked one. After a successful generation, the new types' numbers are added to the file.
+	LockFile string
+	// If it is true, the registered types are prefixed with go-amino's prefix bytes, which are calculated
+	// from TypeEntry.Name (the name passed to amino's RegisterConcrete), instead of the magic numbers.
+	// EncodeAny writes the four prefix bytes followed by the struct, and an interface member's content is
+	// also the prefix bytes followed by the struct, just like amino does. So the data written by amino
+	// can be decoded. TypeEntry.MagicNum and the lock file hold the prefix bytes as a big-endian number.
+	AminoCompatible bool
+	// If it is true, the signed integers are written as two's complement varints, which are declared as
+	// int32/int64 in the dumped .proto file, and a negative number takes ten bytes. By default, they are
+	// written as zigzag varints, which are declared as sint32/sint64. Unlike go-amino, which writes int8 and
+	// int16 as zigzag varints, it also applies to int8 and int16.
+	TwosComplementInts bool
+}
+
 func GenerateCodecFile(
 	//output target
 	w io.Writer,
@@ -61,124 +205,296 @@ func GenerateCodecFile(
 	// extra logics to put in the generated code
 	extraLogics string,
 	// extra imported packages to put in the generated code
-	extraImports []string) {
+	extraImports []string) error {
+	return GenerateCodecFileWithOptions(w, GeneratorOptions{}, leafTypes, ignoreImpl, typeEntryList, extraLogics, extraImports)
+}
+
+// The generated code is formatted with go/format before being written to w. If it has syntax errors,
+// nothing is written and the returned error tells which type and field produced the wrong code.
+// If the registered types have unsupported fields or other problems, nothing is written either,
+// and all of the problems are returned as GenerateErrors.
+
+func GenerateCodecFileWithOptions(
+	//output target
+	w io.Writer,
+	// options for the generated code
+	opts GeneratorOptions,
+	// contains the types which should be regarded as leaf types
+	// Key is the full type name, Value is the short type name
+	leafTypes map[string]string,
+	// Some struct->interface implementation relationship must be ignored
+	// Key is struct's alias and Value is interface's alias
+	ignoreImpl map[string]string,
+	// The types for which we will generate code
+	typeEntryList []TypeEntry,
+	// extra logics to put in the generated code
+	extraLogics string,
+	// extra imported packages to put in the generated code
+	extraImports []string) error {
 
 	// The beginning of the generated file
-	w.Write([]byte("//nolint\npackage codec\nimport (\n"))
-	for _, p := range extraImports {
-		w.Write([]byte(p + "\n"))
-	}
-	w.Write([]byte("\"encoding/binary\"\n\"errors\"\n)\n"))
+	out := w
+	w = &sourceBuffer{}
+	opts.writeHeader(w)
+	writeImports(w, extraImports, "")
 	w.Write([]byte(headerLogics))
-	w.Write([]byte(extraLogics))
+	w.Write([]byte(errorLogics))
+	w.Write([]byte(jsonLogics))
+	w.Write([]byte(streamLogics))
+	writeLines(w, []string{beginMark("the extra logics"), extraLogics, endMark()})
 
 	// Now initialize the context
 	ctx := newContext(leafTypes, ignoreImpl)
+	ctx.opts = opts
 	for _, entry := range typeEntryList {
-		ctx.register(entry.Alias, entry.Name, entry.Value)
+		ctx.guard(entry.Alias, "", func() {
+			ctx.register(entry.Alias, entry.Name, entry.getType(), entry.MagicNum)
+		})
 	}
 	ctx.analyzeIfc()
+	var lock map[string]lockEntry
+	if len(opts.LockFile) != 0 {
+		oldLock, err := readLockFile(opts.LockFile)
+		if err != nil {
+			return err
+		}
+		lock = ctx.checkLock(oldLock)
+	}
 
 	// Generate functions for structs
 	for _, entry := range typeEntryList {
-		t := derefPtr(entry.Value)
+		t := entry.getType()
 		if t.Kind() != reflect.Interface {
 			w.Write([]byte("// Non-Interface\n"))
-			lines := ctx.generateStructFunc(entry.Alias, t)
+			var lines []string
+			ctx.guard(entry.Alias, "", func() {
+				lines = ctx.generateStructFunc(entry.Alias, t)
+				lines = append(lines, ctx.generateStructJSONFunc(entry.Alias, t)...)
+				lines = append(lines, ctx.generateDecodeEachFunc(entry.Alias, t)...)
+			})
+			writeLines(w, []string{beginMark("type " + entry.Alias)})
 			writeLines(w, lines)
+			writeLines(w, []string{endMark()})
 		}
 	}
 	// Generate functions for interfaces
 	for _, entry := range typeEntryList {
-		t := derefPtr(entry.Value)
+		t := entry.getType()
 		if t.Kind() == reflect.Interface {
 			w.Write([]byte("// Interface\n"))
-			lines := ctx.generateIfcFunc(entry.Alias, t)
+			var lines []string
+			ctx.guard(entry.Alias, "", func() {
+				lines = ctx.generateIfcFunc(entry.Alias, t)
+				lines = append(lines, ctx.generateIfcJSONFunc(entry.Alias, t)...)
+			})
+			writeLines(w, []string{beginMark("interface " + entry.Alias)})
 			writeLines(w, lines)
+			writeLines(w, []string{endMark()})
 		}
 	}
-	// Generate the "getMagicNum" and "getMagicNumOfVar" functions, which maps aliases to magic bytes
-	lines := ctx.generateMagicNumFunc()
-	writeLines(w, lines)
+	// The top-level functions, which support all the registered types
+	ctx.guard("Any", "", func() {
+		// Generate the "getMagicNum" and "getMagicNumOfVar" functions, which maps aliases to magic bytes
+		lines := ctx.generateMagicNumFunc()
+		writeLines(w, lines)
 
-	// Get sorted list of struct aliases
-	aliases := make([]string, 0, len(ctx.structPath2Alias))
-	for _, alias := range ctx.structPath2Alias {
-		aliases = append(aliases, alias)
+		// Get sorted list of struct aliases
+		aliases := make([]string, 0, len(ctx.structPath2Alias))
+		for _, alias := range ctx.structPath2Alias {
+			aliases = append(aliases, alias)
+		}
+		sort.Strings(aliases)
+		// Top-level encode function, which supports all the registered types. It writes magic bytes at the beginning
+		lines = ctx.generateIfcEncodeFunc("Any", aliases)
+		writeLines(w, lines)
+		// Top-level size function, which returns the number of bytes written by EncodeAny
+		lines = ctx.generateIfcSizeFunc("Any", aliases)
+		writeLines(w, lines)
+		// Top-level decode function, which supports all the registered types. It uses magic bytes to decide type
+		lines = ctx.generateDecodeAnyFunc()
+		writeLines(w, lines)
+		// Top-level JSON functions. The registered types are wrapped with their names
+		lines = ctx.generateIfcJSONEncodeFunc("Any", aliases, "wrap")
+		writeLines(w, lines)
+		lines = ctx.generateIfcJSONDecodeFunc("Any", "interface{}", nil, aliases)
+		writeLines(w, lines)
+		lines = ctx.generateDecodeJSONPtrFunc(aliases, ctx.ifcAliases())
+		writeLines(w, lines)
+		// Assign structs to interfaces' pointers
+		lines = ctx.generateIfcAssignFunc()
+		writeLines(w, lines)
+		// Fill an interface object, randomly select the underlying struct type and randomly fill the fields
+		lines, aliases = ctx.generateIfcRandFunc("RandAny", "interface{}", nil, aliases, nil)
+		writeLines(w, lines)
+		// DeepCopy an interface object
+		lines = ctx.generateIfcDeepCopyFunc("DeepCopyAny", "interface{}", nil, aliases)
+		writeLines(w, lines)
+		// Compare two interface objects by what their encoded bytes can tell apart
+		lines = ctx.generateIfcEqualFunc("EqualAny", "interface{}", nil, aliases)
+		writeLines(w, lines)
+		// Generate a GetSupportList function which returns the sorted full path list of all the supported types
+		lines = ctx.generateSupportListFunc()
+		writeLines(w, lines)
+		// Generate a RoundTripSelfTest function which checks the generated code with random values
+		lines = generateRoundTripFunc(typeEntryList)
+		writeLines(w, lines)
+	})
+	if err := ctx.err(); err != nil {
+		return err
 	}
-	sort.Strings(aliases)
-	// Top-level encode function, which supports all the registered types. It writes magic bytes at the beginning
-	lines = generateIfcEncodeFunc("EncodeAny", aliases)
-	writeLines(w, lines)
-	// Top-level decode function, which supports all the registered types. It uses magic bytes to decide type
-	lines = ctx.generateDecodeAnyFunc()
-	writeLines(w, lines)
-	// Assign structs to interfaces' pointers
-	lines = ctx.generateIfcAssignFunc()
-	writeLines(w, lines)
-	// Fill an interface object, randomly select the underlying struct type and randomly fill the fields
-	lines, aliases = ctx.generateIfcRandFunc("RandAny", "interface{}", nil, aliases, nil)
-	writeLines(w, lines)
-	// DeepCopy an interface object
-	lines = ctx.generateIfcDeepCopyFunc("DeepCopyAny", "interface{}", nil, aliases)
-	writeLines(w, lines)
-	// Generate a GetSupportList function which returns the sorted full path list of all the supported types
-	lines = ctx.generateSupportListFunc()
-	writeLines(w, lines)
+
+	if err := w.(*sourceBuffer).writeTo(out); err != nil {
+		return err
+	}
+	if opts.FuzzTestWriter != nil {
+		if err := generateFuzzTestFile(opts.FuzzTestWriter, opts, typeEntryList); err != nil {
+			return err
+		}
+	}
+	if lock != nil {
+		return writeLockFile(opts.LockFile, lock)
+	}
+	return nil
+}
+
+var roundTripTemplate = `
+for i := 0; i < count; i++ {
+	v := RandAAA(r)
+	buf := make([]byte, 0, 64)
+	EncodeAAA(&buf, v)
+	if len(buf) != SizeAAA(v) {
+		return fmt.Errorf("AAA: size is %d but encoded %d bytes", SizeAAA(v), len(buf))
+	}
+	res, n, err := DecodeAAA(buf)
+	if err != nil {
+		return fmt.Errorf("AAA: %v", err)
+	}
+	if n != len(buf) {
+		return fmt.Errorf("AAA: decoded %d bytes out of %d", n, len(buf))
+	}
+	buf2 := make([]byte, 0, 64)
+	EncodeAAA(&buf2, res)
+	if !bytes.Equal(buf, buf2) {
+		return errors.New("AAA: mismatch after round trip")
+	}
+	if !EqualAAA(v, res) {
+		return errors.New("AAA: value changed after round trip")
+	}
+}`
+
+// For every registered type, RoundTripSelfTest fills a random value and runs Encode->Decode->Encode,
+// then compares the two encoded results. Any field-number mismatch between encoding and decoding
+// would be caught by it.
+func generateRoundTripFunc(typeEntryList []TypeEntry) []string {
+	lines := make([]string, 0, len(typeEntryList)+3)
+	lines = append(lines, "func RoundTripSelfTest(r RandSrc, count int) error {")
+	for _, entry := range typeEntryList {
+		lines = append(lines, strings.Replace(roundTripTemplate, "AAA", entry.Alias, -1))
+	}
+	lines = append(lines, "return nil")
+	lines = append(lines, "} // end of RoundTripSelfTest")
+	return lines
 }
 
 type context struct {
 	structPath2Alias map[string]string
 	ifcPath2Alias    map[string]string
-	structPath2Type  map[string]reflect.Type
-	structAlias2Type map[string]reflect.Type
-	ifcPath2Type     map[string]reflect.Type
+	structPath2Type  map[string]Type
+	structAlias2Type map[string]Type
+	ifcPath2Type     map[string]Type
 
 	// map an interface to its implementations
 	ifcPath2StructPaths map[string][]string
 
 	structAlias2MagicNum map[string]uint32
 	magicNum2StructAlias map[uint32]string
+	// the names in TypeEntry, which are used in JSON
+	structAlias2Name map[string]string
 
 	leafTypes  map[string]string
 	ignoreImpl map[string]string
+
+	opts GeneratorOptions
+
+	// the problems found during generation
+	diagnostics
 }
 
 func newContext(leafTypes, ignoreImpl map[string]string) *context {
 	return &context{
 		structPath2Alias: make(map[string]string),
 		ifcPath2Alias:    make(map[string]string),
-		structPath2Type:  make(map[string]reflect.Type),
-		structAlias2Type: make(map[string]reflect.Type),
-		ifcPath2Type:     make(map[string]reflect.Type),
+		structPath2Type:  make(map[string]Type),
+		structAlias2Type: make(map[string]Type),
+		ifcPath2Type:     make(map[string]Type),
 
-		ifcPath2StructPaths:    make(map[string][]string),
-		structAlias2MagicNum:   make(map[string]uint32),
-		magicNum2StructAlias:   make(map[uint32]string),
-		leafTypes:              leafTypes,
-		ignoreImpl:             ignoreImpl,
+		ifcPath2StructPaths:  make(map[string][]string),
+		structAlias2MagicNum: make(map[string]uint32),
+		magicNum2StructAlias: make(map[uint32]string),
+		structAlias2Name:     make(map[string]string),
+		leafTypes:            leafTypes,
+		ignoreImpl:           ignoreImpl,
+	}
+}
+
+// The magic number is written as the tag of a length-delimited field, so its size is known when generating.
+// The amino prefix bytes are not followed by a length.
+func (ctx *context) generateIfcSizeFunc(name string, aliases []string) []string {
+	lines := make([]string, 0, 1000)
+	lines = append(lines, fmt.Sprintf("func Size%s(x interface{}) int {", name))
+	lines = append(lines, fmt.Sprintf("return size%s(x, nil)", name))
+	lines = append(lines, "}")
+	lines = append(lines, fmt.Sprintf("func size%s(x interface{}, s *codonSizes) int {", name))
+
+	lines = append(lines, "switch v := x.(type) {")
+	for _, alias := range aliases {
+		if ctx.opts.AminoCompatible {
+			lines = append(lines, fmt.Sprintf("case %s:", alias))
+			lines = append(lines, fmt.Sprintf("return 4 + size%s(v, s)", alias))
+			lines = append(lines, fmt.Sprintf("case *%s:", alias))
+			lines = append(lines, fmt.Sprintf("return 4 + size%s(*v, s)", alias))
+			continue
+		}
+		size := tagSize(int(ctx.structAlias2MagicNum[alias]), 2)
+		lines = append(lines, fmt.Sprintf("case %s:", alias))
+		lines = append(lines, "idx := s.reserve()")
+		lines = append(lines, fmt.Sprintf("return %d + codonByteSliceSize(s.set(idx, size%s(v, s)))", size, alias))
+		lines = append(lines, fmt.Sprintf("case *%s:", alias))
+		lines = append(lines, "idx := s.reserve()")
+		lines = append(lines, fmt.Sprintf("return %d + codonByteSliceSize(s.set(idx, size%s(*v, s)))", size, alias))
 	}
+	lines = append(lines, "default:")
+	lines = append(lines, `panic(fmt.Sprintf("Unknown Type %v %v\n", x, reflect.TypeOf(x)))`)
+	lines = append(lines, "} // end of switch")
+	lines = append(lines, "} // end of func")
+	return lines
 }
 
-func generateIfcEncodeFunc(funcName string, aliases []string) []string {
+func (ctx *context) generateIfcEncodeFunc(name string, aliases []string) []string {
 	lines := make([]string, 0, 1000)
-	lines = append(lines, "func "+funcName+"(w *[]byte, x interface{}) {")
+	lines = append(lines, fmt.Sprintf("func Encode%s(w *[]byte, x interface{}) {", name))
+	lines = append(lines, "s := &codonSizes{}")
+	lines = append(lines, fmt.Sprintf("codonGrow(w, size%s(x, s))", name))
+	lines = append(lines, fmt.Sprintf("encode%s(w, x, s)", name))
+	lines = append(lines, "}")
+	lines = append(lines, genHashLines(name, "interface{}")...)
+	lines = append(lines, fmt.Sprintf("func encode%s(w *[]byte, x interface{}, s *codonSizes) {", name))
 
 	lines = append(lines, "switch v := x.(type) {")
 	for _, alias := range aliases {
+		header := fmt.Sprintf("codonEncodeLength(int(getMagicNum(\"%s\")), w, s.next())", alias)
+		if ctx.opts.AminoCompatible {
+			magicNum := ctx.structAlias2MagicNum[alias]
+			header = fmt.Sprintf("*w = append(*w, 0x%02x, 0x%02x, 0x%02x, 0x%02x) // prefix bytes",
+				byte(magicNum>>24), byte(magicNum>>16), byte(magicNum>>8), byte(magicNum))
+		}
 		lines = append(lines, fmt.Sprintf("case %s:", alias))
-		line := fmt.Sprintf("codonEncodeByteSlice(int(getMagicNum(\"%s\")), w, func() []byte {", alias)
-		lines = append(lines, line)
-		lines = append(lines, "wBuf := make([]byte, 0, 64)\nw := &wBuf")
-		lines = append(lines, fmt.Sprintf("Encode%s(w, v)", alias))
-		lines = append(lines, "return wBuf\n}())")
+		lines = append(lines, header)
+		lines = append(lines, fmt.Sprintf("encode%s(w, v, s)", alias))
 
 		lines = append(lines, fmt.Sprintf("case *%s:", alias))
-		line = fmt.Sprintf("codonEncodeByteSlice(int(getMagicNum(\"%s\")), w, func() []byte {", alias)
-		lines = append(lines, line)
-		lines = append(lines, "wBuf := make([]byte, 0, 64)\nw := &wBuf")
-		lines = append(lines, fmt.Sprintf("Encode%s(w, *v)", alias))
-		lines = append(lines, "return wBuf\n}())")
+		lines = append(lines, header)
+		lines = append(lines, fmt.Sprintf("encode%s(w, *v, s)", alias))
 	}
 	lines = append(lines, "default:")
 	lines = append(lines, `panic(fmt.Sprintf("Unknown Type %v %v\n", x, reflect.TypeOf(x)))`)
@@ -187,7 +503,21 @@ func generateIfcEncodeFunc(funcName string, aliases []string) []string {
 	return lines
 }
 
-func (ctx *context) generateIfcRandFunc(funcName, ifcAlias string, ifcType reflect.Type, aliases []string, ignoreImpl map[string]string) ([]string, []string) {
+// Hash writes the same bytes as Encode into a hash.Hash. The encoded bytes are flushed into h in
+// chunks, instead of being collected into one big slice
+func genHashLines(name, typeName string) []string {
+	return []string{
+		fmt.Sprintf("func Hash%s(h hash.Hash, v %s) {", name, typeName),
+		"s := &codonSizes{out: h}",
+		fmt.Sprintf("size%s(v, s)", name),
+		"w := make([]byte, 0, 2*codonChunkSize)",
+		fmt.Sprintf("encode%s(&w, v, s)", name),
+		"h.Write(w)",
+		"}",
+	}
+}
+
+func (ctx *context) generateIfcRandFunc(funcName, ifcAlias string, ifcType Type, aliases []string, ignoreImpl map[string]string) ([]string, []string) {
 	lines := make([]string, 0, 1000)
 	lines = append(lines, "func "+funcName+"(r RandSrc) "+ifcAlias+" {")
 	newAliases := make([]string, 0, len(aliases))
@@ -201,11 +531,11 @@ func (ctx *context) generateIfcRandFunc(funcName, ifcAlias string, ifcType refle
 		lines = append(lines, fmt.Sprintf("case %d:", i))
 		structType, ok := ctx.structAlias2Type[alias]
 		if !ok {
-			panic("Can not find type for "+alias)
+			panic("Can not find type for " + alias)
 		}
 		if ifcType == nil || structType.Implements(ifcType) {
 			lines = append(lines, fmt.Sprintf("return Rand%s(r)", alias))
-		} else if reflect.PtrTo(structType).Implements(ifcType) {
+		} else if structType.PtrImplements(ifcType) {
 			lines = append(lines, fmt.Sprintf("tmp := Rand%s(r)\nreturn &tmp", alias))
 		} else {
 			panic(alias + "does not implement " + ifcAlias)
@@ -218,20 +548,20 @@ func (ctx *context) generateIfcRandFunc(funcName, ifcAlias string, ifcType refle
 	return lines, newAliases
 }
 
-func (ctx *context) generateIfcDeepCopyFunc(funcName, ifcAlias string, ifcType reflect.Type, aliases []string) []string {
+func (ctx *context) generateIfcDeepCopyFunc(funcName, ifcAlias string, ifcType Type, aliases []string) []string {
 	lines := make([]string, 0, 1000)
 	lines = append(lines, fmt.Sprintf("func %s(x %s) %s {", funcName, ifcAlias, ifcAlias))
 	lines = append(lines, "switch v := x.(type) {")
 	for _, alias := range aliases {
 		structType, ok := ctx.structAlias2Type[alias]
 		if !ok {
-			panic("Can not find type for "+alias)
+			panic("Can not find type for " + alias)
 		}
 		if ifcType == nil || structType.Implements(ifcType) {
 			lines = append(lines, fmt.Sprintf("case %s:", alias))
 			lines = append(lines, fmt.Sprintf("res := DeepCopy%s(v)\nreturn res", alias))
 		}
-		if ifcType == nil || reflect.PtrTo(structType).Implements(ifcType) {
+		if ifcType == nil || structType.PtrImplements(ifcType) {
 			lines = append(lines, fmt.Sprintf("case *%s:", alias))
 			lines = append(lines, fmt.Sprintf("res := DeepCopy%s(*v)\nreturn &res", alias))
 		}
@@ -243,18 +573,76 @@ func (ctx *context) generateIfcDeepCopyFunc(funcName, ifcAlias string, ifcType r
 	return lines
 }
 
+// A value and a pointer to it are encoded to the same bytes in an interface, so they are regarded as equal.
+// The values of unregistered types are regarded as unequal, instead of panicking.
+func (ctx *context) generateIfcEqualFunc(funcName, ifcAlias string, ifcType Type, aliases []string) []string {
+	lines := make([]string, 0, 1000)
+	lines = append(lines, fmt.Sprintf("func %s(a, b %s) bool {", funcName, ifcAlias))
+	lines = append(lines, "switch x := a.(type) {")
+	lines = append(lines, "case nil:\nreturn b == nil")
+	for _, alias := range aliases {
+		structType, ok := ctx.structAlias2Type[alias]
+		if !ok {
+			panic("Can not find type for " + alias)
+		}
+		valueOK := ifcType == nil || structType.Implements(ifcType)
+		ptrOK := ifcType == nil || structType.PtrImplements(ifcType)
+		if valueOK {
+			lines = append(lines, fmt.Sprintf("case %s:", alias))
+			lines = append(lines, "switch y := b.(type) {")
+			lines = append(lines, fmt.Sprintf("case %s:\nreturn Equal%s(x, y)", alias, alias))
+			if ptrOK {
+				lines = append(lines, fmt.Sprintf("case *%s:\nreturn y != nil && Equal%s(x, *y)", alias, alias))
+			}
+			lines = append(lines, "}")
+		}
+		if ptrOK {
+			lines = append(lines, fmt.Sprintf("case *%s:", alias))
+			lines = append(lines, "switch y := b.(type) {")
+			if valueOK {
+				lines = append(lines, fmt.Sprintf("case %s:\nreturn x != nil && Equal%s(*x, y)", alias, alias))
+			}
+			lines = append(lines, fmt.Sprintf("case *%s:\nreturn x == y || (x != nil && y != nil && Equal%s(*x, *y))", alias, alias))
+			lines = append(lines, "}")
+		}
+	}
+	lines = append(lines, "default:\n_ = x")
+	lines = append(lines, "} // end of switch")
+	lines = append(lines, "return false")
+	lines = append(lines, "} // end of func")
+	return lines
+}
+
 func (ctx *context) generateDecodeAnyFunc() []string {
-	res, _ := ctx.generateIfcDecodeFunc("DecodeAny", "interface{}", nil, ctx.structAlias2MagicNum)
+	res, _ := ctx.generateIfcDecodeFunc("Any", "interface{}", nil, ctx.structAlias2MagicNum)
 	return res
 }
 
+// Decode uses no limits, and DecodeWithOptions checks the limits in opts. DecodeNoCopy does not
+// copy the byte slices and strings, so bz must not be changed while the decoded value is in use.
+func genDecodeWrapperLines(name, typeName string) []string {
+	return []string{
+		fmt.Sprintf("func Decode%s(bz []byte) (%s, int, error) {", name, typeName),
+		fmt.Sprintf("return decode%s(bz, nil)", name),
+		"}",
+		fmt.Sprintf("func Decode%sNoCopy(bz []byte) (%s, int, error) {", name, typeName),
+		fmt.Sprintf("return decode%s(bz, &codonDecoder{noCopy: true})", name),
+		"}",
+		fmt.Sprintf("func Decode%sWithOptions(bz []byte, opts DecodeOptions) (v %s, total int, err error) {", name, typeName),
+		"d, err := newCodonDecoder(bz, opts)",
+		"if err != nil {\nreturn\n}",
+		fmt.Sprintf("return decode%s(bz, d)", name),
+		"}",
+	}
+}
+
 var beforeDecodeFunc = `l := codonDecodeUint64(bz, &n, &err)
 if err != nil {
 	return
 }
 bz = bz[n:]
 total += n
-if int(l) > len(bz) {
+if l > uint64(len(bz)) {
 	err = errors.New("Length Too Large")
 	return
 }`
@@ -266,15 +654,27 @@ var afterDecodeFunc = `if int(l) != n {
 
 var ending = "\nif err != nil {return}\nbz = bz[n:]\ntotal+=n"
 
-func (ctx *context) generateIfcDecodeFunc(funcName, decTypeName string, decType reflect.Type, alias2bytes map[string]uint32) ([]string, []string) {
+func (ctx *context) generateIfcDecodeFunc(name, decTypeName string, decType Type, alias2bytes map[string]uint32) ([]string, []string) {
 	aliases := make([]string, 0, len(alias2bytes))
 	for alias := range alias2bytes {
 		aliases = append(aliases, alias)
 	}
 	sort.Strings(aliases)
-	lines := make([]string, 0, 1000)
-	lines = append(lines, "func "+funcName+"(bz []byte) (v "+decTypeName+", total int, err error) {")
-	lines = append(lines, `
+	lines := genDecodeWrapperLines(name, decTypeName)
+	lines = append(lines, "func decode"+name+"(bz []byte, d *codonDecoder) (v "+decTypeName+", total int, err error) {")
+	if ctx.opts.AminoCompatible {
+		// the prefix bytes are followed by the struct, which extends to the end of bz
+		lines = append(lines, `
+	var n int
+	if len(bz) < 4 {
+		err = errors.New("Prefix Bytes Too Short")
+		return
+	}
+	magicNum := binary.BigEndian.Uint32(bz[:4])
+	bz = bz[4:]
+	total += 4`)
+	} else {
+		lines = append(lines, `
 	var n int
 	tag := codonDecodeUint64(bz, &n, &err)
 	if err != nil {
@@ -283,31 +683,37 @@ func (ctx *context) generateIfcDecodeFunc(funcName, decTypeName string, decType
 	bz = bz[n:]
 	total += n
 	magicNum := uint32(tag >> 3)`)
+	}
 
 	lines = append(lines, "switch magicNum {")
 	for _, alias := range aliases {
 		magicNum := alias2bytes[alias]
 		lines = append(lines, fmt.Sprintf("case %d:", magicNum))
-		lines = append(lines, beforeDecodeFunc)
-		lines = append(lines, fmt.Sprintf("var tmp %s", alias))
-		lines = append(lines, fmt.Sprintf("tmp, n, err = Decode%s(bz[:l])%s", alias, ending))
-		lines = append(lines, afterDecodeFunc)
+		if ctx.opts.AminoCompatible {
+			lines = append(lines, fmt.Sprintf("var tmp %s", alias))
+			lines = append(lines, fmt.Sprintf("tmp, n, err = decode%s(bz, d)%s", alias, ending))
+		} else {
+			lines = append(lines, beforeDecodeFunc)
+			lines = append(lines, fmt.Sprintf("var tmp %s", alias))
+			lines = append(lines, fmt.Sprintf("tmp, n, err = decode%s(bz[:l], d)%s", alias, ending))
+			lines = append(lines, afterDecodeFunc)
+		}
 		structType, ok := ctx.structAlias2Type[alias]
 		if !ok {
-			panic("Can not find type for "+alias)
+			panic("Can not find type for " + alias)
 		}
 		if decType == nil || structType.Implements(decType) {
 			lines = append(lines, "v = tmp\nreturn")
-		} else if reflect.PtrTo(structType).Implements(decType) {
+		} else if structType.PtrImplements(decType) {
 			lines = append(lines, "v = &tmp\nreturn")
 		} else {
 		}
 	}
 	lines = append(lines, "default:")
-	lines = append(lines, "panic(\"Unknown type\")")
+	lines = append(lines, fmt.Sprintf("err = &ErrUnknownMagicNum{MagicNum: magicNum, Alias: \"%s\"}", decTypeName))
 	lines = append(lines, "} // end of switch")
-	lines = append(lines, "return v, n, nil")
-	lines = append(lines, "} // end of "+funcName)
+	lines = append(lines, "return")
+	lines = append(lines, "} // end of decode"+name)
 	return lines, aliases
 }
 
@@ -327,9 +733,19 @@ func (ctx *context) generateMagicNumFunc() []string {
 	}
 	lines = append(lines, "} // end of switch")
 	lines = append(lines, "panic(\"Should not reach here\")")
-	lines = append(lines, "return 0")
 	lines = append(lines, "} // end of getMagicNum")
 
+	lines = append(lines, "func getAliasOfMagicNum(magicNum uint32) string {")
+	lines = append(lines, "switch magicNum {")
+	for _, alias := range aliases {
+		magicNum := ctx.structAlias2MagicNum[alias]
+		lines = append(lines, fmt.Sprintf("case %d:", magicNum))
+		lines = append(lines, fmt.Sprintf("return \"%s\"", alias))
+	}
+	lines = append(lines, "} // end of switch")
+	lines = append(lines, "return \"\"")
+	lines = append(lines, "} // end of getAliasOfMagicNum")
+
 	lines = append(lines, "func getMagicNumOfVar(x interface{}) (uint32, bool) {")
 	lines = append(lines, "switch x.(type) {")
 	for _, alias := range aliases {
@@ -367,7 +783,7 @@ func (ctx *context) generateSupportListFunc() []string {
 func (ctx *context) analyzeIfc() {
 	for ifcPath, ifcType := range ctx.ifcPath2Type {
 		for structPath, structType := range ctx.structPath2Type {
-			if structType.Implements(ifcType) || reflect.PtrTo(structType).Implements(ifcType) {
+			if structType.Implements(ifcType) || structType.PtrImplements(ifcType) {
 				if _, ok := ctx.ifcPath2StructPaths[ifcPath]; ok {
 					ctx.ifcPath2StructPaths[ifcPath] = append(ctx.ifcPath2StructPaths[ifcPath], structPath)
 				} else {
@@ -375,19 +791,20 @@ func (ctx *context) analyzeIfc() {
 				}
 			}
 		}
+		// sorted, such that the generated code is the same for every run
+		sort.Strings(ctx.ifcPath2StructPaths[ifcPath])
 	}
 }
 
-func derefPtr(v interface{}) reflect.Type {
+func derefPtr(v interface{}) Type {
 	t := reflect.TypeOf(v)
 	if t.Kind() == reflect.Ptr {
 		t = t.Elem()
 	}
-	return t
+	return TypeOfReflect(t)
 }
 
-func (ctx *context) register(alias string, name string, v interface{}) {
-	t := derefPtr(v)
+func (ctx *context) register(alias string, name string, t Type, magicNum uint32) {
 	path := t.PkgPath() + "." + t.Name()
 	//if len(t.PkgPath()) == 0 || len(t.Name()) == 0 {
 	//	panic("Invalid Path:" + path)
@@ -403,20 +820,37 @@ func (ctx *context) register(alias string, name string, v interface{}) {
 		}
 		ctx.structPath2Type[path] = t
 		ctx.structAlias2Type[alias] = t
-		magicNum := calcMagicNum([]string{alias, name})
+		if magicNum == 0 && ctx.opts.AminoCompatible {
+			magicNum = calcAminoPrefix(name)
+		} else if magicNum == 0 {
+			magicNum = calcMagicNum([]string{alias, name})
+		} else if ctx.opts.AminoCompatible {
+			if magicNum>>24 == 0 {
+				panic(fmt.Sprintf("Prefix bytes %08x must not begin with zero", magicNum))
+			}
+		} else if magicNum < MinMagicNum || magicNum > MaxMagicNum {
+			panic(fmt.Sprintf("Magic number %d is out of range [%d, %d]", magicNum, MinMagicNum, MaxMagicNum))
+		}
 		if otherAlias, ok := ctx.magicNum2StructAlias[magicNum]; ok {
 			panic("Magic Bytes Conflicts: " + otherAlias + " vs " + alias)
 		}
 		ctx.structAlias2MagicNum[alias] = magicNum
 		ctx.magicNum2StructAlias[magicNum] = alias
+		ctx.structAlias2Name[alias] = name
 	}
 }
 
 func (ctx *context) generateIfcAssignFunc() []string {
 	lines := make([]string, 0, 1000)
-	lines = append(lines, "func AssignIfcPtrFromStruct(ifcPtrIn interface{}, structObjIn interface{}) {")
+	lines = append(lines, "func AssignIfcPtrFromStruct(ifcPtrIn interface{}, structObjIn interface{}) error {")
 	lines = append(lines, "switch ifcPtr := ifcPtrIn.(type) {")
-	for ifcPath, structPaths := range ctx.ifcPath2StructPaths {
+	ifcPaths := make([]string, 0, len(ctx.ifcPath2StructPaths))
+	for ifcPath := range ctx.ifcPath2StructPaths {
+		ifcPaths = append(ifcPaths, ifcPath)
+	}
+	sort.Strings(ifcPaths)
+	for _, ifcPath := range ifcPaths {
+		structPaths := ctx.ifcPath2StructPaths[ifcPath]
 		ifcAlias, ok := ctx.ifcPath2Alias[ifcPath]
 		if !ok {
 			panic("cannot find ifcAlias")
@@ -432,17 +866,18 @@ func (ctx *context) generateIfcAssignFunc() []string {
 			lines = append(lines, "\t*ifcPtr = &structObj")
 		}
 		lines = append(lines, "\tdefault:")
-		lines = append(lines, `	panic(fmt.Sprintf("Type mismatch %v %v\n", reflect.TypeOf(ifcPtr), reflect.TypeOf(structObjIn)))`)
+		lines = append(lines, "\treturn newErrTypeMismatch(structObjIn, ifcPtrIn)")
 		lines = append(lines, "\t} // end switch of structs")
 	}
 	lines = append(lines, "default:")
-	lines = append(lines, `panic(fmt.Sprintf("Unknown Type %v\n", reflect.TypeOf(ifcPtrIn)))`)
+	lines = append(lines, "return newErrTypeMismatch(structObjIn, ifcPtrIn)")
 	lines = append(lines, "} // end switch of interfaces")
+	lines = append(lines, "return nil")
 	lines = append(lines, "}")
 	return lines
 }
 
-func (ctx *context) generateIfcFunc(ifc string, t reflect.Type) []string {
+func (ctx *context) generateIfcFunc(ifc string, t Type) []string {
 	ifcPath := t.PkgPath() + "." + t.Name()
 	structPaths, ok := ctx.ifcPath2StructPaths[ifcPath]
 	if !ok {
@@ -460,54 +895,102 @@ func (ctx *context) generateIfcFunc(ifc string, t reflect.Type) []string {
 		}
 		alias2bytes[alias] = magicNum
 	}
-	decLines, aliases := ctx.generateIfcDecodeFunc("Decode"+ifc, ifc, t, alias2bytes)
-	encLines := generateIfcEncodeFunc("Encode"+ifc, aliases)
+	decLines, aliases := ctx.generateIfcDecodeFunc(ifc, ifc, t, alias2bytes)
+	encLines := ctx.generateIfcEncodeFunc(ifc, aliases)
+	encLines = append(encLines, ctx.generateIfcSizeFunc(ifc, aliases)...)
 	randLines, aliases := ctx.generateIfcRandFunc("Rand"+ifc, ifc, t, aliases, ctx.ignoreImpl)
 	deepcopyLines := ctx.generateIfcDeepCopyFunc("DeepCopy"+ifc, ifc, t, aliases)
-	result := make([]string, 0, len(decLines)+len(encLines)+len(randLines)+len(deepcopyLines))
+	equalLines := ctx.generateIfcEqualFunc("Equal"+ifc, ifc, t, aliases)
+	result := make([]string, 0, len(decLines)+len(encLines)+len(randLines)+len(deepcopyLines)+len(equalLines))
 	result = append(result, decLines...)
 	result = append(result, encLines...)
 	result = append(result, randLines...)
 	result = append(result, deepcopyLines...)
+	result = append(result, equalLines...)
 	return result
 }
 
-func (ctx *context) generateStructFunc(alias string, t reflect.Type) []string {
+func (ctx *context) generateStructFunc(alias string, t Type) []string {
 	lines := make([]string, 0, 1000)
 
-	// Encode
+	// Encode, which precomputes the sizes of the nested messages and then writes everything into one buffer
 	line := fmt.Sprintf("func Encode%s(w *[]byte, v %s) {", alias, alias)
 	lines = append(lines, line)
+	lines = append(lines, "s := &codonSizes{}")
+	lines = append(lines, fmt.Sprintf("codonGrow(w, size%s(v, s))", alias))
+	lines = append(lines, fmt.Sprintf("encode%s(w, v, s)", alias))
+	lines = append(lines, "}")
+	lines = append(lines, genHashLines(alias, alias)...)
+	line = fmt.Sprintf("func encode%s(w *[]byte, v %s, s *codonSizes) {", alias, alias)
+	lines = append(lines, line)
 	_, isLeaf := ctx.leafTypes[t.PkgPath()+"."+t.Name()]
-	if !isLeaf && len(t.PkgPath())==0 {
+	if !isLeaf && len(t.PkgPath()) == 0 {
 		isLeaf = true
 	}
-	if t.Kind() == reflect.Struct && !isLeaf{
+	bare := ctx.opts.AminoCompatible && (t.Kind() != reflect.Struct || isLeaf)
+	if bare {
+		checkAminoBareType(t)
+	}
+	if t.Kind() == reflect.Struct && !isLeaf {
 		ctx.genStructEncLines(t, &lines, "v", 0)
+	} else if bare {
+		lines = append(lines, "codonWriteUvarint(w, uint64(len(v)))")
+		if t.Kind() == reflect.String {
+			lines = append(lines, "*w = append(*w, string(v)...)")
+		} else {
+			lines = append(lines, "*w = append(*w, v[:]...)")
+		}
 	} else {
 		ctx.genFieldEncLines(0, t, &lines, "v", 0)
 	}
 	lines = append(lines, "} //End of Encode"+alias+"\n")
 
-	// Decode
-	line = fmt.Sprintf("func Decode%s(bz []byte) (v %s, total int, err error) {", alias, alias)
+	// Size
+	line = fmt.Sprintf("func Size%s(v %s) int {", alias, alias)
+	lines = append(lines, line)
+	lines = append(lines, fmt.Sprintf("return size%s(v, nil)", alias))
+	lines = append(lines, "}")
+	line = fmt.Sprintf("func size%s(v %s, s *codonSizes) (total int) {", alias, alias)
 	lines = append(lines, line)
-	lines = append(lines, "var n int")
-	ending := "\nif err != nil {return v, total, err}\nbz = bz[n:]\ntotal+=n"
-	lines = append(lines, "for len(bz) != 0 {")
-	lines = append(lines, fmt.Sprintf("tag := codonDecodeUint64(bz, &n, &err)%s", ending))
-	lines = append(lines, "tag = tag >> 3")
-	lines = append(lines, "switch tag {")
 	if t.Kind() == reflect.Struct && !isLeaf {
-		ctx.genStructDecLines(t, &lines, "v", 0)
+		ctx.genStructSizeLines(t, &lines, "v", 0)
+	} else if bare {
+		lines = append(lines, "total += codonByteSliceSize(len(v))")
 	} else {
-		lines = append(lines, "case 0:")
+		ctx.genFieldSizeLines(0, t, &lines, "v", 0)
+	}
+	lines = append(lines, "return")
+	lines = append(lines, "} //End of Size"+alias+"\n")
+
+	// Decode
+	lines = append(lines, genDecodeWrapperLines(alias, alias)...)
+	line = fmt.Sprintf("func decode%s(bz []byte, d *codonDecoder) (v %s, total int, err error) {", alias, alias)
+	lines = append(lines, line)
+	lines = append(lines, "var n int")
+	lines = append(lines, "if err = d.enter(); err != nil {\nreturn\n}")
+	if bare { // the value is not behind a tag
 		ctx.genFieldDecLines(0, t, &lines, "v", 0)
+	} else {
+		ending := "\nif err != nil {return v, total, err}\nbz = bz[n:]\ntotal+=n"
+		lines = append(lines, "for len(bz) != 0 {")
+		lines = append(lines, fmt.Sprintf("tag := codonDecodeUint64(bz, &n, &err)%s", ending))
+		lines = append(lines, "switch tag >> 3 {")
+		if t.Kind() == reflect.Struct && !isLeaf {
+			ctx.genStructDecLines(t, &lines, "v", 0)
+			ctx.genUnknownFieldLines(&lines, ctx.getUnrecognized(t, "v"))
+		} else {
+			lines = append(lines, "case 0:")
+			ctx.genFieldDecLines(0, t, &lines, "v", 0)
+			ctx.genUnknownFieldLines(&lines, "")
+		}
+		lines = append(lines, "} // end for")
 	}
-	lines = append(lines, "default: err = errors.New(\"Unknown Field\")\nreturn\n}")
-	lines = append(lines, "} // end for")
+	lines = append(lines, "d.leave()")
 	lines = append(lines, "return v, total, nil")
 	lines = append(lines, "} //End of Decode"+alias+"\n")
+	if t.Kind() == reflect.Struct && !isLeaf && !bare {
+		lines = append(lines, ctx.genPeekFuncs(alias, t)...)
+	}
 
 	// Rand
 	line = fmt.Sprintf("func Rand%s(r RandSrc) %s {", alias, alias)
@@ -548,12 +1031,23 @@ func (ctx *context) generateStructFunc(alias string, t reflect.Type) []string {
 	lines = append(lines, "return")
 	lines = append(lines, "} //End of DeepCopy"+alias+"\n")
 
+	// Equal
+	line = fmt.Sprintf("func Equal%s(a, b %s) bool {", alias, alias)
+	lines = append(lines, line)
+	if t.Kind() == reflect.Struct && !isLeaf {
+		ctx.genStructEqualLines(t, &lines, "", 0)
+	} else {
+		ctx.genFieldEqualLines(t, &lines, "", 0)
+	}
+	lines = append(lines, "return true")
+	lines = append(lines, "} //End of Equal"+alias+"\n")
+
 	return lines
 }
 
 //====================================================================
 
-func isMutex(t reflect.Type) bool {
+func isMutex(t Type) bool {
 	if t.PkgPath() == "sync" {
 		if t.Name() == "Mutex" || t.Name() == "RWMutex" {
 			return true
@@ -562,7 +1056,56 @@ func isMutex(t reflect.Type) bool {
 	return false
 }
 
-func (ctx *context) genFieldEncLines(fieldNum int, t reflect.Type, lines *[]string, fieldName string, iterLevel int) {
+// In the amino-compatible mode, a registered type which is not a struct is written without a tag, just
+// like amino does. Only strings and byte slices and arrays (such as public keys) are supported.
+func checkAminoBareType(t Type) {
+	switch t.Kind() {
+	case reflect.String:
+		return
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return
+		}
+	}
+	panic(fmt.Sprintf("Registered type of %s is not supported in the amino-compatible mode", t.Kind()))
+}
+
+// protobuf3 only allows integral and string types as map keys, and map values cannot be repeated
+func checkMapType(t Type) {
+	switch t.Key().Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.String:
+	default:
+		panic(fmt.Sprintf("Map key of %s is not supported", t.Key().Kind()))
+	}
+	elemT := t.Elem()
+	if elemT.Kind() == reflect.Map {
+		panic("Map of maps is not supported")
+	}
+	if elemT.Kind() == reflect.Slice && elemT.Elem().Kind() != reflect.Uint8 {
+		panic("Map of slices is not supported, except for byte slices")
+	}
+}
+
+// the order used to sort map keys, such that the encoded bytes are deterministic
+func keyLessExpr(keys string, keyT Type) string {
+	if keyT.Kind() == reflect.Bool {
+		return fmt.Sprintf("!%s[i] && %s[j]", keys, keys)
+	}
+	return fmt.Sprintf("%s[i] < %s[j]", keys, keys)
+}
+
+// iterates over a map's keys in sorted order, leaving the loop body open
+func (ctx *context) genSortedKeysLines(keyT Type, lines *[]string, fieldName string, iterLevel int) {
+	keys := fmt.Sprintf("keys_%d", iterLevel)
+	key := fmt.Sprintf("key_%d", iterLevel)
+	*lines = append(*lines, fmt.Sprintf("%s := make([]%s, 0, len(%s))", keys, ctx.getTypeName(keyT), fieldName))
+	*lines = append(*lines, fmt.Sprintf("for %s := range %s {\n%s = append(%s, %s)\n}", key, fieldName, keys, keys, key))
+	*lines = append(*lines, fmt.Sprintf("codonSortKeys(%s, func(i, j int) bool {return %s})", keys, keyLessExpr(keys, keyT)))
+	*lines = append(*lines, fmt.Sprintf("for _, %s := range %s {", key, keys))
+}
+
+func (ctx *context) genFieldEncLines(fieldNum int, t Type, lines *[]string, fieldName string, iterLevel int) {
 	if fieldNum > MaxFieldNum {
 		panic("Field Number is too large")
 	}
@@ -592,11 +1135,18 @@ func (ctx *context) genFieldEncLines(fieldNum int, t reflect.Type, lines *[]stri
 	case reflect.Complex128:
 		panic("Complex128 is not supported")
 	case reflect.Map:
-		panic("Map is not supported")
-	case reflect.Float32:
-		panic("Float32 is not supported")
-	case reflect.Float64:
-		panic("Float64 is not supported")
+		checkMapType(t)
+		keyT := t.Key()
+		key := fmt.Sprintf("key_%d", iterLevel)
+		*lines = append(*lines, "{ // map "+fieldName)
+		ctx.genSortedKeysLines(keyT, lines, fieldName, iterLevel)
+		*lines = append(*lines, fmt.Sprintf("codonEncodeLength(%d, w, s.next())", fieldNum))
+		ctx.genFieldEncLines(1, keyT, lines, key, iterLevel+1)
+		ctx.enter("[]")
+		ctx.genNilableEncLines(2, t.Elem(), lines, fieldName+"["+key+"]", iterLevel+1)
+		ctx.leave()
+		*lines = append(*lines, "}")
+		line = "} // end of " + fieldName
 
 	case reflect.Bool:
 		if len(t.PkgPath()) == 0 {
@@ -604,24 +1154,8 @@ func (ctx *context) genFieldEncLines(fieldNum int, t reflect.Type, lines *[]stri
 		} else {
 			line = fmt.Sprintf("codonEncodeBool(%d, w, bool(%s))", fieldNum, fieldName)
 		}
-	case reflect.Int:
-		line = fmt.Sprintf("codonEncodeVarint(%d, w, int64(%s))", fieldNum, fieldName)
-	case reflect.Int8:
-		if len(t.PkgPath()) == 0 {
-			line = fmt.Sprintf("codonEncodeInt8(%d, w, %s)", fieldNum, fieldName)
-		} else {
-			line = fmt.Sprintf("codonEncodeInt8(%d, w, int8(%s))", fieldNum, fieldName)
-		}
-	case reflect.Int16:
-		if len(t.PkgPath()) == 0 {
-			line = fmt.Sprintf("codonEncodeInt16(%d, w, %s)", fieldNum, fieldName)
-		} else {
-			line = fmt.Sprintf("codonEncodeInt16(%d, w, int16(%s))", fieldNum, fieldName)
-		}
-	case reflect.Int32:
-		line = fmt.Sprintf("codonEncodeVarint(%d, w, int64(%s))", fieldNum, fieldName)
-	case reflect.Int64:
-		line = fmt.Sprintf("codonEncodeVarint(%d, w, int64(%s))", fieldNum, fieldName)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		line = ctx.signedEncLine(fieldNum, t, fieldName)
 	case reflect.Uint:
 		line = fmt.Sprintf("codonEncodeUvarint(%d, w, uint64(%s))", fieldNum, fieldName)
 	case reflect.Uint8:
@@ -640,6 +1174,18 @@ func (ctx *context) genFieldEncLines(fieldNum int, t reflect.Type, lines *[]stri
 		line = fmt.Sprintf("codonEncodeUvarint(%d, w, uint64(%s))", fieldNum, fieldName)
 	case reflect.Uint64:
 		line = fmt.Sprintf("codonEncodeUvarint(%d, w, uint64(%s))", fieldNum, fieldName)
+	case reflect.Float32:
+		if len(t.PkgPath()) == 0 {
+			line = fmt.Sprintf("codonEncodeFloat32(%d, w, %s)", fieldNum, fieldName)
+		} else {
+			line = fmt.Sprintf("codonEncodeFloat32(%d, w, float32(%s))", fieldNum, fieldName)
+		}
+	case reflect.Float64:
+		if len(t.PkgPath()) == 0 {
+			line = fmt.Sprintf("codonEncodeFloat64(%d, w, %s)", fieldNum, fieldName)
+		} else {
+			line = fmt.Sprintf("codonEncodeFloat64(%d, w, float64(%s))", fieldNum, fieldName)
+		}
 	case reflect.String:
 		if len(t.PkgPath()) == 0 {
 			line = fmt.Sprintf("codonEncodeString(%d, w, %s)", fieldNum, fieldName)
@@ -655,6 +1201,15 @@ func (ctx *context) genFieldEncLines(fieldNum int, t reflect.Type, lines *[]stri
 		elemT := t.Elem()
 		if elemT.Kind() == reflect.Uint8 {
 			line = fmt.Sprintf("codonEncodeByteSlice(%d, w, %s[:])", fieldNum, fieldName)
+		} else if isPacked(t) {
+			iterVar := fmt.Sprintf("_%d", iterLevel)
+			*lines = append(*lines, fmt.Sprintf("if len(%s) != 0 {", fieldName))
+			*lines = append(*lines, fmt.Sprintf("codonEncodeLength(%d, w, s.next())", fieldNum))
+			*lines = append(*lines, fmt.Sprintf("for %s:=0; %s<len(%s); %s++ {",
+				iterVar, iterVar, fieldName, iterVar))
+			*lines = append(*lines, ctx.packedElemEncLine(elemT, fieldName+"["+iterVar+"]"))
+			*lines = append(*lines, "}")
+			line = "} // end of packed " + fieldName
 		} else {
 			*lines = append(*lines, line)
 			iterVar := fmt.Sprintf("_%d", iterLevel)
@@ -662,7 +1217,9 @@ func (ctx *context) genFieldEncLines(fieldNum int, t reflect.Type, lines *[]stri
 				iterVar, iterVar, fieldName, iterVar)
 			*lines = append(*lines, line)
 			varName := fieldName + "[" + iterVar + "]"
+			ctx.enter("[]")
 			ctx.genFieldEncLines(fieldNum, elemT, lines, varName, iterLevel+1)
+			ctx.leave()
 			line = "}"
 		}
 	case reflect.Interface:
@@ -671,11 +1228,8 @@ func (ctx *context) genFieldEncLines(fieldNum int, t reflect.Type, lines *[]stri
 		if !ok {
 			panic("Cannot find alias for:" + typePath)
 		}
-		line = fmt.Sprintf("codonEncodeByteSlice(%d, w, func() []byte {", fieldNum)
-		*lines = append(*lines, line)
-		*lines = append(*lines, "w := make([]byte, 0, 64)")
-		*lines = append(*lines, fmt.Sprintf("Encode%s(&w, %s)// interface_encode", alias, fieldName))
-		line = "return w\n}()) // end of " + fieldName
+		*lines = append(*lines, fmt.Sprintf("codonEncodeLength(%d, w, s.next())", fieldNum))
+		line = fmt.Sprintf("encode%s(w, %s, s) // interface_encode", alias, fieldName)
 	case reflect.Ptr:
 		panic("Should not reach here")
 	case reflect.Struct:
@@ -685,11 +1239,253 @@ func (ctx *context) genFieldEncLines(fieldNum int, t reflect.Type, lines *[]stri
 			}
 			line = fmt.Sprintf("codonEncodeByteSlice(%d, w, Encode%s(%s))", fieldNum, t.Name(), fieldName)
 		} else {
-			line = fmt.Sprintf("codonEncodeByteSlice(%d, w, func() []byte {",fieldNum)
-			*lines = append(*lines, line)
-			*lines = append(*lines, "wBuf := make([]byte, 0, 64)\nw := &wBuf")
+			*lines = append(*lines, fmt.Sprintf("codonEncodeLength(%d, w, s.next())", fieldNum))
 			ctx.genStructEncLines(t, lines, fieldName, iterLevel)
-			line = "return wBuf\n}()) // end of " + fieldName
+			line = "// end of " + fieldName
+		}
+	default:
+		panic(fmt.Sprintf("Unknown Kind %s", t.Kind()))
+	}
+	*lines = append(*lines, line)
+}
+
+func (ctx *context) genStructEncLines(t Type, lines *[]string, varName string, iterLevel int) {
+	fieldNums := getFieldNums(t)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if isUnrecognizedField(field) {
+			continue
+		}
+		*lines = append(*lines, fieldMark(varName+"."+field.Name))
+		ctx.guard("."+field.Name, fieldKey(t, field), func() {
+			ctx.genNilableEncLines(fieldNums[i], field.Type, lines, varName+"."+field.Name, iterLevel)
+		})
+		if !isScalar(field.Type) {
+			*lines = append(*lines, "s.flush(w)")
+		}
+		*lines = append(*lines, endMark())
+	}
+	// the unknown fields skipped during decoding are written back at the end
+	if unrecognized := ctx.getUnrecognized(t, varName); len(unrecognized) != 0 {
+		*lines = append(*lines, fmt.Sprintf("*w = append(*w, %s...)", unrecognized))
+	}
+}
+
+// the scalar fields are small, so there is no need to flush the bytes into a hash after them
+func isScalar(t Type) bool {
+	switch t.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func isSignedInt(t Type) bool {
+	return t.Kind() >= reflect.Int && t.Kind() <= reflect.Int64
+}
+
+// A signed integer is written as a zigzag varint, or as a two's complement varint if TwosComplementInts is set
+func (ctx *context) signedEncLine(fieldNum int, t Type, fieldName string) string {
+	if ctx.opts.TwosComplementInts {
+		return fmt.Sprintf("codonEncodeUvarint(%d, w, uint64(%s))", fieldNum, fieldName)
+	}
+	switch {
+	case t.Kind() == reflect.Int8 && len(t.PkgPath()) == 0:
+		return fmt.Sprintf("codonEncodeInt8(%d, w, %s)", fieldNum, fieldName)
+	case t.Kind() == reflect.Int8:
+		return fmt.Sprintf("codonEncodeInt8(%d, w, int8(%s))", fieldNum, fieldName)
+	case t.Kind() == reflect.Int16 && len(t.PkgPath()) == 0:
+		return fmt.Sprintf("codonEncodeInt16(%d, w, %s)", fieldNum, fieldName)
+	case t.Kind() == reflect.Int16:
+		return fmt.Sprintf("codonEncodeInt16(%d, w, int16(%s))", fieldNum, fieldName)
+	}
+	return fmt.Sprintf("codonEncodeVarint(%d, w, int64(%s))", fieldNum, fieldName)
+}
+
+// returns an expression for the size of a signed integer, excluding its tag
+func (ctx *context) signedSizeExpr(x string) string {
+	if ctx.opts.TwosComplementInts {
+		return fmt.Sprintf("codonUvarintSize(uint64(%s))", x)
+	}
+	return fmt.Sprintf("codonVarintSize(int64(%s))", x)
+}
+
+// The repeated scalars are written in the packed form: a length-delimited field holding the elements
+// without their tags. The decoders accept both the packed form and the unpacked form.
+func isPacked(t Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8 && isScalar(t.Elem())
+}
+
+// returns the line which writes an element of a packed field
+func (ctx *context) packedElemEncLine(elemT Type, elem string) string {
+	switch elemT.Kind() {
+	case reflect.Bool:
+		return fmt.Sprintf("codonWriteBool(w, bool(%s))", elem)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if ctx.opts.TwosComplementInts {
+			return fmt.Sprintf("codonWriteUvarint(w, uint64(%s))", elem)
+		}
+		return fmt.Sprintf("codonWriteVarint(w, int64(%s))", elem)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprintf("codonWriteUvarint(w, uint64(%s))", elem)
+	case reflect.Float32:
+		return fmt.Sprintf("codonWriteFloat32(w, float32(%s))", elem)
+	case reflect.Float64:
+		return fmt.Sprintf("codonWriteFloat64(w, float64(%s))", elem)
+	}
+	panic("Should not reach here")
+}
+
+// returns an expression for the content size of a packed field
+func (ctx *context) packedSizeExpr(elemT Type, fieldName string, iterLevel int) string {
+	switch elemT.Kind() {
+	case reflect.Bool:
+		return fmt.Sprintf("len(%s)", fieldName)
+	case reflect.Float32:
+		return fmt.Sprintf("len(%s)*4", fieldName)
+	case reflect.Float64:
+		return fmt.Sprintf("len(%s)*8", fieldName)
+	}
+	iterVar := fmt.Sprintf("_%d", iterLevel)
+	elemSize := fmt.Sprintf("codonUvarintSize(uint64(%s[%s]))", fieldName, iterVar)
+	if isSignedInt(elemT) {
+		elemSize = ctx.signedSizeExpr(fieldName + "[" + iterVar + "]")
+	}
+	return fmt.Sprintf("func() (total int) {\nfor %s:=0; %s<len(%s); %s++ {\ntotal += %s\n}\nreturn\n}()",
+		iterVar, iterVar, fieldName, iterVar, elemSize)
+}
+
+func isNilable(t Type) bool {
+	return t.Kind() == reflect.Ptr || t.Kind() == reflect.Interface
+}
+
+// A nil pointer or a nil interface is omitted when encoding, and is decoded back as nil,
+// because it does not appear on the wire and its tag is never met by the decoder
+func (ctx *context) genNilableEncLines(fieldNum int, t Type, lines *[]string, fieldName string, iterLevel int) {
+	if !isNilable(t) {
+		ctx.genFieldEncLines(fieldNum, t, lines, fieldName, iterLevel)
+		return
+	}
+	*lines = append(*lines, fmt.Sprintf("if %s != nil {", fieldName))
+	ctx.genFieldEncLines(fieldNum, t, lines, fieldName, iterLevel)
+	*lines = append(*lines, "} // end of nilable "+fieldName)
+}
+
+//=========================
+
+// returns the number of bytes taken by a field's tag
+func tagSize(fieldNum int, wireType int) int {
+	var buf [binary.MaxVarintLen64]byte
+	return binary.PutUvarint(buf[:], (uint64(fieldNum)<<3)|uint64(wireType))
+}
+
+func isFixedSize(t Type) bool {
+	return t.Kind() == reflect.Bool || t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64
+}
+
+// returns an expression for the encoded size of a non-leaf struct. A registered struct has its own
+// size function, and an unregistered one has its size calculated in an inline closure
+func (ctx *context) structSizeExpr(t Type, fieldName string, isPtr bool, iterLevel int) string {
+	if len(t.Name()) != 0 {
+		if alias, ok := ctx.structPath2Alias[t.PkgPath()+"."+t.Name()]; ok {
+			if isPtr {
+				fieldName = "*(" + fieldName + ")"
+			}
+			return fmt.Sprintf("size%s(%s, s)", alias, fieldName)
+		}
+	}
+	lines := []string{"func() (total int) {"}
+	ctx.genStructSizeLines(t, &lines, fieldName, iterLevel)
+	lines = append(lines, "return\n}()")
+	return strings.Join(lines, "\n")
+}
+
+// The size of a nested message is recorded before the sizes of its own nested messages, which is
+// the same order as the encode lines consume them with "s.next()"
+func genMessageSizeLines(fieldNum int, sizeExpr string, lines *[]string) {
+	*lines = append(*lines, "{\nidx := s.reserve()")
+	*lines = append(*lines, fmt.Sprintf("total += %d + codonByteSliceSize(s.set(idx, %s))\n}", tagSize(fieldNum, 2), sizeExpr))
+}
+
+// The size lines mirror the encode lines, such that the length prefixes can be written before
+// the contents of nested messages, without encoding them into temporary buffers
+func (ctx *context) genFieldSizeLines(fieldNum int, t Type, lines *[]string, fieldName string, iterLevel int) {
+	if isMutex(t) {
+		return
+	}
+	isPtr := false
+	if t.Kind() == reflect.Ptr {
+		isPtr = true
+		t = t.Elem()
+	}
+	var line string
+	switch t.Kind() {
+	case reflect.Map:
+		key := fmt.Sprintf("key_%d", iterLevel)
+		*lines = append(*lines, "{ // map "+fieldName)
+		if isFixedSize(t.Key()) && isFixedSize(t.Elem()) {
+			// all the entries have the same size, so the order does not matter and the key is unused
+			*lines = append(*lines, fmt.Sprintf("for range %s {", fieldName))
+		} else {
+			ctx.genSortedKeysLines(t.Key(), lines, fieldName, iterLevel)
+		}
+		entryLines := []string{"func() (total int) {"}
+		ctx.genFieldSizeLines(1, t.Key(), &entryLines, key, iterLevel+1)
+		ctx.enter("[]")
+		ctx.genNilableSizeLines(2, t.Elem(), &entryLines, fieldName+"["+key+"]", iterLevel+1)
+		ctx.leave()
+		entryLines = append(entryLines, "return\n}()")
+		genMessageSizeLines(fieldNum, strings.Join(entryLines, "\n"), lines)
+		*lines = append(*lines, "}")
+		line = "} // end of " + fieldName
+	case reflect.Bool:
+		line = fmt.Sprintf("total += %d", tagSize(fieldNum, 0)+1)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		line = fmt.Sprintf("total += %d + %s", tagSize(fieldNum, 0), ctx.signedSizeExpr(fieldName))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		line = fmt.Sprintf("total += %d + codonUvarintSize(uint64(%s))", tagSize(fieldNum, 0), fieldName)
+	case reflect.Float32:
+		line = fmt.Sprintf("total += %d", tagSize(fieldNum, 5)+4)
+	case reflect.Float64:
+		line = fmt.Sprintf("total += %d", tagSize(fieldNum, 1)+8)
+	case reflect.String, reflect.Array:
+		line = fmt.Sprintf("total += %d + codonByteSliceSize(len(%s))", tagSize(fieldNum, 2), fieldName)
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			line = fmt.Sprintf("total += %d + codonByteSliceSize(len(%s))", tagSize(fieldNum, 2), fieldName)
+		} else if isPacked(t) {
+			*lines = append(*lines, fmt.Sprintf("if len(%s) != 0 {", fieldName))
+			genMessageSizeLines(fieldNum, ctx.packedSizeExpr(t.Elem(), fieldName, iterLevel), lines)
+			line = "}"
+		} else {
+			iterVar := fmt.Sprintf("_%d", iterLevel)
+			*lines = append(*lines, fmt.Sprintf("for %s:=0; %s<len(%s); %s++ {",
+				iterVar, iterVar, fieldName, iterVar))
+			ctx.enter("[]")
+			ctx.genFieldSizeLines(fieldNum, t.Elem(), lines, fieldName+"["+iterVar+"]", iterLevel+1)
+			ctx.leave()
+			line = "}"
+		}
+	case reflect.Interface:
+		typePath := t.PkgPath() + "." + t.Name()
+		alias, ok := ctx.ifcPath2Alias[typePath]
+		if !ok {
+			panic("Cannot find alias for:" + typePath)
+		}
+		genMessageSizeLines(fieldNum, fmt.Sprintf("size%s(%s, s)", alias, fieldName), lines)
+		return
+	case reflect.Struct:
+		if _, ok := ctx.leafTypes[t.PkgPath()+"."+t.Name()]; ok {
+			// leaf types do not provide size functions, so they must be encoded to get their sizes
+			if isPtr {
+				fieldName = "*(" + fieldName + ")"
+			}
+			line = fmt.Sprintf("total += %d + codonByteSliceSize(len(Encode%s(%s)))", tagSize(fieldNum, 2), t.Name(), fieldName)
+		} else {
+			genMessageSizeLines(fieldNum, ctx.structSizeExpr(t, fieldName, isPtr, iterLevel), lines)
+			return
 		}
 	default:
 		panic(fmt.Sprintf("Unknown Kind %s", t.Kind()))
@@ -697,16 +1493,37 @@ func (ctx *context) genFieldEncLines(fieldNum int, t reflect.Type, lines *[]stri
 	*lines = append(*lines, line)
 }
 
-func (ctx *context) genStructEncLines(t reflect.Type, lines *[]string, varName string, iterLevel int) {
+func (ctx *context) genStructSizeLines(t Type, lines *[]string, varName string, iterLevel int) {
+	fieldNums := getFieldNums(t)
 	for i := 0; i < t.NumField(); i++ {
 		field := t.Field(i)
-		ctx.genFieldEncLines(i+1, field.Type, lines, varName+"."+field.Name, iterLevel)
+		if isUnrecognizedField(field) {
+			continue
+		}
+		*lines = append(*lines, fieldMark(varName+"."+field.Name))
+		ctx.guard("."+field.Name, fieldKey(t, field), func() {
+			ctx.genNilableSizeLines(fieldNums[i], field.Type, lines, varName+"."+field.Name, iterLevel)
+		})
+		*lines = append(*lines, endMark())
+	}
+	if unrecognized := ctx.getUnrecognized(t, varName); len(unrecognized) != 0 {
+		*lines = append(*lines, fmt.Sprintf("total += len(%s)", unrecognized))
+	}
+}
+
+func (ctx *context) genNilableSizeLines(fieldNum int, t Type, lines *[]string, fieldName string, iterLevel int) {
+	if !isNilable(t) {
+		ctx.genFieldSizeLines(fieldNum, t, lines, fieldName, iterLevel)
+		return
 	}
+	*lines = append(*lines, fmt.Sprintf("if %s != nil {", fieldName))
+	ctx.genFieldSizeLines(fieldNum, t, lines, fieldName, iterLevel)
+	*lines = append(*lines, "} // end of nilable "+fieldName)
 }
 
 //=========================
 
-func (ctx *context) getTypeName(elemT reflect.Type) string {
+func (ctx *context) getTypeName(elemT Type) string {
 	if elemT.Kind() == reflect.Ptr {
 		panic("Should not reach here")
 	}
@@ -724,24 +1541,56 @@ func (ctx *context) getTypeName(elemT reflect.Type) string {
 	return alias
 }
 
-func (ctx *context) getTypeInfo(elemT reflect.Type) (string, bool) {
+// returns the type's expression in the generated code, such as "[]*Coin" and "map[string]Coin"
+// reports whether getTypeExpr can express t, which is false for the members of unregistered structs
+func (ctx *context) canExpressType(t Type) bool {
+	if ctx.isLeafType(t) {
+		return true
+	}
+	if len(t.Name()) != 0 {
+		typePath := t.PkgPath() + "." + t.Name()
+		_, isStruct := ctx.structPath2Alias[typePath]
+		_, isIfc := ctx.ifcPath2Alias[typePath]
+		return len(t.PkgPath()) == 0 || isStruct || isIfc
+	}
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		return ctx.canExpressType(t.Elem())
+	case reflect.Map:
+		return ctx.canExpressType(t.Key()) && ctx.canExpressType(t.Elem())
+	default:
+		return false
+	}
+}
+
+func (ctx *context) getTypeExpr(t Type) string {
+	if alias, ok := ctx.leafTypes[t.PkgPath()+"."+t.Name()]; ok {
+		return alias
+	}
+	if len(t.Name()) != 0 {
+		return ctx.getTypeName(t)
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + ctx.getTypeExpr(t.Elem())
+	case reflect.Slice:
+		return "[]" + ctx.getTypeExpr(t.Elem())
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), ctx.getTypeExpr(t.Elem()))
+	case reflect.Map:
+		return fmt.Sprintf("map[%s]%s", ctx.getTypeExpr(t.Key()), ctx.getTypeExpr(t.Elem()))
+	default:
+		panic(fmt.Sprintf("Cannot express type %s", t))
+	}
+}
+
+func (ctx *context) getTypeInfo(elemT Type) (string, bool) {
 	isPtr := false
 	if elemT.Kind() == reflect.Ptr {
 		elemT = elemT.Elem()
 		isPtr = true
 	}
 	if len(elemT.PkgPath()) == 0 {
-		return elemT.Name(), isPtr //basic type
-	}
-	typePath := elemT.PkgPath() + "." + elemT.Name()
-	alias, ok := ctx.structPath2Alias[typePath]
-	if !ok {
-		alias, ok = ctx.ifcPath2Alias[typePath]
-	}
-	if !ok {
-		panic(typePath + " is not registered")
-	}
-	if !ok {
 		if elemT.Kind() == reflect.Array {
 			panic("Do not support slice/array of arrays")
 		}
@@ -752,19 +1601,41 @@ func (ctx *context) getTypeInfo(elemT reflect.Type) (string, bool) {
 				panic("Do not support slice/array of slices, except for byte slices")
 			}
 		}
+		return elemT.Name(), isPtr //basic type
+	}
+	typePath := elemT.PkgPath() + "." + elemT.Name()
+	alias, ok := ctx.structPath2Alias[typePath]
+	if !ok {
+		alias, ok = ctx.ifcPath2Alias[typePath]
+	}
+	if !ok {
+		panic(typePath + " is not registered")
 	}
 	return alias, isPtr
 }
 
-func (ctx *context) buildDecLine(typeName, fieldName, ending string, t reflect.Type) string {
+func (ctx *context) buildDecLine(typeName, fieldName, ending string, t Type) string {
+	funcName := decodeFuncName(typeName)
+	if ctx.opts.TwosComplementInts && isSignedInt(t) {
+		// the conversion from uint64 restores the negative numbers
+		funcName = "codonDecodeUint64"
+	}
 	if len(t.PkgPath()) == 0 {
-		return fmt.Sprintf("%s = %s(codonDecode%s(bz, &n, &err))%s", fieldName, strings.ToLower(typeName), typeName, ending)
+		return fmt.Sprintf("%s = %s(%s(bz, &n, &err))%s", fieldName, strings.ToLower(typeName), funcName, ending)
 	}
 	alias := ctx.getTypeName(t)
-	return fmt.Sprintf("%s = %s(codonDecode%s(bz, &n, &err))%s", fieldName, alias, typeName, ending)
+	return fmt.Sprintf("%s = %s(%s(bz, &n, &err))%s", fieldName, alias, funcName, ending)
 }
 
-func (ctx *context) initPtrMember(fieldName string, t reflect.Type) string {
+// strings are decoded by codonDecoder, which checks their lengths
+func decodeFuncName(typeName string) string {
+	if typeName == "String" {
+		return "d.decodeString"
+	}
+	return "codonDecode" + typeName
+}
+
+func (ctx *context) initPtrMember(fieldName string, t Type) string {
 	typePath := t.PkgPath() + "." + t.Name()
 	alias, ok := ctx.structPath2Alias[typePath]
 	if !ok {
@@ -776,7 +1647,7 @@ func (ctx *context) initPtrMember(fieldName string, t reflect.Type) string {
 	return fmt.Sprintf("%s = &%s{}", fieldName, alias)
 }
 
-func (ctx *context) genFieldDecLines(fieldNum int, t reflect.Type, lines *[]string, fieldName string, iterLevel int) {
+func (ctx *context) genFieldDecLines(fieldNum int, t Type, lines *[]string, fieldName string, iterLevel int) {
 	if fieldNum >= MaxFieldNum {
 		panic("Field Number is too large")
 	}
@@ -806,11 +1677,30 @@ func (ctx *context) genFieldDecLines(fieldNum int, t reflect.Type, lines *[]stri
 	case reflect.Complex128:
 		panic("Complex128 is not supported")
 	case reflect.Map:
-		panic("Map is not supported")
-	case reflect.Float32:
-		panic("Float32 is not supported")
-	case reflect.Float64:
-		panic("Float64 is not supported")
+		checkMapType(t)
+		key := fmt.Sprintf("key_%d", iterLevel)
+		value := fmt.Sprintf("value_%d", iterLevel)
+		*lines = append(*lines, genSliceLengthCheck(fieldName))
+		*lines = append(*lines, beforeDecodeFunc)
+		*lines = append(*lines, fmt.Sprintf("var %s %s", key, ctx.getTypeExpr(t.Key())))
+		*lines = append(*lines, fmt.Sprintf("var %s %s", value, ctx.getTypeExpr(t.Elem())))
+		*lines = append(*lines, "func(bz []byte) {")
+		*lines = append(*lines, "for len(bz) != 0 {")
+		*lines = append(*lines, fmt.Sprintf("tag := codonDecodeUint64(bz, &n, &err)%s", ending))
+		*lines = append(*lines, "switch tag >> 3 {")
+		*lines = append(*lines, "case 1: // "+key)
+		ctx.genFieldDecLines(1, t.Key(), lines, key, iterLevel+1)
+		*lines = append(*lines, "case 2: // "+value)
+		ctx.enter("[]")
+		ctx.genFieldDecLines(2, t.Elem(), lines, value, iterLevel+1)
+		ctx.leave()
+		ctx.genUnknownFieldLines(lines, "")
+		*lines = append(*lines, "} // end for")
+		*lines = append(*lines, "}(bz[:l]) // end func")
+		*lines = append(*lines, "if err != nil {return}")
+		*lines = append(*lines, "bz = bz[l:]\nn += int(l)")
+		*lines = append(*lines, fmt.Sprintf("if %s == nil {\n%s = make(%s)\n}", fieldName, fieldName, ctx.getTypeExpr(t)))
+		line = fmt.Sprintf("%s[%s] = %s", fieldName, key, value)
 	case reflect.Bool:
 		line = ctx.buildDecLine("Bool", fieldName, ending, t)
 	case reflect.Int:
@@ -833,6 +1723,10 @@ func (ctx *context) genFieldDecLines(fieldNum int, t reflect.Type, lines *[]stri
 		line = ctx.buildDecLine("Uint32", fieldName, ending, t)
 	case reflect.Uint64:
 		line = ctx.buildDecLine("Uint64", fieldName, ending, t)
+	case reflect.Float32:
+		line = ctx.buildDecLine("Float32", fieldName, ending, t)
+	case reflect.Float64:
+		line = ctx.buildDecLine("Float64", fieldName, ending, t)
 	case reflect.String:
 		line = ctx.buildDecLine("String", fieldName, ending, t)
 	case reflect.Array:
@@ -844,32 +1738,37 @@ func (ctx *context) genFieldDecLines(fieldNum int, t reflect.Type, lines *[]stri
 	case reflect.Slice:
 		typeName, isPtr := ctx.getTypeInfo(t.Elem())
 		elemT := t.Elem()
-		if isPtr {
-			if elemT.Kind() == reflect.Interface || elemT.Kind() == reflect.Struct {
-				*lines = append(*lines, beforeDecodeFunc)
-				line = fmt.Sprintf("var tmp %s\ntmp, n, err = Decode%s(bz[:l])%s",
-					typeName, typeName, ending)
-				*lines = append(*lines, line)
-				*lines = append(*lines, afterDecodeFunc)
-			} else {
-				ctx.genFieldDecLines(fieldNum, elemT, lines, "tmp", iterLevel+1)
-			}
+		// the packed form checks the length of each of its elements
+		if isPtr || (elemT.Kind() != reflect.Uint8 && !isPacked(t)) {
+			*lines = append(*lines, genSliceLengthCheck(fieldName))
+		}
+		if isPtr { // only pointers to registered structs are supported
+			*lines = append(*lines, beforeDecodeFunc)
+			line = fmt.Sprintf("var tmp %s\ntmp, n, err = decode%s(bz[:l], d)%s",
+				typeName, typeName, ending)
+			*lines = append(*lines, line)
+			*lines = append(*lines, afterDecodeFunc)
 			line = fmt.Sprintf("%s = append(%s, &tmp)", fieldName, fieldName)
 		} else {
 			if elemT.Kind() == reflect.Uint8 {
-				line = fmt.Sprintf("var tmpBz []byte\nn, err = codonGetByteSlice(&tmpBz, bz)%s", ending)
+				line = fmt.Sprintf("var tmpBz []byte\nn, err = d.getByteSlice(&tmpBz, bz)%s", ending)
 				*lines = append(*lines, line)
 				line = fmt.Sprintf("%s = tmpBz", fieldName)
 			} else {
 				if elemT.Kind() == reflect.Interface || elemT.Kind() == reflect.Struct {
 					*lines = append(*lines, beforeDecodeFunc)
-					line = fmt.Sprintf("var tmp %s\ntmp, n, err = Decode%s(bz[:l])%s",
+					line = fmt.Sprintf("var tmp %s\ntmp, n, err = decode%s(bz[:l], d)%s",
 						typeName, typeName, ending)
 					*lines = append(*lines, line)
 					*lines = append(*lines, afterDecodeFunc)
+				} else if isPacked(t) {
+					ctx.genPackedDecLines(fieldNum, t, lines, fieldName, iterLevel)
+					return
 				} else {
 					*lines = append(*lines, fmt.Sprintf("var tmp %s", typeName))
+					ctx.enter("[]")
 					ctx.genFieldDecLines(fieldNum, elemT, lines, "tmp", iterLevel+1)
+					ctx.leave()
 				}
 				line = fmt.Sprintf("%s = append(%s, tmp)", fieldName, fieldName)
 			}
@@ -881,7 +1780,7 @@ func (ctx *context) genFieldDecLines(fieldNum int, t reflect.Type, lines *[]stri
 			panic("Cannot find alias for:" + typePath)
 		}
 		*lines = append(*lines, beforeDecodeFunc)
-		line = fmt.Sprintf("%s, n, err = Decode%s(bz[:l])%s // interface_decode", fieldName, alias, ending)
+		line = fmt.Sprintf("%s, n, err = decode%s(bz[:l], d)%s // interface_decode", fieldName, alias, ending)
 		*lines = append(*lines, line)
 		line = afterDecodeFunc
 	case reflect.Ptr:
@@ -902,17 +1801,18 @@ func (ctx *context) genFieldDecLines(fieldNum int, t reflect.Type, lines *[]stri
 			if isPtr {
 				*lines = append(*lines, ctx.initPtrMember(fieldName, t))
 			}
-			*lines = append(*lines, fmt.Sprintf("l := codonDecodeUint64(bz, &n, &err)%s", ending))
+			*lines = append(*lines, beforeDecodeFunc)
+			*lines = append(*lines, "if err = d.enter(); err != nil {\nreturn\n}")
 			*lines = append(*lines, "func(bz []byte) {")
 			*lines = append(*lines, "for len(bz) != 0 {")
 			*lines = append(*lines, fmt.Sprintf("tag := codonDecodeUint64(bz, &n, &err)%s", ending))
-			*lines = append(*lines, "tag = tag >> 3")
-			*lines = append(*lines, "switch tag {")
+			*lines = append(*lines, "switch tag >> 3 {")
 			ctx.genStructDecLines(t, lines, fieldName, iterLevel)
-			*lines = append(*lines, "default: err = errors.New(\"Unknown Field\")\nreturn\n}")
+			ctx.genUnknownFieldLines(lines, ctx.getUnrecognized(t, fieldName))
 			*lines = append(*lines, "} // end for")
 			*lines = append(*lines, "}(bz[:l]) // end func")
 			*lines = append(*lines, "if err != nil {return}")
+			*lines = append(*lines, "d.leave()")
 			*lines = append(*lines, "bz = bz[l:]\nn += int(l)")
 		}
 	default:
@@ -921,18 +1821,189 @@ func (ctx *context) genFieldDecLines(fieldNum int, t reflect.Type, lines *[]stri
 	*lines = append(*lines, line)
 }
 
-func (ctx *context) genStructDecLines(t reflect.Type, lines *[]string, varName string, iterLevel int) {
+// A repeated scalar may be met in the packed form (wire type 2) or as one of its elements with its own tag
+func (ctx *context) genPackedDecLines(fieldNum int, t Type, lines *[]string, fieldName string, iterLevel int) {
+	typeName, _ := ctx.getTypeInfo(t.Elem())
+	elemLines := []string{genSliceLengthCheck(fieldName), fmt.Sprintf("var tmp %s", typeName)}
+	ctx.enter("[]")
+	ctx.genFieldDecLines(fieldNum, t.Elem(), &elemLines, "tmp", iterLevel+1)
+	ctx.leave()
+	elemLines = append(elemLines, fmt.Sprintf("%s = append(%s, tmp)", fieldName, fieldName))
+
+	*lines = append(*lines, "if tag&7 == 2 { // packed "+fieldName)
+	*lines = append(*lines, beforeDecodeFunc)
+	*lines = append(*lines, "func(bz []byte) {")
+	*lines = append(*lines, "for len(bz) != 0 {")
+	*lines = append(*lines, elemLines...)
+	*lines = append(*lines, "}")
+	*lines = append(*lines, "}(bz[:l]) // end func")
+	*lines = append(*lines, "if err != nil {return}")
+	*lines = append(*lines, "bz = bz[l:]")
+	*lines = append(*lines, "} else {")
+	*lines = append(*lines, elemLines...)
+	*lines = append(*lines, "} // end of packed "+fieldName)
+}
+
+// checked before an element is appended to a repeated field or a map
+func genSliceLengthCheck(fieldName string) string {
+	return fmt.Sprintf("if err = d.checkSliceLength(len(%s)+1); err != nil {\nreturn\n}", fieldName)
+}
+
+// The default case of the switch in decoders, which handles unknown fields
+func (ctx *context) genUnknownFieldLines(lines *[]string, unrecognized string) {
+	if !ctx.opts.SkipUnknownFields {
+		*lines = append(*lines, "default: err = errors.New(\"Unknown Field\")\nreturn\n}")
+		return
+	}
+	*lines = append(*lines, "default:")
+	*lines = append(*lines, "n, err = codonSkipField(bz, int(tag&7))\nif err != nil {return}")
+	if len(unrecognized) != 0 {
+		*lines = append(*lines, fmt.Sprintf("codonWriteUvarint(&%s, tag)", unrecognized))
+		*lines = append(*lines, fmt.Sprintf("%s = append(%s, bz[:n]...)", unrecognized, unrecognized))
+	}
+	*lines = append(*lines, "bz = bz[n:]\ntotal+=n\n}")
+}
+
+func isUnrecognizedField(field StructField) bool {
+	return field.Name == UnrecognizedFieldName && field.Type.Kind() == reflect.Slice &&
+		field.Type.Elem().Kind() == reflect.Uint8
+}
+
+// Returns the XXX_unrecognized member which keeps the skipped unknown fields, or "" if it should not be kept
+func (ctx *context) getUnrecognized(t Type, varName string) string {
+	if !ctx.opts.SkipUnknownFields || !ctx.opts.KeepUnrecognized {
+		return ""
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if isUnrecognizedField(t.Field(i)) {
+			return varName + "." + UnrecognizedFieldName
+		}
+	}
+	return ""
+}
+
+func (ctx *context) genStructDecLines(t Type, lines *[]string, varName string, iterLevel int) {
+	fieldNums := getFieldNums(t)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if isUnrecognizedField(field) {
+			continue
+		}
+		fieldName := varName + "." + field.Name
+		*lines = append(*lines, fieldMark(fieldName))
+		*lines = append(*lines, fmt.Sprintf("case %d: // %s", fieldNums[i], fieldName))
+		ctx.guard("."+field.Name, fieldKey(t, field), func() {
+			ctx.genFieldDecLines(fieldNums[i], field.Type, lines, fieldName, iterLevel)
+		})
+		*lines = append(*lines, endMark())
+	}
+}
+
+// Peek<Alias>_<Field> decodes only one field from the bytes written by Encode<Alias>. The other fields,
+// including their sub-messages, are skipped by their lengths without being checked. The fields of the
+// registered structs inside are reached by paths such as PeekStdTx_Fee_Amount, which only descend into the
+// sub-messages on the path.
+func (ctx *context) genPeekFuncs(alias string, t Type) []string {
+	var lines []string
+	visiting := map[string]bool{t.PkgPath() + "." + t.Name(): true}
+	ctx.genPeekFuncsAt(alias, nil, t, &lines, visiting)
+	return lines
+}
+
+// a field on the path of a Peek function
+type peekStep struct {
+	field    StructField
+	fieldNum int
+}
+
+// generates the Peek functions for t's fields, whose parents are on the path. visiting contains the
+// structs on the path, which are not descended into again.
+func (ctx *context) genPeekFuncsAt(alias string, path []peekStep, t Type, lines *[]string, visiting map[string]bool) {
+	fieldNums := getFieldNums(t)
 	for i := 0; i < t.NumField(); i++ {
 		field := t.Field(i)
-		fieldName := varName+"."+field.Name
-		*lines = append(*lines, fmt.Sprintf("case %d: // %s", i, fieldName))
-		ctx.genFieldDecLines(i+1, field.Type, lines, fieldName, iterLevel)
+		// the result type of the Peek function must be expressible
+		if isUnrecognizedField(field) || isMutex(field.Type) || !ctx.canExpressType(field.Type) {
+			continue
+		}
+		steps := append(path[:len(path):len(path)], peekStep{field: field, fieldNum: fieldNums[i]})
+		*lines = append(*lines, fieldMark(peekFieldPath(steps)))
+		ctx.guard("."+field.Name, fieldKey(t, field), func() {
+			*lines = append(*lines, ctx.genPeekFunc(alias, steps)...)
+		})
+		*lines = append(*lines, endMark())
+
+		structT := field.Type
+		if structT.Kind() == reflect.Ptr {
+			structT = structT.Elem()
+		}
+		typePath := structT.PkgPath() + "." + structT.Name()
+		if _, ok := ctx.structPath2Alias[typePath]; !ok || structT.Kind() != reflect.Struct ||
+			ctx.isLeafType(structT) || visiting[typePath] {
+			continue
+		}
+		visiting[typePath] = true
+		ctx.enter("." + field.Name)
+		ctx.genPeekFuncsAt(alias, steps, structT, lines, visiting)
+		ctx.leave()
+		delete(visiting, typePath)
+	}
+}
+
+// such as "v.Fee.Amount"
+func peekFieldPath(steps []peekStep) string {
+	fieldName := "v"
+	for _, step := range steps {
+		fieldName += "." + step.field.Name
 	}
+	return fieldName
+}
+
+// The sub-messages on the path are decoded in nested closures, like the struct members in genFieldDecLines.
+// When a member on the path appears more than once, the results are merged, as Decode<Alias> does.
+func (ctx *context) genPeekFunc(alias string, steps []peekStep) []string {
+	last := steps[len(steps)-1]
+	funcName := "Peek" + alias
+	for _, step := range steps {
+		funcName += "_" + step.field.Name
+	}
+	resType := ctx.getTypeExpr(last.field.Type)
+	lines := []string{fmt.Sprintf("func %s(bz []byte) (res %s, err error) {", funcName, resType)}
+	lines = append(lines, "var d *codonDecoder\nvar n, total int")
+	for i, step := range steps {
+		lines = append(lines, "for len(bz) != 0 {")
+		lines = append(lines, fmt.Sprintf("tag := codonDecodeUint64(bz, &n, &err)%s", ending))
+		lines = append(lines, "switch tag >> 3 {")
+		lines = append(lines, fmt.Sprintf("case %d: // %s", step.fieldNum, peekFieldPath(steps[:i+1])))
+		if i == len(steps)-1 {
+			ctx.genFieldDecLines(step.fieldNum, step.field.Type, &lines, "res", 0)
+			continue
+		}
+		if step.field.Type.Kind() == reflect.Ptr { // Decode<Alias> allocates a new struct for a pointer
+			lines = append(lines, fmt.Sprintf("var zero %s\nres = zero", resType))
+		}
+		lines = append(lines, beforeDecodeFunc)
+		lines = append(lines, "func(bz []byte) {")
+	}
+	for i := len(steps) - 1; i >= 0; i-- {
+		if i != len(steps)-1 {
+			lines = append(lines, "}(bz[:l]) // end func")
+			lines = append(lines, "if err != nil {return}")
+			lines = append(lines, "bz = bz[l:]\ntotal += int(l)")
+		}
+		lines = append(lines, "default:")
+		lines = append(lines, "n, err = codonSkipField(bz, int(tag&7))"+ending)
+		lines = append(lines, "} // end switch\n} // end for")
+	}
+	lines = append(lines, "_ = d")
+	lines = append(lines, "return res, nil")
+	lines = append(lines, fmt.Sprintf("} //End of %s\n", funcName))
+	return lines
 }
 
 //======================
 
-func (ctx *context) buildRandLine(typeName, fieldName string, t reflect.Type) string {
+func (ctx *context) buildRandLine(typeName, fieldName string, t Type) string {
 	if len(t.PkgPath()) == 0 {
 		return fmt.Sprintf("%s = r.Get%s()", fieldName, typeName)
 	}
@@ -940,7 +2011,7 @@ func (ctx *context) buildRandLine(typeName, fieldName string, t reflect.Type) st
 	return fmt.Sprintf("%s = %s(r.Get%s())", fieldName, alias, typeName)
 }
 
-func (ctx *context) genFieldRandLines(t reflect.Type, lines *[]string, fieldName string, iterLevel int) bool {
+func (ctx *context) genFieldRandLines(t Type, lines *[]string, fieldName string, iterLevel int) bool {
 	if isMutex(t) {
 		return false
 	}
@@ -968,7 +2039,26 @@ func (ctx *context) genFieldRandLines(t reflect.Type, lines *[]string, fieldName
 	case reflect.Complex128:
 		panic("Complex128 is not supported")
 	case reflect.Map:
-		panic("Map is not supported")
+		checkMapType(t)
+		needLength = true
+		*lines = append(*lines, "length = 1+int(r.GetUint()%(MaxSliceLength-1))")
+		*lines = append(*lines, fmt.Sprintf("%s = make(%s, length)", fieldName, ctx.getTypeExpr(t)))
+		iterVar := fmt.Sprintf("_%d", iterLevel)
+		key := fmt.Sprintf("key_%d", iterLevel)
+		value := fmt.Sprintf("value_%d", iterLevel)
+		line = fmt.Sprintf("for %s, length_%d := 0, length; %s<length_%d; %s++ { //map of %s",
+			iterVar, iterLevel, iterVar, iterLevel, iterVar, t.Elem().Kind())
+		*lines = append(*lines, line)
+		*lines = append(*lines, fmt.Sprintf("var %s %s", key, ctx.getTypeExpr(t.Key())))
+		*lines = append(*lines, fmt.Sprintf("var %s %s", value, ctx.getTypeExpr(t.Elem())))
+		nl := ctx.genFieldRandLines(t.Key(), lines, key, iterLevel+1)
+		needLength = needLength || nl
+		ctx.enter("[]")
+		nl = ctx.genNilableRandLines(t.Elem(), lines, value, iterLevel+1)
+		ctx.leave()
+		needLength = needLength || nl
+		*lines = append(*lines, fmt.Sprintf("%s[%s] = %s", fieldName, key, value))
+		line = "}"
 	case reflect.Bool:
 		line = ctx.buildRandLine("Bool", fieldName, t)
 	case reflect.Int:
@@ -1023,13 +2113,15 @@ func (ctx *context) genFieldRandLines(t reflect.Type, lines *[]string, fieldName
 				initVar, iterVar, iterLevel, iterVar, t.Kind(), elemT.Kind())
 			*lines = append(*lines, line)
 			if elemT.Kind() == reflect.Interface || elemT.Kind() == reflect.Struct {
-				line = fmt.Sprintf("tmp := Rand%s(bz)%s", typeName)
+				line = fmt.Sprintf("tmp := Rand%s(r)", typeName)
 				*lines = append(*lines, line)
 				line = fmt.Sprintf("%s[%s] = &tmp", fieldName, iterVar)
 				*lines = append(*lines, line)
 			} else {
 				varName := fieldName + "[" + iterVar + "]"
+				ctx.enter("[]")
 				nl := ctx.genFieldRandLines(elemT, lines, varName, iterLevel+1)
+				ctx.leave()
 				needLength = needLength || nl
 			}
 			line = "}"
@@ -1052,7 +2144,9 @@ func (ctx *context) genFieldRandLines(t reflect.Type, lines *[]string, fieldName
 					*lines = append(*lines, line)
 				} else {
 					varName := fieldName + "[" + iterVar + "]"
+					ctx.enter("[]")
 					nl := ctx.genFieldRandLines(elemT, lines, varName, iterLevel+1)
+					ctx.leave()
 					needLength = needLength || nl
 				}
 				line = "}"
@@ -1090,19 +2184,37 @@ func (ctx *context) genFieldRandLines(t reflect.Type, lines *[]string, fieldName
 	return needLength
 }
 
-func (ctx *context) genStructRandLines(t reflect.Type, lines *[]string, varName string, iterLevel int) bool {
+func (ctx *context) genStructRandLines(t Type, lines *[]string, varName string, iterLevel int) bool {
 	needLength := false
 	for i := 0; i < t.NumField(); i++ {
 		field := t.Field(i)
-		nl := ctx.genFieldRandLines(field.Type, lines, varName+"."+field.Name, iterLevel)
-		needLength = needLength || nl
+		if isUnrecognizedField(field) { // left as nil, because random bytes are not valid fields
+			continue
+		}
+		*lines = append(*lines, fieldMark(varName+"."+field.Name))
+		ctx.guard("."+field.Name, fieldKey(t, field), func() {
+			nl := ctx.genNilableRandLines(field.Type, lines, varName+"."+field.Name, iterLevel)
+			needLength = needLength || nl
+		})
+		*lines = append(*lines, endMark())
 	}
 	return needLength
 }
 
+// Pointers and interfaces are left as nil in one out of four cases, such that nil values are also tested
+func (ctx *context) genNilableRandLines(t Type, lines *[]string, fieldName string, iterLevel int) bool {
+	if !isNilable(t) {
+		return ctx.genFieldRandLines(t, lines, fieldName, iterLevel)
+	}
+	*lines = append(*lines, "if r.GetUint()%4 != 0 {")
+	needLength := ctx.genFieldRandLines(t, lines, fieldName, iterLevel)
+	*lines = append(*lines, "} // end of nilable "+fieldName)
+	return needLength
+}
+
 //===================================================================
 
-func (ctx *context) genFieldDeepCopyLines(t reflect.Type, lines *[]string, fieldName string, iterLevel int) bool {
+func (ctx *context) genFieldDeepCopyLines(t Type, lines *[]string, fieldName string, iterLevel int) bool {
 	if isMutex(t) {
 		return false
 	}
@@ -1130,11 +2242,27 @@ func (ctx *context) genFieldDeepCopyLines(t reflect.Type, lines *[]string, field
 	case reflect.Complex128:
 		panic("Complex128 is not supported")
 	case reflect.Map:
-		panic("Map is not supported")
+		checkMapType(t)
+		needLength = true
+		*lines = append(*lines, fmt.Sprintf("length = len(in%s)", fieldName))
+		makeMap := fmt.Sprintf("if length==0 {out%s = nil\n} else {\nout%s = make(%s, length)\n}",
+			fieldName, fieldName, ctx.getTypeExpr(t))
+		*lines = append(*lines, makeMap)
+		// "in"+value and "out"+value are the names of the temporary variables
+		key := fmt.Sprintf("key_%d", iterLevel)
+		value := fmt.Sprintf("Value_%d", iterLevel)
+		*lines = append(*lines, fmt.Sprintf("for %s, in%s := range in%s {", key, value, fieldName))
+		*lines = append(*lines, fmt.Sprintf("var out%s %s", value, ctx.getTypeExpr(t.Elem())))
+		ctx.enter("[]")
+		nl := ctx.genNilableDeepCopyLines(t.Elem(), lines, value, iterLevel+1)
+		ctx.leave()
+		needLength = needLength || nl
+		*lines = append(*lines, fmt.Sprintf("out%s[%s] = out%s", fieldName, key, value))
+		line = "}"
 	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16,
-	reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8,
-	reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32,
-	reflect.Float64, reflect.String:
+		reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8,
+		reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32,
+		reflect.Float64, reflect.String:
 		line = fmt.Sprintf("out%s = in%s", fieldName, fieldName)
 	case reflect.Array, reflect.Slice:
 		line = fmt.Sprintf("length = len(in%s)", fieldName)
@@ -1154,13 +2282,15 @@ func (ctx *context) genFieldDeepCopyLines(t reflect.Type, lines *[]string, field
 				initVar, iterVar, iterLevel, iterVar, t.Kind(), elemT.Kind())
 			*lines = append(*lines, line)
 			if elemT.Kind() == reflect.Interface || elemT.Kind() == reflect.Struct {
-				line = fmt.Sprintf("tmp = DeepCopy%s(in%s[%s])", typeName, fieldName, iterVar)
+				line = fmt.Sprintf("tmp := DeepCopy%s(*(in%s[%s]))", typeName, fieldName, iterVar)
 				*lines = append(*lines, line)
 				line = fmt.Sprintf("out%s[%s] = &tmp", fieldName, iterVar)
 				*lines = append(*lines, line)
 			} else {
 				varName := fieldName + "[" + iterVar + "]"
+				ctx.enter("[]")
 				nl := ctx.genFieldDeepCopyLines(elemT, lines, varName, iterLevel+1)
+				ctx.leave()
 				needLength = needLength || nl
 			}
 			line = "}"
@@ -1183,7 +2313,9 @@ func (ctx *context) genFieldDeepCopyLines(t reflect.Type, lines *[]string, field
 					*lines = append(*lines, line)
 				} else {
 					varName := fieldName + "[" + iterVar + "]"
+					ctx.enter("[]")
 					nl := ctx.genFieldDeepCopyLines(elemT, lines, varName, iterLevel+1)
+					ctx.leave()
 					needLength = needLength || nl
 				}
 				line = "}"
@@ -1221,15 +2353,151 @@ func (ctx *context) genFieldDeepCopyLines(t reflect.Type, lines *[]string, field
 	return needLength
 }
 
-
-func (ctx *context) genStructDeepCopyLines(t reflect.Type, lines *[]string, fieldPrefix string, iterLevel int) bool {
+func (ctx *context) genStructDeepCopyLines(t Type, lines *[]string, fieldPrefix string, iterLevel int) bool {
 	needLength := false
 	for i := 0; i < t.NumField(); i++ {
 		field := t.Field(i)
-		newPrefix := fieldPrefix+"."+field.Name
-		nl := ctx.genFieldDeepCopyLines(field.Type, lines, newPrefix, iterLevel)
-		needLength = needLength || nl
+		newPrefix := fieldPrefix + "." + field.Name
+		*lines = append(*lines, fieldMark(newPrefix))
+		ctx.guard("."+field.Name, fieldKey(t, field), func() {
+			nl := ctx.genNilableDeepCopyLines(field.Type, lines, newPrefix, iterLevel)
+			needLength = needLength || nl
+		})
+		*lines = append(*lines, endMark())
 	}
 	return needLength
 }
 
+// A nil pointer or a nil interface is copied as nil
+func (ctx *context) genNilableDeepCopyLines(t Type, lines *[]string, fieldName string, iterLevel int) bool {
+	if !isNilable(t) {
+		return ctx.genFieldDeepCopyLines(t, lines, fieldName, iterLevel)
+	}
+	*lines = append(*lines, fmt.Sprintf("if in%s != nil {", fieldName))
+	needLength := ctx.genFieldDeepCopyLines(t, lines, fieldName, iterLevel)
+	*lines = append(*lines, "} // end of nilable "+fieldName)
+	return needLength
+}
+
+//===================================================================
+
+// The generated Equal functions compare two values by what the encoding can tell apart: nil and
+// empty slices (or maps) are equal, floats are compared by their bits, and leaf types by their encoded bytes
+func (ctx *context) genFieldEqualLines(t Type, lines *[]string, fieldName string, iterLevel int) {
+	if isMutex(t) {
+		return
+	}
+	isPtr := false
+	if t.Kind() == reflect.Ptr {
+		isPtr = true
+		elemT := t.Elem()
+		if elemT.Kind() == reflect.Struct {
+			t = elemT
+		} else {
+			panic(fmt.Sprintf("Pointer to %s is not supported", elemT.Kind()))
+		}
+	}
+	var line string
+	switch t.Kind() {
+	case reflect.Chan:
+		panic("Channel is not supported")
+	case reflect.Func:
+		panic("Func is not supported")
+	case reflect.Uintptr:
+		panic("Uintptr is not supported")
+	case reflect.Complex64:
+		panic("Complex64 is not supported")
+	case reflect.Complex128:
+		panic("Complex128 is not supported")
+	case reflect.Map:
+		checkMapType(t)
+		*lines = append(*lines, fmt.Sprintf("if len(a%s) != len(b%s) {\nreturn false\n}", fieldName, fieldName))
+		// "a"+value and "b"+value are the names of the temporary variables
+		key := fmt.Sprintf("key_%d", iterLevel)
+		value := fmt.Sprintf("Value_%d", iterLevel)
+		*lines = append(*lines, fmt.Sprintf("for %s, a%s := range a%s {", key, value, fieldName))
+		*lines = append(*lines, fmt.Sprintf("b%s, ok := b%s[%s]", value, fieldName, key))
+		*lines = append(*lines, "if !ok {\nreturn false\n}")
+		ctx.enter("[]")
+		ctx.genNilableEqualLines(t.Elem(), lines, value, iterLevel+1)
+		ctx.leave()
+		line = "}"
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16,
+		reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8,
+		reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.String:
+		line = fmt.Sprintf("if a%s != b%s {\nreturn false\n}", fieldName, fieldName)
+	case reflect.Float32:
+		line = fmt.Sprintf("if math.Float32bits(float32(a%s)) != math.Float32bits(float32(b%s)) {\nreturn false\n}",
+			fieldName, fieldName)
+	case reflect.Float64:
+		line = fmt.Sprintf("if math.Float64bits(float64(a%s)) != math.Float64bits(float64(b%s)) {\nreturn false\n}",
+			fieldName, fieldName)
+	case reflect.Array, reflect.Slice:
+		elemT := t.Elem()
+		if elemT.Kind() == reflect.Uint8 {
+			if t.Kind() == reflect.Slice {
+				line = fmt.Sprintf("if !bytes.Equal(a%s, b%s) {\nreturn false\n}", fieldName, fieldName)
+			} else {
+				line = fmt.Sprintf("if a%s != b%s {\nreturn false\n}", fieldName, fieldName)
+			}
+			break
+		}
+		if t.Kind() == reflect.Slice {
+			*lines = append(*lines, fmt.Sprintf("if len(a%s) != len(b%s) {\nreturn false\n}", fieldName, fieldName))
+		}
+		iterVar := fmt.Sprintf("_%d", iterLevel)
+		*lines = append(*lines, fmt.Sprintf("for %s := range a%s { //%s of %s", iterVar, fieldName, t.Kind(), elemT.Kind()))
+		ctx.enter("[]")
+		ctx.genNilableEqualLines(elemT, lines, fieldName+"["+iterVar+"]", iterLevel+1)
+		ctx.leave()
+		line = "}"
+	case reflect.Interface:
+		typePath := t.PkgPath() + "." + t.Name()
+		alias, ok := ctx.ifcPath2Alias[typePath]
+		if !ok {
+			panic("Cannot find alias for:" + typePath)
+		}
+		line = fmt.Sprintf("if !Equal%s(a%s, b%s) {\nreturn false\n}", alias, fieldName, fieldName)
+	case reflect.Ptr:
+		panic("Should not reach here")
+	case reflect.Struct:
+		if _, ok := ctx.leafTypes[t.PkgPath()+"."+t.Name()]; ok {
+			a, b := "a"+fieldName, "b"+fieldName
+			if isPtr {
+				a, b = "*("+a+")", "*("+b+")"
+			}
+			line = fmt.Sprintf("if !bytes.Equal(Encode%s(%s), Encode%s(%s)) {\nreturn false\n}", t.Name(), a, t.Name(), b)
+		} else {
+			ctx.genStructEqualLines(t, lines, fieldName, iterLevel)
+			line = "// end of " + fieldName
+		}
+	default:
+		panic(fmt.Sprintf("Unknown Kind %s", t.Kind()))
+	}
+	*lines = append(*lines, line)
+}
+
+func (ctx *context) genStructEqualLines(t Type, lines *[]string, fieldPrefix string, iterLevel int) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		newPrefix := fieldPrefix + "." + field.Name
+		*lines = append(*lines, fieldMark(newPrefix))
+		ctx.guard("."+field.Name, fieldKey(t, field), func() {
+			ctx.genNilableEqualLines(field.Type, lines, newPrefix, iterLevel)
+		})
+		*lines = append(*lines, endMark())
+	}
+}
+
+// A nil pointer is omitted when encoding, so it only equals another nil pointer.
+// The interfaces are compared by their Equal functions, which handle nil.
+func (ctx *context) genNilableEqualLines(t Type, lines *[]string, fieldName string, iterLevel int) {
+	if t.Kind() != reflect.Ptr {
+		ctx.genFieldEqualLines(t, lines, fieldName, iterLevel)
+		return
+	}
+	*lines = append(*lines, fmt.Sprintf("if (a%s == nil) != (b%s == nil) {\nreturn false\n}", fieldName, fieldName))
+	*lines = append(*lines, fmt.Sprintf("if a%s != nil {", fieldName))
+	ctx.genFieldEqualLines(t, lines, fieldName, iterLevel)
+	*lines = append(*lines, "} // end of nilable "+fieldName)
+}
@@ -0,0 +1,195 @@
+package codon_test
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/coinexchain/codon"
+	"github.com/coinexchain/codon/internal/fixture"
+	"github.com/coinexchain/codon/internal/fixture/plain"
+)
+
+var update = flag.Bool("update", false, "rewrite the generated files of the fixture")
+
+// The names are go-amino's, such that the codec of the fixture can be checked against go-amino
+func fixtureEntries() []codon.TypeEntry {
+	return []codon.TypeEntry{
+		{Alias: "Msg", Name: "Msg", Value: (*fixture.Msg)(nil)},
+		{Alias: "Coin", Name: "fixture/Coin", Value: fixture.Coin{}},
+		{Alias: "Fee", Name: "fixture/Fee", Value: fixture.Fee{}},
+		{Alias: "MsgSend", Name: "fixture/MsgSend", Value: fixture.MsgSend{}},
+		{Alias: "MsgVote", Name: "fixture/MsgVote", Value: fixture.MsgVote{}},
+		{Alias: "Tx", Name: "fixture/Tx", Value: fixture.Tx{}},
+		{Alias: "Extra", Name: "fixture/Extra", Value: fixture.Extra{}},
+	}
+}
+
+// go-amino writes int, int32 and int64 as two's complement varints
+var fixtureOptions = codon.GeneratorOptions{
+	AminoCompatible:    true,
+	TwosComplementInts: true,
+}
+
+// The second fixture uses the default encoding options
+func plainEntries() []codon.TypeEntry {
+	return []codon.TypeEntry{
+		{Alias: "Shape", Name: "Shape", Value: (*plain.Shape)(nil)},
+		{Alias: "Square", Name: "Square", Value: plain.Square{}},
+		{Alias: "Circle", Name: "Circle", Value: &plain.Circle{}},
+		{Alias: "Item", Name: "Item", Value: plain.Item{}},
+		{Alias: "Record", Name: "Record", Value: plain.Record{}},
+		{Alias: "RecordV1", Name: "RecordV1", Value: plain.RecordV1{}},
+		{Alias: "Note", Name: "Note", Value: plain.Note{}},
+		{Alias: "NoteV1", Name: "NoteV1", Value: plain.NoteV1{}},
+		{Alias: "Catalog", Name: "Catalog", Value: plain.Catalog{}},
+	}
+}
+
+var plainOptions = codon.GeneratorOptions{
+	SkipUnknownFields: true,
+	KeepUnrecognized:  true,
+}
+
+// generates the files of the fixture, and returns their contents by their paths
+func generateFixture(t *testing.T) map[string][]byte {
+	var codecBuf, fuzzBuf, serializableBuf bytes.Buffer
+	opts := fixtureOptions
+	opts.FuzzTestWriter = &fuzzBuf
+	err := codon.GenerateCodecFileWithOptions(&codecBuf, opts, map[string]string{}, map[string]string{},
+		fixtureEntries(), codon.BridgeLogic, codon.ImportsForBridgeLogic)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the file of GenerateSerializableImpl is put in the package of the types, and has no interfaces
+	var entries []codon.TypeEntry
+	for _, entry := range fixtureEntries() {
+		if entry.Alias != "Msg" && entry.Alias != "Tx" {
+			entries = append(entries, entry)
+		}
+	}
+	opts = fixtureOptions
+	opts.PackageName = "fixture"
+	err = codon.GenerateSerializableImplWithOptions(&serializableBuf, opts, map[string]string{}, map[string]string{},
+		entries, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var plainCodecBuf, plainFuzzBuf bytes.Buffer
+	opts = plainOptions
+	opts.FuzzTestWriter = &plainFuzzBuf
+	err = codon.GenerateCodecFileWithOptions(&plainCodecBuf, opts, map[string]string{}, map[string]string{},
+		plainEntries(), "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return map[string][]byte{
+		"internal/fixture/plain/codec/codec.go":           plainCodecBuf.Bytes(),
+		"internal/fixture/plain/codec/codec_fuzz_test.go": plainFuzzBuf.Bytes(),
+		"internal/fixture/plain/codec/types.proto":        dumpProto(t, plainOptions, plainEntries()),
+		"internal/fixture/codec/codec.go":                 codecBuf.Bytes(),
+		"internal/fixture/codec/codec_fuzz_test.go":       fuzzBuf.Bytes(),
+		"internal/fixture/serializable.go":                serializableBuf.Bytes(),
+	}
+}
+
+// DumpProtoFile prints to stdout, so stdout is redirected to a temporary file
+func dumpProto(t *testing.T, opts codon.GeneratorOptions, entries []codon.TypeEntry) []byte {
+	f, err := ioutil.TempFile("", "codon-*.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	stdout := os.Stdout
+	os.Stdout = f
+	err = codon.DumpProtoFileWithOptions(opts, map[string]string{}, map[string]string{}, entries)
+	os.Stdout = stdout
+	if err != nil {
+		t.Fatal(err)
+	}
+	bz, err := ioutil.ReadFile(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bz
+}
+
+type Note struct {
+	Title            string
+	XXX_unrecognized []byte
+	Body             string
+}
+
+type PinnedNote struct {
+	Title            string `codon:"3"`
+	XXX_unrecognized []byte
+	Body             string `codon:"1"`
+}
+
+// XXX_unrecognized has no field number, so it neither shifts Note.Body nor conflicts with the pinned numbers
+func TestUnrecognizedFieldHasNoNumber(t *testing.T) {
+	entries := []codon.TypeEntry{
+		{Alias: "Note", Name: "Note", Value: Note{}},
+		{Alias: "PinnedNote", Name: "PinnedNote", Value: PinnedNote{}},
+	}
+	opts := codon.GeneratorOptions{SkipUnknownFields: true, KeepUnrecognized: true}
+	var buf bytes.Buffer
+	err := codon.GenerateCodecFileWithOptions(&buf, opts, map[string]string{}, map[string]string{}, entries, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proto := dumpProto(t, opts, entries)
+	for _, field := range []string{"string Title = 1;", "string Body = 2;", "string Title = 3;", "string Body = 1;"} {
+		if !bytes.Contains(proto, []byte(field)) {
+			t.Errorf("the .proto file does not contain %q:\n%s", field, proto)
+		}
+	}
+	if bytes.Contains(proto, []byte("XXX_unrecognized")) {
+		t.Errorf("the .proto file contains XXX_unrecognized:\n%s", proto)
+	}
+}
+
+// the .proto file can not describe the amino-compatible mode of the fixture
+func TestDumpProtoRejectsAminoCompatible(t *testing.T) {
+	err := codon.DumpProtoFileWithOptions(fixtureOptions, map[string]string{}, map[string]string{}, fixtureEntries())
+	if err == nil {
+		t.Fatal("DumpProtoFileWithOptions accepts AminoCompatible")
+	}
+}
+
+// The generated files are compiled and tested as a part of this module. They must be regenerated
+// with "go test -run TestFixtureIsUpToDate -update" after the generator is changed.
+func TestFixtureIsUpToDate(t *testing.T) {
+	for path, content := range generateFixture(t) {
+		path = filepath.FromSlash(path)
+		if *update {
+			if err := ioutil.WriteFile(path, content, 0644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		old, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(old, content) {
+			t.Errorf("%s is out of date, run \"go test -run TestFixtureIsUpToDate -update\"", path)
+		}
+	}
+}
+
+func TestGenerationIsStable(t *testing.T) {
+	first, second := generateFixture(t), generateFixture(t)
+	for path, content := range first {
+		if !bytes.Equal(content, second[path]) {
+			t.Errorf("%s changes between two runs of the generator", path)
+		}
+	}
+}
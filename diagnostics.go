@@ -0,0 +1,118 @@
+package codon
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// GenerateError is a problem found in the registered types, such as an unsupported field
+type GenerateError struct {
+	// Where the problem is found, such as "StdTx.Msgs[].Inputs[].Coins". "[]" stands for the
+	// elements of slices and arrays, and the values of maps.
+	Path string
+	Msg  string
+}
+
+func (e *GenerateError) Error() string {
+	return e.Path + ": " + e.Msg
+}
+
+// GenerateErrors collects all the problems found in one run of a generator function
+type GenerateErrors []*GenerateError
+
+func (errs GenerateErrors) Error() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%d problem(s) found in the registered types:", len(errs)))
+	for _, e := range errs {
+		sb.WriteString("\n\t" + e.Error())
+	}
+	return sb.String()
+}
+
+// Unwrap makes the GenerateError list visible to errors.Is and errors.As
+func (errs GenerateErrors) Unwrap() []error {
+	res := make([]error, len(errs))
+	for i, e := range errs {
+		res[i] = e
+	}
+	return res
+}
+
+// The generator panics when it meets a problem. diagnostics recovers the panics at the types and
+// the fields, records them with their paths and lets the generation go on, such that all the
+// problems can be found in one run.
+type diagnostics struct {
+	path []string
+	errs GenerateErrors
+	// the same location is visited by encoding, decoding and the other generated functions,
+	// and only the first problem found at a location is recorded
+	seen map[string]bool
+}
+
+// enter and leave mark the elements of slices and maps in the path. If a panic is recovered
+// by guard in between, the path is restored by guard, so leave is not needed.
+func (d *diagnostics) enter(elem string) {
+	d.path = append(d.path, elem)
+}
+
+func (d *diagnostics) leave() {
+	d.path = d.path[:len(d.path)-1]
+}
+
+// guard runs f with elem appended to the path. If f panics, the panic is recorded as an error.
+// key identifies the location (such as a struct's field) for deduplication, and the path is used
+// if it is empty. Runtime errors are bugs of the generator instead of problems in the registered
+// types, so they are panicked again.
+func (d *diagnostics) guard(elem, key string, f func()) {
+	depth := len(d.path)
+	d.path = append(d.path, elem)
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(runtime.Error); ok {
+				panic(r)
+			}
+			d.addError(key, fmt.Sprint(r))
+		}
+		d.path = d.path[:depth]
+	}()
+	f()
+}
+
+func (d *diagnostics) addError(key, msg string) {
+	path := strings.Join(d.path, "")
+	if len(key) == 0 {
+		key = path
+	}
+	if d.seen == nil {
+		d.seen = make(map[string]bool)
+	}
+	if d.seen[key] {
+		return
+	}
+	d.seen[key] = true
+	d.errs = append(d.errs, &GenerateError{Path: path, Msg: msg})
+}
+
+// records a problem found at the path of elem
+func (d *diagnostics) addErrorAt(elem, msg string) {
+	d.path = append(d.path, elem)
+	d.addError("", msg)
+	d.path = d.path[:len(d.path)-1]
+}
+
+// returns nil if no problem is found
+func (d *diagnostics) err() error {
+	if len(d.errs) == 0 {
+		return nil
+	}
+	return d.errs
+}
+
+// the key of a struct's field used by guard
+func fieldKey(t Type, field StructField) string {
+	if len(t.Name()) == 0 {
+		return ""
+	}
+	return t.PkgPath() + "." + t.Name() + "." + field.Name
+}
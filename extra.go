@@ -30,14 +30,28 @@ func codonWriteUvarint(w *[]byte, v uint64) {
 	*w = append(*w, buf[0:n]...)
 }
 
-func codonEncodeBool(n int, w *[]byte, v bool) {
-	codonWriteUvarint(w, uint64(n)<<3)
+func codonWriteBool(w *[]byte, v bool) {
 	if v {
 		codonWriteUvarint(w, uint64(1))
 	} else {
 		codonWriteUvarint(w, uint64(0))
 	}
 }
+func codonWriteFloat32(w *[]byte, v float32) {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], math.Float32bits(v))
+	*w = append(*w, buf[:]...)
+}
+func codonWriteFloat64(w *[]byte, v float64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], math.Float64bits(v))
+	*w = append(*w, buf[:]...)
+}
+
+func codonEncodeBool(n int, w *[]byte, v bool) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteBool(w, v)
+}
 func codonEncodeVarint(n int, w *[]byte, v int64) {
 	codonWriteUvarint(w, uint64(n)<<3)
 	codonWriteVarint(w, int64(v))
@@ -71,6 +85,87 @@ func codonEncodeByteSlice(n int, w *[]byte, v []byte) {
 func codonEncodeString(n int, w *[]byte, v string) {
 	codonEncodeByteSlice(n, w, []byte(v))
 }
+func codonEncodeFloat32(n int, w *[]byte, v float32) {
+	codonWriteUvarint(w, (uint64(n)<<3)|5)
+	codonWriteFloat32(w, v)
+}
+func codonEncodeFloat64(n int, w *[]byte, v float64) {
+	codonWriteUvarint(w, (uint64(n)<<3)|1)
+	codonWriteFloat64(w, v)
+}
+// writes the tag and the length prefix of a length-delimited field, whose content must follow
+func codonEncodeLength(n int, w *[]byte, length int) {
+	codonWriteUvarint(w, (uint64(n)<<3)|2)
+	codonWriteUvarint(w, uint64(length))
+}
+func codonUvarintSize(v uint64) int {
+	n := 1
+	for v >= 0x80 {
+		v >>= 7
+		n++
+	}
+	return n
+}
+func codonVarintSize(v int64) int {
+	uv := uint64(v) << 1
+	if v < 0 {
+		uv = ^uv
+	}
+	return codonUvarintSize(uv)
+}
+// the size of a length-delimited field's content plus its length prefix, excluding the tag
+func codonByteSliceSize(length int) int {
+	return codonUvarintSize(uint64(length)) + length
+}
+// codonSizes records the sizes of nested messages in the order they are encoded, such that
+// the length prefixes can be written from the precomputed sizes. A nil *codonSizes records nothing.
+// When out is not nil, the encoded bytes are streamed into it in chunks by flush, and the first
+// error returned by out is kept in err.
+type codonSizes struct {
+	list []int
+	pos  int
+	out  io.Writer
+	err  error
+}
+func (s *codonSizes) reserve() int {
+	if s == nil {
+		return 0
+	}
+	s.list = append(s.list, 0)
+	return len(s.list) - 1
+}
+func (s *codonSizes) set(idx, size int) int {
+	if s != nil {
+		s.list[idx] = size
+	}
+	return size
+}
+func (s *codonSizes) next() int {
+	size := s.list[s.pos]
+	s.pos++
+	return size
+}
+const codonChunkSize = 4096
+func (s *codonSizes) flush(w *[]byte) {
+	if s.out != nil && len(*w) >= codonChunkSize {
+		if s.err == nil {
+			_, s.err = s.out.Write(*w)
+		}
+		*w = (*w)[:0]
+	}
+}
+// makes sure n more bytes can be appended to w without reallocation
+func codonGrow(w *[]byte, n int) {
+	if cap(*w)-len(*w) < n {
+		buf := make([]byte, len(*w), len(*w)+n)
+		copy(buf, *w)
+		*w = buf
+	}
+}
+// keys must be a slice of map keys, which are sorted before encoding the map
+func codonSortKeys(keys interface{}, less func(i, j int) bool) {
+	sort.Slice(keys, less)
+}
 func codonDecodeBool(bz []byte, n *int, err *error) bool {
 	return codonDecodeInt64(bz, n, err) != 0
 }
@@ -98,7 +193,6 @@ func codonDecodeInt64(bz []byte, m *int, err *error) int64 {
 		*err = errors.New("EOF decoding varint")
 	}
 	*m = n
-	*err = nil
 	return int64(i)
 }
 func codonDecodeUint(bz []byte, n *int, err *error) uint {
@@ -125,9 +219,56 @@ func codonDecodeUint64(bz []byte, m *int, err *error) uint64 {
 		*err = errors.New("EOF decoding varint")
 	}
 	*m = n
-	*err = nil
 	return uint64(i)
 }
+func codonDecodeFloat32(bz []byte, n *int, err *error) float32 {
+	if len(bz) < 4 {
+		*err = errors.New("Not enough bytes to read")
+		return 0
+	}
+	*n = 4
+	return math.Float32frombits(binary.LittleEndian.Uint32(bz[:4]))
+}
+func codonDecodeFloat64(bz []byte, n *int, err *error) float64 {
+	if len(bz) < 8 {
+		*err = errors.New("Not enough bytes to read")
+		return 0
+	}
+	*n = 8
+	return math.Float64frombits(binary.LittleEndian.Uint64(bz[:8]))
+}
+// Returns how many bytes a field's value occupies, according to its wire type
+func codonSkipField(bz []byte, wireType int) (int, error) {
+	switch wireType {
+	case 0: // varint
+		_, n := binary.Uvarint(bz)
+		if n <= 0 {
+			return 0, errors.New("EOF decoding varint")
+		}
+		return n, nil
+	case 1: // fixed64
+		if len(bz) < 8 {
+			return 0, errors.New("Not enough bytes to read")
+		}
+		return 8, nil
+	case 2: // length-delimited
+		length, n := binary.Uvarint(bz)
+		if n <= 0 {
+			return 0, errors.New("EOF decoding varint")
+		}
+		if uint64(len(bz)-n) < length {
+			return 0, errors.New("Not enough bytes to read")
+		}
+		return n+int(length), nil
+	case 5: // fixed32
+		if len(bz) < 4 {
+			return 0, errors.New("Not enough bytes to read")
+		}
+		return 4, nil
+	default:
+		return 0, errors.New("Unknown wire type")
+	}
+}
 func codonGetByteSlice(res *[]byte, bz []byte) (int, error) {
 	length, n := binary.Uvarint(bz)
 	if n == 0 {
@@ -141,10 +282,10 @@ func codonGetByteSlice(res *[]byte, bz []byte) (int, error) {
 	}
 	if length == 0 {
 		*res = nil
-		return 0, nil
+		return n, nil
 	}
 	bz = bz[n:]
-	if len(bz) < int(length) {
+	if uint64(len(bz)) < length {
 		*res = nil
 		return 0, errors.New("Not enough bytes to read")
 	}
@@ -155,12 +296,149 @@ func codonGetByteSlice(res *[]byte, bz []byte) (int, error) {
 	}
 	return n+int(length), nil
 }
+// the same as codonGetByteSlice, except that res refers to bz instead of a copy. Its capacity is
+// limited, such that appending to it does not overwrite bz.
+func codonGetByteSliceNoCopy(res *[]byte, bz []byte) (int, error) {
+	length, n := binary.Uvarint(bz)
+	if n == 0 {
+		return n, errors.New("buffer too small")
+	} else if n < 0 {
+		n = -n
+		return n, errors.New("EOF decoding varint")
+	}
+	if length == 0 {
+		*res = nil
+		return n, nil
+	}
+	bz = bz[n:]
+	if uint64(len(bz)) < length {
+		*res = nil
+		return 0, errors.New("Not enough bytes to read")
+	}
+	*res = bz[:length:length]
+	return n+int(length), nil
+}
 func codonDecodeString(bz []byte, n *int, err *error) string {
 	var res []byte
 	*n, *err = codonGetByteSlice(&res, bz)
 	return string(res)
 }
 
+// DecodeOptions limits the resources used by the decoders. A zero member means no limit.
+type DecodeOptions struct {
+	// The maximum length of the decoded bytes
+	MaxTotalBytes int
+	// The maximum nesting depth of the structs
+	MaxDepth int
+	// The maximum number of elements in a repeated field or a map
+	MaxSliceLength int
+	// The maximum length of a string or a byte slice
+	MaxBytesLength int
+}
+
+// ErrLimitExceeded is returned when the decoded bytes break a limit in DecodeOptions
+type ErrLimitExceeded struct {
+	// The name of the member in DecodeOptions, such as "MaxDepth"
+	Limit  string
+	Max    int
+	Actual int
+}
+
+func (e *ErrLimitExceeded) Error() string {
+	return fmt.Sprintf("%s is exceeded: %d > %d", e.Limit, e.Actual, e.Max)
+}
+
+// codonDecoder checks DecodeOptions during decoding. A nil *codonDecoder checks nothing.
+// In the noCopy mode, the decoded byte slices and strings share memory with the input.
+type codonDecoder struct {
+	opts   DecodeOptions
+	depth  int
+	noCopy bool
+}
+func newCodonDecoder(bz []byte, opts DecodeOptions) (*codonDecoder, error) {
+	if opts.MaxTotalBytes > 0 && len(bz) > opts.MaxTotalBytes {
+		return nil, &ErrLimitExceeded{Limit: "MaxTotalBytes", Max: opts.MaxTotalBytes, Actual: len(bz)}
+	}
+	return &codonDecoder{opts: opts}, nil
+}
+func (d *codonDecoder) enter() error {
+	if d == nil {
+		return nil
+	}
+	d.depth++
+	if d.opts.MaxDepth > 0 && d.depth > d.opts.MaxDepth {
+		return &ErrLimitExceeded{Limit: "MaxDepth", Max: d.opts.MaxDepth, Actual: d.depth}
+	}
+	return nil
+}
+func (d *codonDecoder) leave() {
+	if d != nil {
+		d.depth--
+	}
+}
+func (d *codonDecoder) checkSliceLength(length int) error {
+	if d != nil && d.opts.MaxSliceLength > 0 && length > d.opts.MaxSliceLength {
+		return &ErrLimitExceeded{Limit: "MaxSliceLength", Max: d.opts.MaxSliceLength, Actual: length}
+	}
+	return nil
+}
+// the length is checked before the bytes are copied
+func (d *codonDecoder) getByteSlice(res *[]byte, bz []byte) (int, error) {
+	if d != nil && d.opts.MaxBytesLength > 0 {
+		length, n := binary.Uvarint(bz)
+		if n > 0 && length > uint64(d.opts.MaxBytesLength) {
+			return n, &ErrLimitExceeded{Limit: "MaxBytesLength", Max: d.opts.MaxBytesLength, Actual: int(length)}
+		}
+	}
+	if d != nil && d.noCopy {
+		return codonGetByteSliceNoCopy(res, bz)
+	}
+	return codonGetByteSlice(res, bz)
+}
+func (d *codonDecoder) decodeString(bz []byte, n *int, err *error) string {
+	var res []byte
+	*n, *err = d.getByteSlice(&res, bz)
+	if d != nil && d.noCopy {
+		return unsafe.String(unsafe.SliceData(res), len(res))
+	}
+	return string(res)
+}
+
+`
+
+var errorLogics = `
+// ErrUnknownMagicNum is returned when the decoded bytes have a magic number unknown to the decoder
+type ErrUnknownMagicNum struct {
+	MagicNum uint32
+	// The alias of the decoded interface, or "interface{}" for DecodeAny
+	Alias string
+}
+
+func (e *ErrUnknownMagicNum) Error() string {
+	return fmt.Sprintf("Unknown magic number %d when decoding %s", e.MagicNum, e.Alias)
+}
+
+// ErrTypeMismatch is returned when a decoded struct cannot be assigned to the target
+type ErrTypeMismatch struct {
+	// The magic number and alias of the decoded struct
+	MagicNum uint32
+	Alias    string
+	// The type of the target pointer
+	Target string
+}
+
+func (e *ErrTypeMismatch) Error() string {
+	return fmt.Sprintf("Type mismatch: cannot assign %s (magic number %d) to %s", e.Alias, e.MagicNum, e.Target)
+}
+
+func newErrTypeMismatch(structObj interface{}, target interface{}) error {
+	magicNum, _ := getMagicNumOfVar(structObj)
+	return &ErrTypeMismatch{
+		MagicNum: magicNum,
+		Alias:    getAliasOfMagicNum(magicNum),
+		Target:   fmt.Sprintf("%T", target),
+	}
+}
 `
 
 var ImportsForBridgeLogic = []string{`"io"`, `"fmt"`, `"reflect"`, `amino "github.com/coinexchain/codon/wrap-amino"`}
@@ -169,10 +447,14 @@ var BridgeLogic = `
 // ========= BridgeBegin ============
 type CodecImp struct {
 	sealed          bool
+	decodeOptions   DecodeOptions
+	// the names passed to RegisterConcrete, which wrap the registered types in JSON
+	names map[reflect.Type]string
 }
 
 var _ amino.Sealer = &CodecImp{}
 var _ amino.CodecIfc = &CodecImp{}
+var _ amino.JSONCodec = &CodecImp{}
 
 func (cdc *CodecImp) MarshalBinaryBare(o interface{}) ([]byte, error) {
 	s := CodonStub{}
@@ -188,11 +470,11 @@ func (cdc *CodecImp) MarshalBinaryLengthPrefixedWriter(w io.Writer, o interface{
 	return int64(m), err
 }
 func (cdc *CodecImp) UnmarshalBinaryBare(bz []byte, ptr interface{}) error {
-	s := CodonStub{}
+	s := CodonStub{DecodeOptions: cdc.decodeOptions}
 	return s.UnmarshalBinaryBare(bz, ptr)
 }
 func (cdc *CodecImp) UnmarshalBinaryLengthPrefixed(bz []byte, ptr interface{}) error {
-	s := CodonStub{}
+	s := CodonStub{DecodeOptions: cdc.decodeOptions}
 	return s.UnmarshalBinaryLengthPrefixed(bz, ptr)
 }
 func (cdc *CodecImp) UnmarshalBinaryLengthPrefixedReader(r io.Reader, ptr interface{}, maxSize int64) (n int64, err error) {
@@ -248,6 +530,58 @@ func (cdc *CodecImp) UnmarshalBinaryLengthPrefixedReader(r io.Reader, ptr interf
 	return
 }
 
+func (cdc *CodecImp) MarshalJSONAny(o interface{}) ([]byte, error) {
+	if rv := reflect.ValueOf(o); rv.Kind() == reflect.Ptr && rv.Elem().Kind() == reflect.Interface {
+		o = rv.Elem().Interface()
+	}
+	// a nil pointer is written as null, just like amino does
+	if rv := reflect.ValueOf(o); o == nil || (rv.Kind() == reflect.Ptr && rv.IsNil()) {
+		return []byte("null"), nil
+	}
+	if _, ok := getMagicNumOfVar(o); !ok {
+		return nil, amino.ErrUnsupportedType
+	}
+	_, wrap := cdc.names[derefPtr(o)]
+	w := &codonJSONWriter{}
+	encodeJSONAny(w, o, wrap)
+	return w.result()
+}
+func (cdc *CodecImp) MarshalJSONIndent(o interface{}, prefix, indent string) ([]byte, error) {
+	bz, err := cdc.MarshalJSONAny(o)
+	if err != nil {
+		return nil, err
+	}
+	var out bytes.Buffer
+	err = json.Indent(&out, bz, prefix, indent)
+	if err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+func (cdc *CodecImp) UnmarshalJSONAny(bz []byte, ptr interface{}) error {
+	if len(bz) == 0 {
+		return errors.New("UnmarshalJSONAny cannot decode empty bytes")
+	}
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr {
+		return errors.New("UnmarshalJSONAny expects a pointer")
+	}
+	d := &codonJSONReader{bz: bz}
+	value := d
+	if name, ok := cdc.names[rv.Elem().Type()]; ok {
+		var typeName string
+		typeName, value = d.typeValue()
+		if d.err == nil && typeName != name {
+			return fmt.Errorf("UnmarshalJSONAny wants to decode a %v but found a %v", name, typeName)
+		}
+	}
+	if !decodeJSONPtr(value, ptr) {
+		return amino.ErrUnsupportedType
+	}
+	d.merge(value)
+	return d.finish()
+}
+
 //------
 
 func (cdc *CodecImp) MustMarshalBinaryBare(o interface{}) []byte {
@@ -315,6 +649,10 @@ func (cdc *CodecImp) RegisterConcrete(o interface{}, name string, copts *amino.C
 	if !found {
 		panic(fmt.Sprintf("%s is not supported", path))
 	}
+	if cdc.names == nil {
+		cdc.names = make(map[reflect.Type]string)
+	}
+	cdc.names[t] = name
 }
 func (cdc *CodecImp) RegisterInterface(o interface{}, _ *amino.InterfaceOptions) {
 	if cdc.sealed {
@@ -343,10 +681,12 @@ func (cdc *CodecImp) SealImp() {
 // ========================================
 
 type CodonStub struct {
+	// The limits used by UnmarshalBinaryBare and the CodecImp created by NewCodecImp
+	DecodeOptions DecodeOptions
 }
 
-func (_ *CodonStub) NewCodecImp() amino.CodecIfc {
-	return &CodecImp{}
+func (s *CodonStub) NewCodecImp() amino.CodecIfc {
+	return &CodecImp{decodeOptions: s.DecodeOptions}
 }
 func (_ *CodonStub) DeepCopy(o interface{}) (r interface{}) {
 	r = DeepCopyAny(o)
@@ -357,35 +697,43 @@ func (_ *CodonStub) MarshalBinaryBare(o interface{}) ([]byte, error) {
 	if _, ok := getMagicNumOfVar(o); !ok {
 		return nil, errors.New("Not Supported Type")
 	}
-	buf := make([]byte, 0, 1024)
+	var buf []byte
 	EncodeAny(&buf, o)
 	return buf, nil
 }
-func (s *CodonStub) MarshalBinaryLengthPrefixed(o interface{}) ([]byte, error) {
+func (_ *CodonStub) MarshalBinaryLengthPrefixed(o interface{}) ([]byte, error) {
 	if _, ok := getMagicNumOfVar(o); !ok {
 		return nil, errors.New("Not Supported Type")
 	}
-	bz, err := s.MarshalBinaryBare(o)
-	var buf [binary.MaxVarintLen64]byte
-	n := binary.PutUvarint(buf[:], uint64(len(bz)))
-	return append(buf[:n], bz...), err
+	s := &codonSizes{}
+	size := sizeAny(o, s)
+	buf := make([]byte, 0, codonByteSliceSize(size))
+	codonWriteUvarint(&buf, uint64(size))
+	encodeAny(&buf, o, s)
+	return buf, nil
 }
-func (_ *CodonStub) UnmarshalBinaryBare(bz []byte, ptr interface{}) error {
+func (s *CodonStub) UnmarshalBinaryBare(bz []byte, ptr interface{}) error {
 	rv := reflect.ValueOf(ptr)
 	if rv.Kind() != reflect.Ptr {
 		panic("Unmarshal expects a pointer")
 	}
 
-	if len(bz) <= 4 {
+	if len(bz) < 4 {
 		return fmt.Errorf("Byte slice is too short: %d", len(bz))
 	}
-	o, _, err := DecodeAny(bz)
+	o, _, err := DecodeAnyWithOptions(bz, s.DecodeOptions)
+	if err != nil {
+		return err
+	}
 	if rv.Elem().Kind() == reflect.Interface {
-		AssignIfcPtrFromStruct(ptr, o)
-	} else {
-		rv.Elem().Set(reflect.ValueOf(o))
+		return AssignIfcPtrFromStruct(ptr, o)
 	}
-	return err
+	ov := reflect.ValueOf(o)
+	if !ov.Type().AssignableTo(rv.Elem().Type()) {
+		return newErrTypeMismatch(o, ptr)
+	}
+	rv.Elem().Set(ov)
+	return nil
 }
 func (s *CodonStub) UnmarshalBinaryLengthPrefixed(bz []byte, ptr interface{}) error {
 	if len(bz) == 0 {
@@ -421,7 +769,10 @@ func (_ *CodonStub) UvarintSize(u uint64) int {
 	return n
 }
 func (_ *CodonStub) EncodeByteSlice(w io.Writer, bz []byte) error {
-	_, err := w.Write(ByteSliceWithLengthPrefix(bz))
+	buf := make([]byte, 0, codonByteSliceSize(len(bz)))
+	codonWriteUvarint(&buf, uint64(len(bz)))
+	buf = append(buf, bz...)
+	_, err := w.Write(buf)
 	return err
 }
 func (s *CodonStub) ByteSliceSize(bz []byte) int {
@@ -541,4 +892,3 @@ func (_ *CodonStub) VarintSize(i int64) int {
 }
 // ========= BridgeEnd ============
 `
-
@@ -0,0 +1,97 @@
+package codon
+
+import (
+	"io"
+	"strings"
+)
+
+var fuzzHeaderLogics = `
+// codonFuzzSrc is a RandSrc backed by the fuzzer's input bytes. After the input is used up, it returns zeros.
+type codonFuzzSrc struct {
+	bz []byte
+}
+
+func (s *codonFuzzSrc) next(n int) []byte {
+	res := make([]byte, n)
+	m := copy(res, s.bz)
+	s.bz = s.bz[m:]
+	return res
+}
+
+func (s *codonFuzzSrc) GetBool() bool       { return s.next(1)[0]&1 != 0 }
+func (s *codonFuzzSrc) GetInt() int         { return int(s.GetUint64()) }
+func (s *codonFuzzSrc) GetInt8() int8       { return int8(s.next(1)[0]) }
+func (s *codonFuzzSrc) GetInt16() int16     { return int16(s.GetUint16()) }
+func (s *codonFuzzSrc) GetInt32() int32     { return int32(s.GetUint32()) }
+func (s *codonFuzzSrc) GetInt64() int64     { return int64(s.GetUint64()) }
+func (s *codonFuzzSrc) GetUint() uint       { return uint(s.GetUint64()) }
+func (s *codonFuzzSrc) GetUint8() uint8     { return s.next(1)[0] }
+func (s *codonFuzzSrc) GetUint16() uint16   { return binary.LittleEndian.Uint16(s.next(2)) }
+func (s *codonFuzzSrc) GetUint32() uint32   { return binary.LittleEndian.Uint32(s.next(4)) }
+func (s *codonFuzzSrc) GetUint64() uint64   { return binary.LittleEndian.Uint64(s.next(8)) }
+func (s *codonFuzzSrc) GetFloat32() float32 { return math.Float32frombits(s.GetUint32()) }
+func (s *codonFuzzSrc) GetFloat64() float64 { return math.Float64frombits(s.GetUint64()) }
+func (s *codonFuzzSrc) GetString(n int) string {
+	return string(s.next(n))
+}
+func (s *codonFuzzSrc) GetBytes(n int) []byte {
+	return s.next(n)
+}
+
+// Seeds the corpus with the encoded bytes of random values, which are filled by Rand* functions
+func codonFuzzSeeds(f *testing.F, encodeRand func(r RandSrc) []byte) {
+	f.Add([]byte{})
+	rnd := rand.New(rand.NewSource(0))
+	for i := 0; i < 16; i++ {
+		input := make([]byte, 1024)
+		rnd.Read(input)
+		f.Add(encodeRand(&codonFuzzSrc{bz: input}))
+	}
+}
+`
+
+var fuzzTemplate = `
+func FuzzDecodeAAA(f *testing.F) {
+	codonFuzzSeeds(f, func(r RandSrc) []byte {
+		var buf []byte
+		EncodeAAA(&buf, RandAAA(r))
+		return buf
+	})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		v, _, err := DecodeAAA(bz)
+		if err != nil {
+			return
+		}
+		var buf []byte
+		EncodeAAA(&buf, v)
+		v2, n, err := DecodeAAA(buf)
+		if err != nil {
+			t.Fatalf("AAA: cannot decode the re-encoded bytes: %v", err)
+		}
+		if n != len(buf) {
+			t.Fatalf("AAA: decoded %d bytes out of %d", n, len(buf))
+		}
+		var buf2 []byte
+		EncodeAAA(&buf2, v2)
+		if !bytes.Equal(buf, buf2) {
+			t.Fatalf("AAA: encoded bytes changed after decode->encode->decode")
+		}
+	})
+}
+`
+
+// For every registered type (and for the top-level "Any"), a native Go fuzzing target is generated.
+// It checks that decoding never panics and that decode->encode->decode is stable.
+func generateFuzzTestFile(out io.Writer, opts GeneratorOptions, typeEntryList []TypeEntry) error {
+	w := &sourceBuffer{}
+	opts.writeHeader(w)
+	w.Write([]byte("import (\n"))
+	w.Write([]byte("\"bytes\"\n\"encoding/binary\"\n\"math\"\n\"math/rand\"\n\"testing\"\n)\n"))
+	w.Write([]byte(fuzzHeaderLogics))
+	for _, entry := range typeEntryList {
+		writeLines(w, []string{beginMark("type " + entry.Alias)})
+		writeLines(w, []string{strings.Replace(fuzzTemplate, "AAA", entry.Alias, -1), endMark()})
+	}
+	writeLines(w, []string{strings.Replace(fuzzTemplate, "AAA", "Any", -1)})
+	return w.writeTo(out)
+}
@@ -1,3 +1,16 @@
 module github.com/coinexchain/codon
 
-go 1.13
+go 1.25.0
+
+require (
+	github.com/BurntSushi/toml v1.2.1
+	github.com/tendermint/go-amino v0.15.0
+	golang.org/x/tools v0.47.0
+	gopkg.in/yaml.v2 v2.4.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	golang.org/x/mod v0.37.0 // indirect
+	golang.org/x/sync v0.21.0 // indirect
+)
@@ -0,0 +1,6671 @@
+// Code generated by codon. DO NOT EDIT.
+
+//nolint:all
+package codec
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	amino "github.com/coinexchain/codon/wrap-amino"
+	"hash"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"unsafe"
+)
+
+type RandSrc interface {
+	GetBool() bool
+	GetInt() int
+	GetInt8() int8
+	GetInt16() int16
+	GetInt32() int32
+	GetInt64() int64
+	GetUint() uint
+	GetUint8() uint8
+	GetUint16() uint16
+	GetUint32() uint32
+	GetUint64() uint64
+	GetFloat32() float32
+	GetFloat64() float64
+	GetString(n int) string
+	GetBytes(n int) []byte
+}
+
+func codonWriteVarint(w *[]byte, v int64) {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(buf[:], v)
+	*w = append(*w, buf[0:n]...)
+}
+func codonWriteUvarint(w *[]byte, v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	*w = append(*w, buf[0:n]...)
+}
+
+func codonWriteBool(w *[]byte, v bool) {
+	if v {
+		codonWriteUvarint(w, uint64(1))
+	} else {
+		codonWriteUvarint(w, uint64(0))
+	}
+}
+func codonWriteFloat32(w *[]byte, v float32) {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], math.Float32bits(v))
+	*w = append(*w, buf[:]...)
+}
+func codonWriteFloat64(w *[]byte, v float64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], math.Float64bits(v))
+	*w = append(*w, buf[:]...)
+}
+
+func codonEncodeBool(n int, w *[]byte, v bool) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteBool(w, v)
+}
+func codonEncodeVarint(n int, w *[]byte, v int64) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteVarint(w, int64(v))
+}
+func codonEncodeInt8(n int, w *[]byte, v int8) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteVarint(w, int64(v))
+}
+func codonEncodeInt16(n int, w *[]byte, v int16) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteVarint(w, int64(v))
+}
+func codonEncodeUvarint(n int, w *[]byte, v uint64) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteUvarint(w, v)
+}
+func codonEncodeUint8(n int, w *[]byte, v uint8) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteUvarint(w, uint64(v))
+}
+func codonEncodeUint16(n int, w *[]byte, v uint16) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteUvarint(w, uint64(v))
+}
+
+func codonEncodeByteSlice(n int, w *[]byte, v []byte) {
+	codonWriteUvarint(w, (uint64(n)<<3)|2)
+	codonWriteUvarint(w, uint64(len(v)))
+	*w = append(*w, v...)
+}
+func codonEncodeString(n int, w *[]byte, v string) {
+	codonEncodeByteSlice(n, w, []byte(v))
+}
+func codonEncodeFloat32(n int, w *[]byte, v float32) {
+	codonWriteUvarint(w, (uint64(n)<<3)|5)
+	codonWriteFloat32(w, v)
+}
+func codonEncodeFloat64(n int, w *[]byte, v float64) {
+	codonWriteUvarint(w, (uint64(n)<<3)|1)
+	codonWriteFloat64(w, v)
+}
+
+// writes the tag and the length prefix of a length-delimited field, whose content must follow
+func codonEncodeLength(n int, w *[]byte, length int) {
+	codonWriteUvarint(w, (uint64(n)<<3)|2)
+	codonWriteUvarint(w, uint64(length))
+}
+func codonUvarintSize(v uint64) int {
+	n := 1
+	for v >= 0x80 {
+		v >>= 7
+		n++
+	}
+	return n
+}
+func codonVarintSize(v int64) int {
+	uv := uint64(v) << 1
+	if v < 0 {
+		uv = ^uv
+	}
+	return codonUvarintSize(uv)
+}
+
+// the size of a length-delimited field's content plus its length prefix, excluding the tag
+func codonByteSliceSize(length int) int {
+	return codonUvarintSize(uint64(length)) + length
+}
+
+// codonSizes records the sizes of nested messages in the order they are encoded, such that
+// the length prefixes can be written from the precomputed sizes. A nil *codonSizes records nothing.
+// When out is not nil, the encoded bytes are streamed into it in chunks by flush, and the first
+// error returned by out is kept in err.
+type codonSizes struct {
+	list []int
+	pos  int
+	out  io.Writer
+	err  error
+}
+
+func (s *codonSizes) reserve() int {
+	if s == nil {
+		return 0
+	}
+	s.list = append(s.list, 0)
+	return len(s.list) - 1
+}
+func (s *codonSizes) set(idx, size int) int {
+	if s != nil {
+		s.list[idx] = size
+	}
+	return size
+}
+func (s *codonSizes) next() int {
+	size := s.list[s.pos]
+	s.pos++
+	return size
+}
+
+const codonChunkSize = 4096
+
+func (s *codonSizes) flush(w *[]byte) {
+	if s.out != nil && len(*w) >= codonChunkSize {
+		if s.err == nil {
+			_, s.err = s.out.Write(*w)
+		}
+		*w = (*w)[:0]
+	}
+}
+
+// makes sure n more bytes can be appended to w without reallocation
+func codonGrow(w *[]byte, n int) {
+	if cap(*w)-len(*w) < n {
+		buf := make([]byte, len(*w), len(*w)+n)
+		copy(buf, *w)
+		*w = buf
+	}
+}
+
+// keys must be a slice of map keys, which are sorted before encoding the map
+func codonSortKeys(keys interface{}, less func(i, j int) bool) {
+	sort.Slice(keys, less)
+}
+func codonDecodeBool(bz []byte, n *int, err *error) bool {
+	return codonDecodeInt64(bz, n, err) != 0
+}
+func codonDecodeInt(bz []byte, n *int, err *error) int {
+	return int(codonDecodeInt64(bz, n, err))
+}
+func codonDecodeInt8(bz []byte, n *int, err *error) int8 {
+	return int8(codonDecodeInt64(bz, n, err))
+}
+func codonDecodeInt16(bz []byte, n *int, err *error) int16 {
+	return int16(codonDecodeInt64(bz, n, err))
+}
+func codonDecodeInt32(bz []byte, n *int, err *error) int32 {
+	return int32(codonDecodeInt64(bz, n, err))
+}
+func codonDecodeInt64(bz []byte, m *int, err *error) int64 {
+	i, n := binary.Varint(bz)
+	if n == 0 {
+		// buf too small
+		*err = errors.New("buffer too small")
+	} else if n < 0 {
+		// value larger than 64 bits (overflow)
+		// and -n is the number of bytes read
+		n = -n
+		*err = errors.New("EOF decoding varint")
+	}
+	*m = n
+	return int64(i)
+}
+func codonDecodeUint(bz []byte, n *int, err *error) uint {
+	return uint(codonDecodeUint64(bz, n, err))
+}
+func codonDecodeUint8(bz []byte, n *int, err *error) uint8 {
+	return uint8(codonDecodeUint64(bz, n, err))
+}
+func codonDecodeUint16(bz []byte, n *int, err *error) uint16 {
+	return uint16(codonDecodeUint64(bz, n, err))
+}
+func codonDecodeUint32(bz []byte, n *int, err *error) uint32 {
+	return uint32(codonDecodeUint64(bz, n, err))
+}
+func codonDecodeUint64(bz []byte, m *int, err *error) uint64 {
+	i, n := binary.Uvarint(bz)
+	if n == 0 {
+		// buf too small
+		*err = errors.New("buffer too small")
+	} else if n < 0 {
+		// value larger than 64 bits (overflow)
+		// and -n is the number of bytes read
+		n = -n
+		*err = errors.New("EOF decoding varint")
+	}
+	*m = n
+	return uint64(i)
+}
+func codonDecodeFloat32(bz []byte, n *int, err *error) float32 {
+	if len(bz) < 4 {
+		*err = errors.New("Not enough bytes to read")
+		return 0
+	}
+	*n = 4
+	return math.Float32frombits(binary.LittleEndian.Uint32(bz[:4]))
+}
+func codonDecodeFloat64(bz []byte, n *int, err *error) float64 {
+	if len(bz) < 8 {
+		*err = errors.New("Not enough bytes to read")
+		return 0
+	}
+	*n = 8
+	return math.Float64frombits(binary.LittleEndian.Uint64(bz[:8]))
+}
+
+// Returns how many bytes a field's value occupies, according to its wire type
+func codonSkipField(bz []byte, wireType int) (int, error) {
+	switch wireType {
+	case 0: // varint
+		_, n := binary.Uvarint(bz)
+		if n <= 0 {
+			return 0, errors.New("EOF decoding varint")
+		}
+		return n, nil
+	case 1: // fixed64
+		if len(bz) < 8 {
+			return 0, errors.New("Not enough bytes to read")
+		}
+		return 8, nil
+	case 2: // length-delimited
+		length, n := binary.Uvarint(bz)
+		if n <= 0 {
+			return 0, errors.New("EOF decoding varint")
+		}
+		if uint64(len(bz)-n) < length {
+			return 0, errors.New("Not enough bytes to read")
+		}
+		return n + int(length), nil
+	case 5: // fixed32
+		if len(bz) < 4 {
+			return 0, errors.New("Not enough bytes to read")
+		}
+		return 4, nil
+	default:
+		return 0, errors.New("Unknown wire type")
+	}
+}
+func codonGetByteSlice(res *[]byte, bz []byte) (int, error) {
+	length, n := binary.Uvarint(bz)
+	if n == 0 {
+		// buf too small
+		return n, errors.New("buffer too small")
+	} else if n < 0 {
+		// value larger than 64 bits (overflow)
+		// and -n is the number of bytes read
+		n = -n
+		return n, errors.New("EOF decoding varint")
+	}
+	if length == 0 {
+		*res = nil
+		return n, nil
+	}
+	bz = bz[n:]
+	if uint64(len(bz)) < length {
+		*res = nil
+		return 0, errors.New("Not enough bytes to read")
+	}
+	if *res == nil {
+		*res = append(*res, bz[:length]...)
+	} else {
+		*res = append((*res)[:0], bz[:length]...)
+	}
+	return n + int(length), nil
+}
+
+// the same as codonGetByteSlice, except that res refers to bz instead of a copy. Its capacity is
+// limited, such that appending to it does not overwrite bz.
+func codonGetByteSliceNoCopy(res *[]byte, bz []byte) (int, error) {
+	length, n := binary.Uvarint(bz)
+	if n == 0 {
+		return n, errors.New("buffer too small")
+	} else if n < 0 {
+		n = -n
+		return n, errors.New("EOF decoding varint")
+	}
+	if length == 0 {
+		*res = nil
+		return n, nil
+	}
+	bz = bz[n:]
+	if uint64(len(bz)) < length {
+		*res = nil
+		return 0, errors.New("Not enough bytes to read")
+	}
+	*res = bz[:length:length]
+	return n + int(length), nil
+}
+func codonDecodeString(bz []byte, n *int, err *error) string {
+	var res []byte
+	*n, *err = codonGetByteSlice(&res, bz)
+	return string(res)
+}
+
+// DecodeOptions limits the resources used by the decoders. A zero member means no limit.
+type DecodeOptions struct {
+	// The maximum length of the decoded bytes
+	MaxTotalBytes int
+	// The maximum nesting depth of the structs
+	MaxDepth int
+	// The maximum number of elements in a repeated field or a map
+	MaxSliceLength int
+	// The maximum length of a string or a byte slice
+	MaxBytesLength int
+}
+
+// ErrLimitExceeded is returned when the decoded bytes break a limit in DecodeOptions
+type ErrLimitExceeded struct {
+	// The name of the member in DecodeOptions, such as "MaxDepth"
+	Limit  string
+	Max    int
+	Actual int
+}
+
+func (e *ErrLimitExceeded) Error() string {
+	return fmt.Sprintf("%s is exceeded: %d > %d", e.Limit, e.Actual, e.Max)
+}
+
+// codonDecoder checks DecodeOptions during decoding. A nil *codonDecoder checks nothing.
+// In the noCopy mode, the decoded byte slices and strings share memory with the input.
+type codonDecoder struct {
+	opts   DecodeOptions
+	depth  int
+	noCopy bool
+}
+
+func newCodonDecoder(bz []byte, opts DecodeOptions) (*codonDecoder, error) {
+	if opts.MaxTotalBytes > 0 && len(bz) > opts.MaxTotalBytes {
+		return nil, &ErrLimitExceeded{Limit: "MaxTotalBytes", Max: opts.MaxTotalBytes, Actual: len(bz)}
+	}
+	return &codonDecoder{opts: opts}, nil
+}
+func (d *codonDecoder) enter() error {
+	if d == nil {
+		return nil
+	}
+	d.depth++
+	if d.opts.MaxDepth > 0 && d.depth > d.opts.MaxDepth {
+		return &ErrLimitExceeded{Limit: "MaxDepth", Max: d.opts.MaxDepth, Actual: d.depth}
+	}
+	return nil
+}
+func (d *codonDecoder) leave() {
+	if d != nil {
+		d.depth--
+	}
+}
+func (d *codonDecoder) checkSliceLength(length int) error {
+	if d != nil && d.opts.MaxSliceLength > 0 && length > d.opts.MaxSliceLength {
+		return &ErrLimitExceeded{Limit: "MaxSliceLength", Max: d.opts.MaxSliceLength, Actual: length}
+	}
+	return nil
+}
+
+// the length is checked before the bytes are copied
+func (d *codonDecoder) getByteSlice(res *[]byte, bz []byte) (int, error) {
+	if d != nil && d.opts.MaxBytesLength > 0 {
+		length, n := binary.Uvarint(bz)
+		if n > 0 && length > uint64(d.opts.MaxBytesLength) {
+			return n, &ErrLimitExceeded{Limit: "MaxBytesLength", Max: d.opts.MaxBytesLength, Actual: int(length)}
+		}
+	}
+	if d != nil && d.noCopy {
+		return codonGetByteSliceNoCopy(res, bz)
+	}
+	return codonGetByteSlice(res, bz)
+}
+func (d *codonDecoder) decodeString(bz []byte, n *int, err *error) string {
+	var res []byte
+	*n, *err = d.getByteSlice(&res, bz)
+	if d != nil && d.noCopy {
+		return unsafe.String(unsafe.SliceData(res), len(res))
+	}
+	return string(res)
+}
+
+// ErrUnknownMagicNum is returned when the decoded bytes have a magic number unknown to the decoder
+type ErrUnknownMagicNum struct {
+	MagicNum uint32
+	// The alias of the decoded interface, or "interface{}" for DecodeAny
+	Alias string
+}
+
+func (e *ErrUnknownMagicNum) Error() string {
+	return fmt.Sprintf("Unknown magic number %d when decoding %s", e.MagicNum, e.Alias)
+}
+
+// ErrTypeMismatch is returned when a decoded struct cannot be assigned to the target
+type ErrTypeMismatch struct {
+	// The magic number and alias of the decoded struct
+	MagicNum uint32
+	Alias    string
+	// The type of the target pointer
+	Target string
+}
+
+func (e *ErrTypeMismatch) Error() string {
+	return fmt.Sprintf("Type mismatch: cannot assign %s (magic number %d) to %s", e.Alias, e.MagicNum, e.Target)
+}
+
+func newErrTypeMismatch(structObj interface{}, target interface{}) error {
+	magicNum, _ := getMagicNumOfVar(structObj)
+	return &ErrTypeMismatch{
+		MagicNum: magicNum,
+		Alias:    getAliasOfMagicNum(magicNum),
+		Target:   fmt.Sprintf("%T", target),
+	}
+}
+
+// codonJSONWriter writes amino-compatible JSON. Only the first error is kept.
+type codonJSONWriter struct {
+	buf []byte
+	err error
+}
+
+func (w *codonJSONWriter) fail(err error) {
+	if w.err == nil {
+		w.err = err
+	}
+}
+
+func (w *codonJSONWriter) result() ([]byte, error) {
+	if w.err != nil {
+		return nil, w.err
+	}
+	return w.buf, nil
+}
+
+func (w *codonJSONWriter) raw(s string) {
+	w.buf = append(w.buf, s...)
+}
+
+// writes a comma unless it is the first member of an object or the first element of an array
+func (w *codonJSONWriter) comma(open byte) {
+	if len(w.buf) != 0 && w.buf[len(w.buf)-1] != open {
+		w.buf = append(w.buf, ',')
+	}
+}
+
+// writes a struct member's key, which is already escaped and followed by a colon
+func (w *codonJSONWriter) key(k string) {
+	w.comma('{')
+	w.buf = append(w.buf, k...)
+}
+
+func (w *codonJSONWriter) mapKey(k string) {
+	w.comma('{')
+	w.string(k)
+	w.buf = append(w.buf, ':')
+}
+
+// begins the wrapper of a registered type, which must be closed with a "}"
+func (w *codonJSONWriter) beginType(name string) {
+	w.buf = append(w.buf, "{\"type\":\""...)
+	w.buf = append(w.buf, name...)
+	w.buf = append(w.buf, "\",\"value\":"...)
+}
+
+func (w *codonJSONWriter) bool(b bool) {
+	if b {
+		w.raw("true")
+	} else {
+		w.raw("false")
+	}
+}
+
+func (w *codonJSONWriter) int(i int64) {
+	w.buf = strconv.AppendInt(w.buf, i, 10)
+}
+
+func (w *codonJSONWriter) uint(u uint64) {
+	w.buf = strconv.AppendUint(w.buf, u, 10)
+}
+
+// 64-bit integers are quoted, because javascript cannot handle them
+func (w *codonJSONWriter) quotedInt(i int64) {
+	w.buf = append(w.buf, '"')
+	w.buf = strconv.AppendInt(w.buf, i, 10)
+	w.buf = append(w.buf, '"')
+}
+
+func (w *codonJSONWriter) quotedUint(u uint64) {
+	w.buf = append(w.buf, '"')
+	w.buf = strconv.AppendUint(w.buf, u, 10)
+	w.buf = append(w.buf, '"')
+}
+
+// escapes s just like encoding/json
+func (w *codonJSONWriter) string(s string) {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c < 0x20 || c >= 0x7f || c == '"' || c == '\\' || c == '<' || c == '>' || c == '&' {
+			w.marshal(s)
+			return
+		}
+	}
+	w.buf = append(w.buf, '"')
+	w.buf = append(w.buf, s...)
+	w.buf = append(w.buf, '"')
+}
+
+// writes bz in base64, or null if it is nil
+func (w *codonJSONWriter) bytes(bz []byte) {
+	if bz == nil {
+		w.raw("null")
+		return
+	}
+	w.buf = append(w.buf, '"')
+	w.buf = base64.StdEncoding.AppendEncode(w.buf, bz)
+	w.buf = append(w.buf, '"')
+}
+
+// uses v's MarshalJSON if it has one, otherwise encoding/json
+func (w *codonJSONWriter) marshal(v interface{}) {
+	if m, ok := v.(json.Marshaler); ok {
+		w.marshaler(m)
+		return
+	}
+	bz, err := json.Marshal(v)
+	if err != nil {
+		w.fail(err)
+		return
+	}
+	w.buf = append(w.buf, bz...)
+}
+
+// the output of MarshalJSON is written unchanged, as amino does
+func (w *codonJSONWriter) marshaler(m json.Marshaler) {
+	bz, err := m.MarshalJSON()
+	if err != nil {
+		w.fail(err)
+		return
+	}
+	w.buf = append(w.buf, bz...)
+}
+
+// codonJSONReader reads the JSON written by codonJSONWriter or amino. Only the first error is kept,
+// and after it every value is read as null.
+type codonJSONReader struct {
+	bz  []byte
+	pos int
+	err error
+	// the key of the object member being read
+	key []byte
+	// whether no member or element of the current object or array has been read
+	first bool
+}
+
+func (d *codonJSONReader) fail(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+}
+
+func (d *codonJSONReader) failf(format string, args ...interface{}) {
+	d.fail(fmt.Errorf(format, args...))
+}
+
+// skips the spaces and returns the next byte, or 0 at the end
+func (d *codonJSONReader) peek() byte {
+	for ; d.pos < len(d.bz); d.pos++ {
+		switch c := d.bz[d.pos]; c {
+		case ' ', '\t', '\n', '\r':
+		default:
+			return c
+		}
+	}
+	return 0
+}
+
+func (d *codonJSONReader) unexpected(what string) {
+	if d.pos >= len(d.bz) {
+		d.failf("unexpected end of JSON input, expecting %s", what)
+	} else {
+		d.failf("invalid character %q at offset %d, expecting %s", d.bz[d.pos], d.pos, what)
+	}
+}
+
+func (d *codonJSONReader) expect(c byte) bool {
+	if d.err != nil {
+		return false
+	}
+	if d.peek() != c {
+		d.unexpected(strconv.QuoteRune(rune(c)))
+		return false
+	}
+	d.pos++
+	return true
+}
+
+func (d *codonJSONReader) literal(s string) {
+	if !bytes.HasPrefix(d.bz[d.pos:], []byte(s)) {
+		d.unexpected(s)
+		return
+	}
+	d.pos += len(s)
+}
+
+// consumes a null and returns true. After an error it returns true too, such that nothing more is read.
+func (d *codonJSONReader) null() bool {
+	if d.err != nil {
+		return true
+	}
+	if d.peek() == 'n' {
+		d.literal("null")
+		return true
+	}
+	return false
+}
+
+func (d *codonJSONReader) beginObject() {
+	if d.expect('{') {
+		d.first = true
+	}
+}
+
+// reads the key of the next member into d.key, and returns false at the end of the object
+func (d *codonJSONReader) nextMember() bool {
+	if d.err != nil {
+		return false
+	}
+	if d.peek() == '}' {
+		d.pos++
+		d.first = false
+		return false
+	}
+	if !d.first && !d.expect(',') {
+		return false
+	}
+	d.first = false
+	d.key = d.stringBytes()
+	return d.expect(':')
+}
+
+func (d *codonJSONReader) beginArray() {
+	if d.expect('[') {
+		d.first = true
+	}
+}
+
+// returns false at the end of the array
+func (d *codonJSONReader) nextElem() bool {
+	if d.err != nil {
+		return false
+	}
+	if d.peek() == ']' {
+		d.pos++
+		d.first = false
+		return false
+	}
+	if !d.first && !d.expect(',') {
+		return false
+	}
+	d.first = false
+	return true
+}
+
+// the returned slice may share the memory of the input
+func (d *codonJSONReader) stringBytes() []byte {
+	if !d.expect('"') {
+		return nil
+	}
+	start := d.pos
+	for i := start; i < len(d.bz); i++ {
+		c := d.bz[i]
+		if c == '"' {
+			d.pos = i + 1
+			return d.bz[start:i]
+		}
+		if c == '\\' || c < 0x20 || c >= 0x80 {
+			return d.unquote(start - 1)
+		}
+	}
+	d.pos = len(d.bz)
+	d.unexpected("'\"'")
+	return nil
+}
+
+// unquotes the string beginning at start with encoding/json, which handles the escapes and invalid UTF-8
+func (d *codonJSONReader) unquote(start int) []byte {
+	end := start + 1
+	for ; end < len(d.bz) && d.bz[end] != '"'; end++ {
+		if d.bz[end] == '\\' {
+			end++
+		}
+	}
+	if end >= len(d.bz) {
+		d.pos = len(d.bz)
+		d.unexpected("'\"'")
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(d.bz[start:end+1], &s); err != nil {
+		d.fail(err)
+		return nil
+	}
+	d.pos = end + 1
+	return []byte(s)
+}
+
+func (d *codonJSONReader) string() string {
+	return string(d.stringBytes())
+}
+
+// reads base64 bytes, an empty string is read as nil
+func (d *codonJSONReader) bytes() []byte {
+	s := d.stringBytes()
+	if d.err != nil || len(s) == 0 {
+		return nil
+	}
+	res, err := base64.StdEncoding.AppendDecode(nil, s)
+	if err != nil {
+		d.fail(err)
+		return nil
+	}
+	return res
+}
+
+// reads base64 bytes into a byte array, whose length must match
+func (d *codonJSONReader) byteArray(a []byte) {
+	bz := d.bytes()
+	if d.err == nil && len(bz) != len(a) {
+		d.failf("byte-length mismatch, got %d want %d", len(bz), len(a))
+		return
+	}
+	copy(a, bz)
+}
+
+func (d *codonJSONReader) bool() bool {
+	if d.err != nil {
+		return false
+	}
+	switch d.peek() {
+	case 't':
+		d.literal("true")
+		return d.err == nil
+	case 'f':
+		d.literal("false")
+	default:
+		d.unexpected("a boolean")
+	}
+	return false
+}
+
+// returns the end of the JSON number beginning at start, or start if there is no number
+func codonScanJSONNumber(bz []byte, start int) int {
+	isDigit := func(i int) bool {
+		return i < len(bz) && bz[i] >= '0' && bz[i] <= '9'
+	}
+	i := start
+	if i < len(bz) && bz[i] == '-' {
+		i++
+	}
+	if i < len(bz) && bz[i] == '0' {
+		i++
+	} else if isDigit(i) {
+		for i++; isDigit(i); i++ {
+		}
+	} else {
+		return start
+	}
+	if i < len(bz) && bz[i] == '.' && isDigit(i+1) {
+		for i += 2; isDigit(i); i++ {
+		}
+	}
+	if i < len(bz) && (bz[i] == 'e' || bz[i] == 'E') {
+		j := i + 1
+		if j < len(bz) && (bz[j] == '+' || bz[j] == '-') {
+			j++
+		}
+		if isDigit(j) {
+			for i = j + 1; isDigit(i); i++ {
+			}
+		}
+	}
+	return i
+}
+
+func (d *codonJSONReader) number() string {
+	if d.err != nil {
+		return ""
+	}
+	d.peek()
+	end := codonScanJSONNumber(d.bz, d.pos)
+	if end == d.pos {
+		d.unexpected("a number")
+		return ""
+	}
+	s := string(d.bz[d.pos:end])
+	d.pos = end
+	return s
+}
+
+// 64-bit integers must be quoted
+func (d *codonJSONReader) quoted() string {
+	s := d.stringBytes()
+	if d.err == nil && (len(s) == 0 || codonScanJSONNumber(s, 0) != len(s)) {
+		d.failf("invalid quoted number %q", s)
+	}
+	return string(s)
+}
+
+func (d *codonJSONReader) parseInt(s string, bitSize int) int64 {
+	if d.err != nil {
+		return 0
+	}
+	i, err := strconv.ParseInt(s, 10, bitSize)
+	if err != nil {
+		d.fail(err)
+	}
+	return i
+}
+
+func (d *codonJSONReader) parseUint(s string, bitSize int) uint64 {
+	if d.err != nil {
+		return 0
+	}
+	u, err := strconv.ParseUint(s, 10, bitSize)
+	if err != nil {
+		d.fail(err)
+	}
+	return u
+}
+
+func (d *codonJSONReader) int(bitSize int) int64 {
+	return d.parseInt(d.number(), bitSize)
+}
+
+func (d *codonJSONReader) uint(bitSize int) uint64 {
+	return d.parseUint(d.number(), bitSize)
+}
+
+func (d *codonJSONReader) quotedInt() int64 {
+	return d.parseInt(d.quoted(), 64)
+}
+
+func (d *codonJSONReader) quotedUint() uint64 {
+	return d.parseUint(d.quoted(), 64)
+}
+
+func (d *codonJSONReader) float(bitSize int) float64 {
+	s := d.number()
+	if d.err != nil {
+		return 0
+	}
+	f, err := strconv.ParseFloat(s, bitSize)
+	if err != nil {
+		d.fail(err)
+	}
+	return f
+}
+
+// the keys of maps are strings, even for integers and bools
+func (d *codonJSONReader) keyBool() bool {
+	switch string(d.key) {
+	case "true":
+		return true
+	case "false":
+	default:
+		d.failf("invalid bool key %q", d.key)
+	}
+	return false
+}
+
+func (d *codonJSONReader) keyInt(bitSize int) int64 {
+	return d.parseInt(string(d.key), bitSize)
+}
+
+func (d *codonJSONReader) keyUint(bitSize int) uint64 {
+	return d.parseUint(string(d.key), bitSize)
+}
+
+const codonMaxJSONDepth = 10000
+
+// skips the next value and checks its syntax
+func (d *codonJSONReader) skip() {
+	d.skipValue(0)
+}
+
+func (d *codonJSONReader) skipValue(depth int) {
+	if depth > codonMaxJSONDepth {
+		d.failf("exceeded max depth %d", codonMaxJSONDepth)
+		return
+	}
+	switch d.peek() {
+	case '{':
+		d.beginObject()
+		for d.nextMember() {
+			d.skipValue(depth + 1)
+		}
+	case '[':
+		d.beginArray()
+		for d.nextElem() {
+			d.skipValue(depth + 1)
+		}
+	case '"':
+		d.stringBytes()
+	case 't', 'f':
+		d.bool()
+	case 'n':
+		d.null()
+	default:
+		d.number()
+	}
+}
+
+// returns the bytes of the next value
+func (d *codonJSONReader) raw() []byte {
+	d.peek()
+	start := d.pos
+	d.skip()
+	if d.err != nil {
+		return nil
+	}
+	return d.bz[start:d.pos]
+}
+
+func (d *codonJSONReader) unmarshaler(u json.Unmarshaler) {
+	if raw := d.raw(); d.err == nil {
+		if err := u.UnmarshalJSON(raw); err != nil {
+			d.fail(err)
+		}
+	}
+}
+
+func (d *codonJSONReader) unmarshal(v interface{}) {
+	if raw := d.raw(); d.err == nil {
+		if err := json.Unmarshal(raw, v); err != nil {
+			d.fail(err)
+		}
+	}
+}
+
+// reads the wrapper of a registered type, and returns the type's name and a reader of its value.
+// The value is read after the name is known, so the order of "type" and "value" does not matter.
+func (d *codonJSONReader) typeValue() (name string, value *codonJSONReader) {
+	var raw []byte
+	d.beginObject()
+	for d.nextMember() {
+		switch string(d.key) {
+		case "type":
+			name = d.string()
+		case "value":
+			raw = d.raw()
+		default:
+			d.skip()
+		}
+	}
+	if d.err == nil && len(name) == 0 {
+		d.failf("JSON encoding of interfaces require non-empty type field")
+	} else if d.err == nil && len(raw) == 0 {
+		d.failf("interface JSON wrapper should have non-empty value field")
+	}
+	return name, &codonJSONReader{bz: raw, err: d.err}
+}
+
+// takes the error of the reader returned by typeValue
+func (d *codonJSONReader) merge(value *codonJSONReader) {
+	if value.err != nil {
+		d.fail(value.err)
+	}
+}
+
+// checks that only spaces follow the value, and returns the first error
+func (d *codonJSONReader) finish() error {
+	if d.err == nil {
+		d.peek()
+		if d.pos < len(d.bz) {
+			d.unexpected("the end of JSON input")
+		}
+	}
+	return d.err
+}
+
+// Encoder writes a sequence of length-prefixed values to an io.Writer. The encoded bytes of a value
+// are written in chunks, so a big value does not need a big buffer.
+type Encoder struct {
+	w   *bufio.Writer
+	buf []byte
+}
+
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: bufio.NewWriter(w)}
+}
+
+// Encode writes a registered value with its length prefix, just like MarshalBinaryLengthPrefixed
+func (e *Encoder) Encode(v interface{}) error {
+	if _, ok := getMagicNumOfVar(v); !ok {
+		return errors.New("Not Supported Type")
+	}
+	s := &codonSizes{out: e.w}
+	size := sizeAny(v, s)
+	e.buf = e.buf[:0]
+	codonWriteUvarint(&e.buf, uint64(size))
+	encodeAny(&e.buf, v, s)
+	if s.err == nil {
+		_, s.err = e.w.Write(e.buf)
+	}
+	return s.err
+}
+
+// Flush writes the buffered bytes to the underlying io.Writer
+func (e *Encoder) Flush() error {
+	return e.w.Flush()
+}
+
+// Decoder reads the values written by Encoder from an io.Reader. After an error, the rest of the stream
+// cannot be read.
+type Decoder struct {
+	// The limits used when decoding each value. MaxTotalBytes also limits the length prefixes.
+	DecodeOptions DecodeOptions
+	r             *bufio.Reader
+	buf           []byte
+	// the number of bytes left in the struct read by a DecodeEach function
+	remaining uint64
+}
+
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: bufio.NewReader(r)}
+}
+
+// Decode reads a value written by Encoder.Encode. io.EOF is returned at the end of the stream.
+func (dec *Decoder) Decode() (interface{}, error) {
+	length, err := dec.readLength()
+	if err != nil {
+		return nil, err
+	}
+	bz, err := dec.read(dec.buf[:0], length)
+	if err != nil {
+		return nil, err
+	}
+	dec.buf = bz
+	v, _, err := DecodeAnyWithOptions(bz, dec.DecodeOptions)
+	return v, err
+}
+
+func (dec *Decoder) readLength() (uint64, error) {
+	length, err := binary.ReadUvarint(dec.r)
+	if err != nil {
+		return 0, err
+	}
+	if max := dec.DecodeOptions.MaxTotalBytes; max > 0 && length > uint64(max) {
+		return 0, &ErrLimitExceeded{Limit: "MaxTotalBytes", Max: max, Actual: int(length)}
+	}
+	return length, nil
+}
+
+// appends n bytes read from the stream to buf
+func (dec *Decoder) read(buf []byte, n uint64) ([]byte, error) {
+	start := len(buf)
+	if uint64(cap(buf)-start) < n {
+		newBuf := make([]byte, start, uint64(start)+n)
+		copy(newBuf, buf)
+		buf = newBuf
+	}
+	buf = buf[:uint64(start)+n]
+	_, err := io.ReadFull(dec.r, buf[start:])
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return buf, err
+}
+
+// appends a varint read from the stream to buf, and returns its value
+func (dec *Decoder) readVarint(buf []byte) ([]byte, uint64, error) {
+	start := len(buf)
+	for i := 0; i < binary.MaxVarintLen64; i++ {
+		b, err := dec.r.ReadByte()
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return buf, 0, err
+		}
+		buf = append(buf, b)
+		if b < 0x80 {
+			v, n := binary.Uvarint(buf[start:])
+			if n <= 0 {
+				return buf, 0, errors.New("EOF decoding varint")
+			}
+			return buf, v, nil
+		}
+	}
+	return buf, 0, errors.New("EOF decoding varint")
+}
+
+// begins to read a struct written by Encoder.Encode, and checks its magic number (or its prefix bytes
+// in the amino-compatible mode) against the alias. Then its fields can be read by nextField.
+func (dec *Decoder) beginStruct(magicNum uint32, aminoCompatible bool, alias string) error {
+	length, err := dec.readLength()
+	if err != nil {
+		return err
+	}
+	var header []byte
+	var found uint32
+	if aminoCompatible {
+		if length < 4 {
+			return errors.New("Prefix Bytes Too Short")
+		}
+		if header, err = dec.read(nil, 4); err != nil {
+			return err
+		}
+		found = binary.BigEndian.Uint32(header)
+		dec.remaining = length - 4
+	} else {
+		var tag, size uint64
+		if header, tag, err = dec.readVarint(nil); err != nil {
+			return err
+		}
+		if header, size, err = dec.readVarint(header); err != nil {
+			return err
+		}
+		if tag&7 != 2 || uint64(len(header)) > length || size != length-uint64(len(header)) {
+			return errors.New("Length Mismatch")
+		}
+		found = uint32(tag >> 3)
+		dec.remaining = size
+	}
+	if found != magicNum {
+		return &ErrTypeMismatch{MagicNum: found, Alias: getAliasOfMagicNum(found), Target: alias}
+	}
+	return nil
+}
+
+// reads the next field of the struct begun by beginStruct, and returns its tag and the bytes after
+// the tag. io.EOF is returned at the end of the struct.
+func (dec *Decoder) nextField() (tag uint64, bz []byte, err error) {
+	if dec.remaining == 0 {
+		return 0, nil, io.EOF
+	}
+	var tagBuf [binary.MaxVarintLen64]byte
+	var tagBz []byte
+	if tagBz, tag, err = dec.readVarint(tagBuf[:0]); err != nil {
+		return
+	}
+	bz = dec.buf[:0]
+	switch tag & 7 {
+	case 0: // varint
+		bz, _, err = dec.readVarint(bz)
+	case 1: // fixed64
+		bz, err = dec.read(bz, 8)
+	case 2: // length-delimited
+		var length uint64
+		if bz, length, err = dec.readVarint(bz); err != nil {
+			return
+		}
+		if length > dec.remaining {
+			err = errors.New("Not enough bytes to read")
+			return
+		}
+		bz, err = dec.read(bz, length)
+	case 5: // fixed32
+		bz, err = dec.read(bz, 4)
+	default:
+		err = fmt.Errorf("Unsupported wire type %d", tag&7)
+	}
+	if err != nil {
+		return
+	}
+	dec.buf = bz
+	if n := uint64(len(tagBz) + len(bz)); n <= dec.remaining {
+		dec.remaining -= n
+	} else {
+		err = errors.New("Length Mismatch")
+	}
+	return
+}
+
+// ========= BridgeBegin ============
+type CodecImp struct {
+	sealed        bool
+	decodeOptions DecodeOptions
+	// the names passed to RegisterConcrete, which wrap the registered types in JSON
+	names map[reflect.Type]string
+}
+
+var _ amino.Sealer = &CodecImp{}
+var _ amino.CodecIfc = &CodecImp{}
+var _ amino.JSONCodec = &CodecImp{}
+
+func (cdc *CodecImp) MarshalBinaryBare(o interface{}) ([]byte, error) {
+	s := CodonStub{}
+	return s.MarshalBinaryBare(o)
+}
+func (cdc *CodecImp) MarshalBinaryLengthPrefixed(o interface{}) ([]byte, error) {
+	s := CodonStub{}
+	return s.MarshalBinaryLengthPrefixed(o)
+}
+func (cdc *CodecImp) MarshalBinaryLengthPrefixedWriter(w io.Writer, o interface{}) (n int64, err error) {
+	bz, err := cdc.MarshalBinaryLengthPrefixed(o)
+	m, err := w.Write(bz)
+	return int64(m), err
+}
+func (cdc *CodecImp) UnmarshalBinaryBare(bz []byte, ptr interface{}) error {
+	s := CodonStub{DecodeOptions: cdc.decodeOptions}
+	return s.UnmarshalBinaryBare(bz, ptr)
+}
+func (cdc *CodecImp) UnmarshalBinaryLengthPrefixed(bz []byte, ptr interface{}) error {
+	s := CodonStub{DecodeOptions: cdc.decodeOptions}
+	return s.UnmarshalBinaryLengthPrefixed(bz, ptr)
+}
+func (cdc *CodecImp) UnmarshalBinaryLengthPrefixedReader(r io.Reader, ptr interface{}, maxSize int64) (n int64, err error) {
+	if maxSize < 0 {
+		panic("maxSize cannot be negative.")
+	}
+
+	// Read byte-length prefix.
+	var l int64
+	var buf [binary.MaxVarintLen64]byte
+	for i := 0; i < len(buf); i++ {
+		_, err = r.Read(buf[i : i+1])
+		if err != nil {
+			return
+		}
+		n += 1
+		if buf[i]&0x80 == 0 {
+			break
+		}
+		if n >= maxSize {
+			err = fmt.Errorf("Read overflow, maxSize is %v but uvarint(length-prefix) is itself greater than maxSize.", maxSize)
+		}
+	}
+	u64, _ := binary.Uvarint(buf[:])
+	if err != nil {
+		return
+	}
+	if maxSize > 0 {
+		if uint64(maxSize) < u64 {
+			err = fmt.Errorf("Read overflow, maxSize is %v but this amino binary object is %v bytes.", maxSize, u64)
+			return
+		}
+		if (maxSize - n) < int64(u64) {
+			err = fmt.Errorf("Read overflow, maxSize is %v but this length-prefixed amino binary object is %v+%v bytes.", maxSize, n, u64)
+			return
+		}
+	}
+	l = int64(u64)
+	if l < 0 {
+		err = fmt.Errorf("Read overflow, this implementation can't read this because, why would anyone have this much data?")
+	}
+
+	// Read that many bytes.
+	var bz = make([]byte, l, l)
+	_, err = io.ReadFull(r, bz)
+	if err != nil {
+		return
+	}
+	n += l
+
+	// Decode.
+	err = cdc.UnmarshalBinaryBare(bz, ptr)
+	return
+}
+
+func (cdc *CodecImp) MarshalJSONAny(o interface{}) ([]byte, error) {
+	if rv := reflect.ValueOf(o); rv.Kind() == reflect.Ptr && rv.Elem().Kind() == reflect.Interface {
+		o = rv.Elem().Interface()
+	}
+	// a nil pointer is written as null, just like amino does
+	if rv := reflect.ValueOf(o); o == nil || (rv.Kind() == reflect.Ptr && rv.IsNil()) {
+		return []byte("null"), nil
+	}
+	if _, ok := getMagicNumOfVar(o); !ok {
+		return nil, amino.ErrUnsupportedType
+	}
+	_, wrap := cdc.names[derefPtr(o)]
+	w := &codonJSONWriter{}
+	encodeJSONAny(w, o, wrap)
+	return w.result()
+}
+func (cdc *CodecImp) MarshalJSONIndent(o interface{}, prefix, indent string) ([]byte, error) {
+	bz, err := cdc.MarshalJSONAny(o)
+	if err != nil {
+		return nil, err
+	}
+	var out bytes.Buffer
+	err = json.Indent(&out, bz, prefix, indent)
+	if err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+func (cdc *CodecImp) UnmarshalJSONAny(bz []byte, ptr interface{}) error {
+	if len(bz) == 0 {
+		return errors.New("UnmarshalJSONAny cannot decode empty bytes")
+	}
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr {
+		return errors.New("UnmarshalJSONAny expects a pointer")
+	}
+	d := &codonJSONReader{bz: bz}
+	value := d
+	if name, ok := cdc.names[rv.Elem().Type()]; ok {
+		var typeName string
+		typeName, value = d.typeValue()
+		if d.err == nil && typeName != name {
+			return fmt.Errorf("UnmarshalJSONAny wants to decode a %v but found a %v", name, typeName)
+		}
+	}
+	if !decodeJSONPtr(value, ptr) {
+		return amino.ErrUnsupportedType
+	}
+	d.merge(value)
+	return d.finish()
+}
+
+//------
+
+func (cdc *CodecImp) MustMarshalBinaryBare(o interface{}) []byte {
+	bz, err := cdc.MarshalBinaryBare(o)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+func (cdc *CodecImp) MustMarshalBinaryLengthPrefixed(o interface{}) []byte {
+	bz, err := cdc.MarshalBinaryLengthPrefixed(o)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+func (cdc *CodecImp) MustUnmarshalBinaryBare(bz []byte, ptr interface{}) {
+	err := cdc.UnmarshalBinaryBare(bz, ptr)
+	if err != nil {
+		panic(err)
+	}
+}
+func (cdc *CodecImp) MustUnmarshalBinaryLengthPrefixed(bz []byte, ptr interface{}) {
+	err := cdc.UnmarshalBinaryLengthPrefixed(bz, ptr)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ====================
+func derefPtr(v interface{}) reflect.Type {
+	t := reflect.TypeOf(v)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+func (cdc *CodecImp) PrintTypes(out io.Writer) error {
+	for _, entry := range GetSupportList() {
+		_, err := out.Write([]byte(entry))
+		if err != nil {
+			return err
+		}
+		_, err = out.Write([]byte("\n"))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (cdc *CodecImp) RegisterConcrete(o interface{}, name string, copts *amino.ConcreteOptions) {
+	if cdc.sealed {
+		panic("Codec is already sealed")
+	}
+	t := derefPtr(o)
+	path := t.PkgPath() + "." + t.Name()
+	found := false
+	for _, entry := range GetSupportList() {
+		if path == entry {
+			found = true
+			break
+		}
+	}
+	if !found {
+		panic(fmt.Sprintf("%s is not supported", path))
+	}
+	if cdc.names == nil {
+		cdc.names = make(map[reflect.Type]string)
+	}
+	cdc.names[t] = name
+}
+func (cdc *CodecImp) RegisterInterface(o interface{}, _ *amino.InterfaceOptions) {
+	if cdc.sealed {
+		panic("Codec is already sealed")
+	}
+	t := derefPtr(o)
+	path := t.PkgPath() + "." + t.Name()
+	found := false
+	for _, entry := range GetSupportList() {
+		if path == entry {
+			found = true
+			break
+		}
+	}
+	if !found {
+		panic(fmt.Sprintf("%s is not supported", path))
+	}
+}
+func (cdc *CodecImp) SealImp() {
+	if cdc.sealed {
+		panic("Codec is already sealed")
+	}
+	cdc.sealed = true
+}
+
+// ========================================
+
+type CodonStub struct {
+	// The limits used by UnmarshalBinaryBare and the CodecImp created by NewCodecImp
+	DecodeOptions DecodeOptions
+}
+
+func (s *CodonStub) NewCodecImp() amino.CodecIfc {
+	return &CodecImp{decodeOptions: s.DecodeOptions}
+}
+func (_ *CodonStub) DeepCopy(o interface{}) (r interface{}) {
+	r = DeepCopyAny(o)
+	return
+}
+
+func (_ *CodonStub) MarshalBinaryBare(o interface{}) ([]byte, error) {
+	if _, ok := getMagicNumOfVar(o); !ok {
+		return nil, errors.New("Not Supported Type")
+	}
+	var buf []byte
+	EncodeAny(&buf, o)
+	return buf, nil
+}
+func (_ *CodonStub) MarshalBinaryLengthPrefixed(o interface{}) ([]byte, error) {
+	if _, ok := getMagicNumOfVar(o); !ok {
+		return nil, errors.New("Not Supported Type")
+	}
+	s := &codonSizes{}
+	size := sizeAny(o, s)
+	buf := make([]byte, 0, codonByteSliceSize(size))
+	codonWriteUvarint(&buf, uint64(size))
+	encodeAny(&buf, o, s)
+	return buf, nil
+}
+func (s *CodonStub) UnmarshalBinaryBare(bz []byte, ptr interface{}) error {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr {
+		panic("Unmarshal expects a pointer")
+	}
+
+	if len(bz) < 4 {
+		return fmt.Errorf("Byte slice is too short: %d", len(bz))
+	}
+	o, _, err := DecodeAnyWithOptions(bz, s.DecodeOptions)
+	if err != nil {
+		return err
+	}
+	if rv.Elem().Kind() == reflect.Interface {
+		return AssignIfcPtrFromStruct(ptr, o)
+	}
+	ov := reflect.ValueOf(o)
+	if !ov.Type().AssignableTo(rv.Elem().Type()) {
+		return newErrTypeMismatch(o, ptr)
+	}
+	rv.Elem().Set(ov)
+	return nil
+}
+func (s *CodonStub) UnmarshalBinaryLengthPrefixed(bz []byte, ptr interface{}) error {
+	if len(bz) == 0 {
+		return errors.New("UnmarshalBinaryLengthPrefixed cannot decode empty bytes")
+	}
+	// Read byte-length prefix.
+	u64, n := binary.Uvarint(bz)
+	if n < 0 {
+		return fmt.Errorf("Error reading msg byte-length prefix: got code %v", n)
+	}
+	if u64 > uint64(len(bz)-n) {
+		return fmt.Errorf("Not enough bytes to read in UnmarshalBinaryLengthPrefixed, want %v more bytes but only have %v",
+			u64, len(bz)-n)
+	} else if u64 < uint64(len(bz)-n) {
+		return fmt.Errorf("Bytes left over in UnmarshalBinaryLengthPrefixed, should read %v more bytes but have %v",
+			u64, len(bz)-n)
+	}
+	bz = bz[n:]
+	return s.UnmarshalBinaryBare(bz, ptr)
+}
+func (s *CodonStub) MustMarshalBinaryLengthPrefixed(o interface{}) []byte {
+	bz, err := s.MarshalBinaryLengthPrefixed(o)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// ========================================
+func (_ *CodonStub) UvarintSize(u uint64) int {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], u)
+	return n
+}
+func (_ *CodonStub) EncodeByteSlice(w io.Writer, bz []byte) error {
+	buf := make([]byte, 0, codonByteSliceSize(len(bz)))
+	codonWriteUvarint(&buf, uint64(len(bz)))
+	buf = append(buf, bz...)
+	_, err := w.Write(buf)
+	return err
+}
+func (s *CodonStub) ByteSliceSize(bz []byte) int {
+	return s.UvarintSize(uint64(len(bz))) + len(bz)
+}
+func (_ *CodonStub) EncodeVarint(w io.Writer, i int64) error {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(buf[:], i)
+	_, err := w.Write(buf[:n])
+	return err
+}
+func (s *CodonStub) EncodeInt8(w io.Writer, i int8) error {
+	return s.EncodeVarint(w, int64(i))
+}
+func (s *CodonStub) EncodeInt16(w io.Writer, i int16) error {
+	return s.EncodeVarint(w, int64(i))
+}
+func (s *CodonStub) EncodeInt32(w io.Writer, i int32) error {
+	return s.EncodeVarint(w, int64(i))
+}
+func (s *CodonStub) EncodeInt64(w io.Writer, i int64) error {
+	return s.EncodeVarint(w, int64(i))
+}
+func (_ *CodonStub) EncodeUvarint(w io.Writer, u uint64) error {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], u)
+	_, err := w.Write(buf[:n])
+	return err
+}
+func (s *CodonStub) EncodeByte(w io.Writer, b byte) error {
+	return s.EncodeUvarint(w, uint64(b))
+}
+func (s *CodonStub) EncodeUint8(w io.Writer, u uint8) error {
+	return s.EncodeUvarint(w, uint64(u))
+}
+func (s *CodonStub) EncodeUint16(w io.Writer, u uint16) error {
+	return s.EncodeUvarint(w, uint64(u))
+}
+func (s *CodonStub) EncodeUint32(w io.Writer, u uint32) error {
+	return s.EncodeUvarint(w, uint64(u))
+}
+func (s *CodonStub) EncodeUint64(w io.Writer, u uint64) error {
+	return s.EncodeUvarint(w, uint64(u))
+}
+func (_ *CodonStub) EncodeBool(w io.Writer, b bool) error {
+	u := byte(0)
+	if b {
+		u = byte(1)
+	}
+	_, err := w.Write([]byte{u})
+	return err
+}
+func (s *CodonStub) EncodeString(w io.Writer, str string) error {
+	return s.EncodeByteSlice(w, []byte(str))
+}
+func (_ *CodonStub) DecodeInt8(bz []byte) (i int8, n int, err error) {
+	i = codonDecodeInt8(bz, &n, &err)
+	return
+}
+func (_ *CodonStub) DecodeInt16(bz []byte) (i int16, n int, err error) {
+	i = codonDecodeInt16(bz, &n, &err)
+	return
+}
+func (_ *CodonStub) DecodeInt32(bz []byte) (i int32, n int, err error) {
+	i = codonDecodeInt32(bz, &n, &err)
+	return
+}
+func (_ *CodonStub) DecodeInt64(bz []byte) (i int64, n int, err error) {
+	i = codonDecodeInt64(bz, &n, &err)
+	return
+}
+func (_ *CodonStub) DecodeVarint(bz []byte) (i int64, n int, err error) {
+	i = codonDecodeInt64(bz, &n, &err)
+	return
+}
+func (s *CodonStub) DecodeByte(bz []byte) (b byte, n int, err error) {
+	b = codonDecodeUint8(bz, &n, &err)
+	return
+}
+func (_ *CodonStub) DecodeUint8(bz []byte) (u uint8, n int, err error) {
+	u = codonDecodeUint8(bz, &n, &err)
+	return
+}
+func (_ *CodonStub) DecodeUint16(bz []byte) (u uint16, n int, err error) {
+	u = codonDecodeUint16(bz, &n, &err)
+	return
+}
+func (_ *CodonStub) DecodeUint32(bz []byte) (u uint32, n int, err error) {
+	u = codonDecodeUint32(bz, &n, &err)
+	return
+}
+func (_ *CodonStub) DecodeUint64(bz []byte) (u uint64, n int, err error) {
+	u = codonDecodeUint64(bz, &n, &err)
+	return
+}
+func (_ *CodonStub) DecodeUvarint(bz []byte) (u uint64, n int, err error) {
+	u = codonDecodeUint64(bz, &n, &err)
+	return
+}
+func (_ *CodonStub) DecodeBool(bz []byte) (b bool, n int, err error) {
+	b = codonDecodeBool(bz, &n, &err)
+	return
+}
+func (_ *CodonStub) DecodeByteSlice(bz []byte) (bz2 []byte, n int, err error) {
+	m, err := codonGetByteSlice(&bz2, bz)
+	n += m
+	return
+}
+func (_ *CodonStub) DecodeString(bz []byte) (s string, n int, err error) {
+	s = codonDecodeString(bz, &n, &err)
+	return
+}
+func (_ *CodonStub) VarintSize(i int64) int {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(buf[:], i)
+	return n
+}
+
+// ========= BridgeEnd ============
+
+// Non-Interface
+func EncodeCoin(w *[]byte, v Coin) {
+	s := &codonSizes{}
+	codonGrow(w, sizeCoin(v, s))
+	encodeCoin(w, v, s)
+}
+func HashCoin(h hash.Hash, v Coin) {
+	s := &codonSizes{out: h}
+	sizeCoin(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeCoin(&w, v, s)
+	h.Write(w)
+}
+func encodeCoin(w *[]byte, v Coin, s *codonSizes) {
+	codonEncodeString(1, w, v.Denom)
+	s.flush(w)
+	codonEncodeUvarint(2, w, uint64(v.Amount))
+} //End of EncodeCoin
+
+func SizeCoin(v Coin) int {
+	return sizeCoin(v, nil)
+}
+func sizeCoin(v Coin, s *codonSizes) (total int) {
+	total += 1 + codonByteSliceSize(len(v.Denom))
+	total += 1 + codonUvarintSize(uint64(v.Amount))
+	return
+} //End of SizeCoin
+
+func DecodeCoin(bz []byte) (Coin, int, error) {
+	return decodeCoin(bz, nil)
+}
+func DecodeCoinNoCopy(bz []byte) (Coin, int, error) {
+	return decodeCoin(bz, &codonDecoder{noCopy: true})
+}
+func DecodeCoinWithOptions(bz []byte, opts DecodeOptions) (v Coin, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeCoin(bz, d)
+}
+func decodeCoin(bz []byte, d *codonDecoder) (v Coin, total int, err error) {
+	var n int
+	if err = d.enter(); err != nil {
+		return
+	}
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Denom
+			v.Denom = string(d.decodeString(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 2: // v.Amount
+			v.Amount = int64(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			err = errors.New("Unknown Field")
+			return
+		}
+	} // end for
+	d.leave()
+	return v, total, nil
+} //End of DecodeCoin
+
+func PeekCoin_Denom(bz []byte) (res string, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Denom
+			res = string(d.decodeString(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekCoin_Denom
+
+func PeekCoin_Amount(bz []byte) (res int64, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 2: // v.Amount
+			res = int64(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekCoin_Amount
+
+func RandCoin(r RandSrc) Coin {
+	var v Coin
+	v.Denom = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	v.Amount = r.GetInt64()
+	return v
+} //End of RandCoin
+
+func DeepCopyCoin(in Coin) (out Coin) {
+	out.Denom = in.Denom
+	out.Amount = in.Amount
+	return
+} //End of DeepCopyCoin
+
+func EqualCoin(a, b Coin) bool {
+	if a.Denom != b.Denom {
+		return false
+	}
+	if a.Amount != b.Amount {
+		return false
+	}
+	return true
+} //End of EqualCoin
+
+func EncodeJSONCoin(v Coin) ([]byte, error) {
+	w := &codonJSONWriter{}
+	encodeJSONCoin(w, &v)
+	return w.result()
+}
+func encodeJSONCoin(w *codonJSONWriter, v *Coin) {
+	if m, ok := interface{}(v).(json.Marshaler); ok {
+		w.marshaler(m)
+		return
+	}
+	w.raw("{")
+	w.key("\"Denom\":")
+	w.string(string(v.Denom))
+	w.key("\"Amount\":")
+	w.quotedInt(int64(v.Amount))
+	w.raw("}")
+	// end of v
+} //End of EncodeJSONCoin
+
+func DecodeJSONCoin(bz []byte) (v Coin, err error) {
+	d := &codonJSONReader{bz: bz}
+	decodeJSONCoin(d, &v)
+	return v, d.finish()
+}
+func decodeJSONCoin(d *codonJSONReader, v *Coin) {
+	if d.null() {
+		*v = Coin{}
+		return
+	}
+	if u, ok := interface{}(v).(json.Unmarshaler); ok {
+		d.unmarshaler(u)
+		return
+	}
+	d.beginObject()
+	for d.nextMember() {
+		switch string(d.key) {
+		case "Denom":
+			if d.null() {
+				v.Denom = ""
+			} else {
+				v.Denom = d.string()
+			}
+		case "Amount":
+			if d.null() {
+				v.Amount = 0
+			} else {
+				v.Amount = d.quotedInt()
+			}
+		default:
+			d.skip()
+		} // end switch
+	} // end for
+	// end of v
+} //End of DecodeJSONCoin
+
+// Non-Interface
+func EncodeFee(w *[]byte, v Fee) {
+	s := &codonSizes{}
+	codonGrow(w, sizeFee(v, s))
+	encodeFee(w, v, s)
+}
+func HashFee(h hash.Hash, v Fee) {
+	s := &codonSizes{out: h}
+	sizeFee(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeFee(&w, v, s)
+	h.Write(w)
+}
+func encodeFee(w *[]byte, v Fee, s *codonSizes) {
+	for _0 := 0; _0 < len(v.Amount); _0++ {
+		codonEncodeLength(1, w, s.next())
+		codonEncodeString(1, w, v.Amount[_0].Denom)
+		s.flush(w)
+		codonEncodeUvarint(2, w, uint64(v.Amount[_0].Amount))
+		// end of v.Amount[_0]
+	}
+	s.flush(w)
+	codonEncodeUvarint(2, w, uint64(v.Gas))
+} //End of EncodeFee
+
+func SizeFee(v Fee) int {
+	return sizeFee(v, nil)
+}
+func sizeFee(v Fee, s *codonSizes) (total int) {
+	for _0 := 0; _0 < len(v.Amount); _0++ {
+		{
+			idx := s.reserve()
+			total += 1 + codonByteSliceSize(s.set(idx, sizeCoin(v.Amount[_0], s)))
+		}
+	}
+	total += 1 + codonUvarintSize(uint64(v.Gas))
+	return
+} //End of SizeFee
+
+func DecodeFee(bz []byte) (Fee, int, error) {
+	return decodeFee(bz, nil)
+}
+func DecodeFeeNoCopy(bz []byte) (Fee, int, error) {
+	return decodeFee(bz, &codonDecoder{noCopy: true})
+}
+func DecodeFeeWithOptions(bz []byte, opts DecodeOptions) (v Fee, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeFee(bz, d)
+}
+func decodeFee(bz []byte, d *codonDecoder) (v Fee, total int, err error) {
+	var n int
+	if err = d.enter(); err != nil {
+		return
+	}
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Amount
+			if err = d.checkSliceLength(len(v.Amount) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var tmp Coin
+			tmp, n, err = decodeCoin(bz[:l], d)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if int(l) != n {
+				err = errors.New("Length Mismatch")
+				return
+			}
+			v.Amount = append(v.Amount, tmp)
+		case 2: // v.Gas
+			v.Gas = uint64(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			err = errors.New("Unknown Field")
+			return
+		}
+	} // end for
+	d.leave()
+	return v, total, nil
+} //End of DecodeFee
+
+func PeekFee_Amount(bz []byte) (res []Coin, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Amount
+			if err = d.checkSliceLength(len(res) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var tmp Coin
+			tmp, n, err = decodeCoin(bz[:l], d)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if int(l) != n {
+				err = errors.New("Length Mismatch")
+				return
+			}
+			res = append(res, tmp)
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekFee_Amount
+
+func PeekFee_Gas(bz []byte) (res uint64, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 2: // v.Gas
+			res = uint64(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekFee_Gas
+
+func RandFee(r RandSrc) Fee {
+	var length int
+	var v Fee
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	if length == 0 {
+		v.Amount = nil
+	} else {
+		v.Amount = make([]Coin, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.Amount[_0] = RandCoin(r)
+	}
+	v.Gas = r.GetUint64()
+	return v
+} //End of RandFee
+
+func DeepCopyFee(in Fee) (out Fee) {
+	var length int
+	length = len(in.Amount)
+	if length == 0 {
+		out.Amount = nil
+	} else {
+		out.Amount = make([]Coin, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		out.Amount[_0] = DeepCopyCoin(in.Amount[_0])
+	}
+	out.Gas = in.Gas
+	return
+} //End of DeepCopyFee
+
+func EqualFee(a, b Fee) bool {
+	if len(a.Amount) != len(b.Amount) {
+		return false
+	}
+	for _0 := range a.Amount { //slice of struct
+		if a.Amount[_0].Denom != b.Amount[_0].Denom {
+			return false
+		}
+		if a.Amount[_0].Amount != b.Amount[_0].Amount {
+			return false
+		}
+		// end of .Amount[_0]
+	}
+	if a.Gas != b.Gas {
+		return false
+	}
+	return true
+} //End of EqualFee
+
+func EncodeJSONFee(v Fee) ([]byte, error) {
+	w := &codonJSONWriter{}
+	encodeJSONFee(w, &v)
+	return w.result()
+}
+func encodeJSONFee(w *codonJSONWriter, v *Fee) {
+	if m, ok := interface{}(v).(json.Marshaler); ok {
+		w.marshaler(m)
+		return
+	}
+	w.raw("{")
+	w.key("\"Amount\":")
+	if v.Amount == nil {
+		w.raw("null")
+	} else {
+		w.raw("[")
+		for _0 := range v.Amount {
+			w.comma('[')
+			encodeJSONCoin(w, &v.Amount[_0])
+		}
+		w.raw("]")
+	}
+	w.key("\"Gas\":")
+	w.quotedUint(uint64(v.Gas))
+	w.raw("}")
+	// end of v
+} //End of EncodeJSONFee
+
+func DecodeJSONFee(bz []byte) (v Fee, err error) {
+	d := &codonJSONReader{bz: bz}
+	decodeJSONFee(d, &v)
+	return v, d.finish()
+}
+func decodeJSONFee(d *codonJSONReader, v *Fee) {
+	if d.null() {
+		*v = Fee{}
+		return
+	}
+	if u, ok := interface{}(v).(json.Unmarshaler); ok {
+		d.unmarshaler(u)
+		return
+	}
+	d.beginObject()
+	for d.nextMember() {
+		switch string(d.key) {
+		case "Amount":
+			if d.null() {
+				v.Amount = nil
+			} else {
+				v.Amount = nil
+				d.beginArray()
+				for d.nextElem() {
+					var tmp_0 Coin
+					decodeJSONCoin(d, &tmp_0)
+					v.Amount = append(v.Amount, tmp_0)
+				}
+			}
+		case "Gas":
+			if d.null() {
+				v.Gas = 0
+			} else {
+				v.Gas = d.quotedUint()
+			}
+		default:
+			d.skip()
+		} // end switch
+	} // end for
+	// end of v
+} //End of DecodeJSONFee
+
+func (dec *Decoder) DecodeEachFee(fn func(field string, elem interface{}) error) (v Fee, err error) {
+	if err = dec.beginStruct(3195000021, true, "Fee"); err != nil {
+		return
+	}
+	d := &codonDecoder{opts: dec.DecodeOptions}
+	if err = d.enter(); err != nil {
+		return
+	}
+	var n, total int
+	counts := make(map[string]int)
+	for {
+		tag, bz, e := dec.nextField()
+		if e == io.EOF {
+			break
+		}
+		if e != nil {
+			err = e
+			return
+		}
+		switch tag >> 3 {
+		case 1: // v.Amount
+			var elems []Coin
+			if err = d.checkSliceLength(len(elems) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var tmp Coin
+			tmp, n, err = decodeCoin(bz[:l], d)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if int(l) != n {
+				err = errors.New("Length Mismatch")
+				return
+			}
+			elems = append(elems, tmp)
+			for _, elem := range elems {
+				counts["Amount"]++
+				if err = d.checkSliceLength(counts["Amount"]); err != nil {
+					return
+				}
+				if err = fn("Amount", elem); err != nil {
+					return
+				}
+			}
+		case 2: // v.Gas
+			v.Gas = uint64(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			err = errors.New("Unknown Field")
+			return
+		}
+	} // end for
+	_ = total
+	d.leave()
+	return v, nil
+} //End of DecodeEachFee
+
+// Non-Interface
+func EncodeMsgSend(w *[]byte, v MsgSend) {
+	s := &codonSizes{}
+	codonGrow(w, sizeMsgSend(v, s))
+	encodeMsgSend(w, v, s)
+}
+func HashMsgSend(h hash.Hash, v MsgSend) {
+	s := &codonSizes{out: h}
+	sizeMsgSend(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeMsgSend(&w, v, s)
+	h.Write(w)
+}
+func encodeMsgSend(w *[]byte, v MsgSend, s *codonSizes) {
+	codonEncodeByteSlice(1, w, v.From[:])
+	s.flush(w)
+	codonEncodeByteSlice(2, w, v.To[:])
+	s.flush(w)
+	for _0 := 0; _0 < len(v.Amount); _0++ {
+		codonEncodeLength(3, w, s.next())
+		codonEncodeString(1, w, v.Amount[_0].Denom)
+		s.flush(w)
+		codonEncodeUvarint(2, w, uint64(v.Amount[_0].Amount))
+		// end of v.Amount[_0]
+	}
+	s.flush(w)
+} //End of EncodeMsgSend
+
+func SizeMsgSend(v MsgSend) int {
+	return sizeMsgSend(v, nil)
+}
+func sizeMsgSend(v MsgSend, s *codonSizes) (total int) {
+	total += 1 + codonByteSliceSize(len(v.From))
+	total += 1 + codonByteSliceSize(len(v.To))
+	for _0 := 0; _0 < len(v.Amount); _0++ {
+		{
+			idx := s.reserve()
+			total += 1 + codonByteSliceSize(s.set(idx, sizeCoin(v.Amount[_0], s)))
+		}
+	}
+	return
+} //End of SizeMsgSend
+
+func DecodeMsgSend(bz []byte) (MsgSend, int, error) {
+	return decodeMsgSend(bz, nil)
+}
+func DecodeMsgSendNoCopy(bz []byte) (MsgSend, int, error) {
+	return decodeMsgSend(bz, &codonDecoder{noCopy: true})
+}
+func DecodeMsgSendWithOptions(bz []byte, opts DecodeOptions) (v MsgSend, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeMsgSend(bz, d)
+}
+func decodeMsgSend(bz []byte, d *codonDecoder) (v MsgSend, total int, err error) {
+	var n int
+	if err = d.enter(); err != nil {
+		return
+	}
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.From
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			v.From = tmpBz
+		case 2: // v.To
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			v.To = tmpBz
+		case 3: // v.Amount
+			if err = d.checkSliceLength(len(v.Amount) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var tmp Coin
+			tmp, n, err = decodeCoin(bz[:l], d)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if int(l) != n {
+				err = errors.New("Length Mismatch")
+				return
+			}
+			v.Amount = append(v.Amount, tmp)
+		default:
+			err = errors.New("Unknown Field")
+			return
+		}
+	} // end for
+	d.leave()
+	return v, total, nil
+} //End of DecodeMsgSend
+
+func PeekMsgSend_From(bz []byte) (res []uint8, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.From
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			res = tmpBz
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekMsgSend_From
+
+func PeekMsgSend_To(bz []byte) (res []uint8, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 2: // v.To
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			res = tmpBz
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekMsgSend_To
+
+func PeekMsgSend_Amount(bz []byte) (res []Coin, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 3: // v.Amount
+			if err = d.checkSliceLength(len(res) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var tmp Coin
+			tmp, n, err = decodeCoin(bz[:l], d)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if int(l) != n {
+				err = errors.New("Length Mismatch")
+				return
+			}
+			res = append(res, tmp)
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekMsgSend_Amount
+
+func RandMsgSend(r RandSrc) MsgSend {
+	var length int
+	var v MsgSend
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.From = r.GetBytes(length)
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.To = r.GetBytes(length)
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	if length == 0 {
+		v.Amount = nil
+	} else {
+		v.Amount = make([]Coin, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.Amount[_0] = RandCoin(r)
+	}
+	return v
+} //End of RandMsgSend
+
+func DeepCopyMsgSend(in MsgSend) (out MsgSend) {
+	var length int
+	length = len(in.From)
+	if length == 0 {
+		out.From = nil
+	} else {
+		out.From = make([]uint8, length)
+	}
+	copy(out.From[:], in.From[:])
+	length = len(in.To)
+	if length == 0 {
+		out.To = nil
+	} else {
+		out.To = make([]uint8, length)
+	}
+	copy(out.To[:], in.To[:])
+	length = len(in.Amount)
+	if length == 0 {
+		out.Amount = nil
+	} else {
+		out.Amount = make([]Coin, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		out.Amount[_0] = DeepCopyCoin(in.Amount[_0])
+	}
+	return
+} //End of DeepCopyMsgSend
+
+func EqualMsgSend(a, b MsgSend) bool {
+	if !bytes.Equal(a.From, b.From) {
+		return false
+	}
+	if !bytes.Equal(a.To, b.To) {
+		return false
+	}
+	if len(a.Amount) != len(b.Amount) {
+		return false
+	}
+	for _0 := range a.Amount { //slice of struct
+		if a.Amount[_0].Denom != b.Amount[_0].Denom {
+			return false
+		}
+		if a.Amount[_0].Amount != b.Amount[_0].Amount {
+			return false
+		}
+		// end of .Amount[_0]
+	}
+	return true
+} //End of EqualMsgSend
+
+func EncodeJSONMsgSend(v MsgSend) ([]byte, error) {
+	w := &codonJSONWriter{}
+	encodeJSONMsgSend(w, &v)
+	return w.result()
+}
+func encodeJSONMsgSend(w *codonJSONWriter, v *MsgSend) {
+	if m, ok := interface{}(v).(json.Marshaler); ok {
+		w.marshaler(m)
+		return
+	}
+	w.raw("{")
+	w.key("\"From\":")
+	w.bytes(v.From)
+	w.key("\"To\":")
+	w.bytes(v.To)
+	w.key("\"Amount\":")
+	if v.Amount == nil {
+		w.raw("null")
+	} else {
+		w.raw("[")
+		for _0 := range v.Amount {
+			w.comma('[')
+			encodeJSONCoin(w, &v.Amount[_0])
+		}
+		w.raw("]")
+	}
+	w.raw("}")
+	// end of v
+} //End of EncodeJSONMsgSend
+
+func DecodeJSONMsgSend(bz []byte) (v MsgSend, err error) {
+	d := &codonJSONReader{bz: bz}
+	decodeJSONMsgSend(d, &v)
+	return v, d.finish()
+}
+func decodeJSONMsgSend(d *codonJSONReader, v *MsgSend) {
+	if d.null() {
+		*v = MsgSend{}
+		return
+	}
+	if u, ok := interface{}(v).(json.Unmarshaler); ok {
+		d.unmarshaler(u)
+		return
+	}
+	d.beginObject()
+	for d.nextMember() {
+		switch string(d.key) {
+		case "From":
+			if d.null() {
+				v.From = nil
+			} else {
+				v.From = d.bytes()
+			}
+		case "To":
+			if d.null() {
+				v.To = nil
+			} else {
+				v.To = d.bytes()
+			}
+		case "Amount":
+			if d.null() {
+				v.Amount = nil
+			} else {
+				v.Amount = nil
+				d.beginArray()
+				for d.nextElem() {
+					var tmp_0 Coin
+					decodeJSONCoin(d, &tmp_0)
+					v.Amount = append(v.Amount, tmp_0)
+				}
+			}
+		default:
+			d.skip()
+		} // end switch
+	} // end for
+	// end of v
+} //End of DecodeJSONMsgSend
+
+func (dec *Decoder) DecodeEachMsgSend(fn func(field string, elem interface{}) error) (v MsgSend, err error) {
+	if err = dec.beginStruct(2361653406, true, "MsgSend"); err != nil {
+		return
+	}
+	d := &codonDecoder{opts: dec.DecodeOptions}
+	if err = d.enter(); err != nil {
+		return
+	}
+	var n, total int
+	counts := make(map[string]int)
+	for {
+		tag, bz, e := dec.nextField()
+		if e == io.EOF {
+			break
+		}
+		if e != nil {
+			err = e
+			return
+		}
+		switch tag >> 3 {
+		case 1: // v.From
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			v.From = tmpBz
+		case 2: // v.To
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			v.To = tmpBz
+		case 3: // v.Amount
+			var elems []Coin
+			if err = d.checkSliceLength(len(elems) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var tmp Coin
+			tmp, n, err = decodeCoin(bz[:l], d)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if int(l) != n {
+				err = errors.New("Length Mismatch")
+				return
+			}
+			elems = append(elems, tmp)
+			for _, elem := range elems {
+				counts["Amount"]++
+				if err = d.checkSliceLength(counts["Amount"]); err != nil {
+					return
+				}
+				if err = fn("Amount", elem); err != nil {
+					return
+				}
+			}
+		default:
+			err = errors.New("Unknown Field")
+			return
+		}
+	} // end for
+	_ = total
+	d.leave()
+	return v, nil
+} //End of DecodeEachMsgSend
+
+// Non-Interface
+func EncodeMsgVote(w *[]byte, v MsgVote) {
+	s := &codonSizes{}
+	codonGrow(w, sizeMsgVote(v, s))
+	encodeMsgVote(w, v, s)
+}
+func HashMsgVote(h hash.Hash, v MsgVote) {
+	s := &codonSizes{out: h}
+	sizeMsgVote(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeMsgVote(&w, v, s)
+	h.Write(w)
+}
+func encodeMsgVote(w *[]byte, v MsgVote, s *codonSizes) {
+	codonEncodeByteSlice(1, w, v.Voter[:])
+	s.flush(w)
+	codonEncodeUvarint(2, w, uint64(v.Proposal))
+	if len(v.Options) != 0 {
+		codonEncodeLength(3, w, s.next())
+		for _0 := 0; _0 < len(v.Options); _0++ {
+			codonWriteUvarint(w, uint64(v.Options[_0]))
+		}
+	} // end of packed v.Options
+	s.flush(w)
+	codonEncodeBool(4, w, v.Yes)
+	codonEncodeUvarint(5, w, uint64(v.Weight))
+} //End of EncodeMsgVote
+
+func SizeMsgVote(v MsgVote) int {
+	return sizeMsgVote(v, nil)
+}
+func sizeMsgVote(v MsgVote, s *codonSizes) (total int) {
+	total += 1 + codonByteSliceSize(len(v.Voter))
+	total += 1 + codonUvarintSize(uint64(v.Proposal))
+	if len(v.Options) != 0 {
+		{
+			idx := s.reserve()
+			total += 1 + codonByteSliceSize(s.set(idx, func() (total int) {
+				for _0 := 0; _0 < len(v.Options); _0++ {
+					total += codonUvarintSize(uint64(v.Options[_0]))
+				}
+				return
+			}()))
+		}
+	}
+	total += 2
+	total += 1 + codonUvarintSize(uint64(v.Weight))
+	return
+} //End of SizeMsgVote
+
+func DecodeMsgVote(bz []byte) (MsgVote, int, error) {
+	return decodeMsgVote(bz, nil)
+}
+func DecodeMsgVoteNoCopy(bz []byte) (MsgVote, int, error) {
+	return decodeMsgVote(bz, &codonDecoder{noCopy: true})
+}
+func DecodeMsgVoteWithOptions(bz []byte, opts DecodeOptions) (v MsgVote, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeMsgVote(bz, d)
+}
+func decodeMsgVote(bz []byte, d *codonDecoder) (v MsgVote, total int, err error) {
+	var n int
+	if err = d.enter(); err != nil {
+		return
+	}
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Voter
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			v.Voter = tmpBz
+		case 2: // v.Proposal
+			v.Proposal = uint64(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 3: // v.Options
+			if tag&7 == 2 { // packed v.Options
+				l := codonDecodeUint64(bz, &n, &err)
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				if l > uint64(len(bz)) {
+					err = errors.New("Length Too Large")
+					return
+				}
+				func(bz []byte) {
+					for len(bz) != 0 {
+						if err = d.checkSliceLength(len(v.Options) + 1); err != nil {
+							return
+						}
+						var tmp uint32
+						tmp = uint32(codonDecodeUint32(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						v.Options = append(v.Options, tmp)
+					}
+				}(bz[:l]) // end func
+				if err != nil {
+					return
+				}
+				bz = bz[l:]
+			} else {
+				if err = d.checkSliceLength(len(v.Options) + 1); err != nil {
+					return
+				}
+				var tmp uint32
+				tmp = uint32(codonDecodeUint32(bz, &n, &err))
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				v.Options = append(v.Options, tmp)
+			} // end of packed v.Options
+		case 4: // v.Yes
+			v.Yes = bool(codonDecodeBool(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 5: // v.Weight
+			v.Weight = int32(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			err = errors.New("Unknown Field")
+			return
+		}
+	} // end for
+	d.leave()
+	return v, total, nil
+} //End of DecodeMsgVote
+
+func PeekMsgVote_Voter(bz []byte) (res []uint8, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Voter
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			res = tmpBz
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekMsgVote_Voter
+
+func PeekMsgVote_Proposal(bz []byte) (res uint64, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 2: // v.Proposal
+			res = uint64(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekMsgVote_Proposal
+
+func PeekMsgVote_Options(bz []byte) (res []uint32, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 3: // v.Options
+			if tag&7 == 2 { // packed res
+				l := codonDecodeUint64(bz, &n, &err)
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				if l > uint64(len(bz)) {
+					err = errors.New("Length Too Large")
+					return
+				}
+				func(bz []byte) {
+					for len(bz) != 0 {
+						if err = d.checkSliceLength(len(res) + 1); err != nil {
+							return
+						}
+						var tmp uint32
+						tmp = uint32(codonDecodeUint32(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						res = append(res, tmp)
+					}
+				}(bz[:l]) // end func
+				if err != nil {
+					return
+				}
+				bz = bz[l:]
+			} else {
+				if err = d.checkSliceLength(len(res) + 1); err != nil {
+					return
+				}
+				var tmp uint32
+				tmp = uint32(codonDecodeUint32(bz, &n, &err))
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				res = append(res, tmp)
+			} // end of packed res
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekMsgVote_Options
+
+func PeekMsgVote_Yes(bz []byte) (res bool, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 4: // v.Yes
+			res = bool(codonDecodeBool(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekMsgVote_Yes
+
+func PeekMsgVote_Weight(bz []byte) (res int32, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 5: // v.Weight
+			res = int32(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekMsgVote_Weight
+
+func RandMsgVote(r RandSrc) MsgVote {
+	var length int
+	var v MsgVote
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.Voter = r.GetBytes(length)
+	v.Proposal = r.GetUint64()
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	if length == 0 {
+		v.Options = nil
+	} else {
+		v.Options = make([]uint32, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of uint32
+		v.Options[_0] = r.GetUint32()
+	}
+	v.Yes = r.GetBool()
+	v.Weight = r.GetInt32()
+	return v
+} //End of RandMsgVote
+
+func DeepCopyMsgVote(in MsgVote) (out MsgVote) {
+	var length int
+	length = len(in.Voter)
+	if length == 0 {
+		out.Voter = nil
+	} else {
+		out.Voter = make([]uint8, length)
+	}
+	copy(out.Voter[:], in.Voter[:])
+	out.Proposal = in.Proposal
+	length = len(in.Options)
+	if length == 0 {
+		out.Options = nil
+	} else {
+		out.Options = make([]uint32, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of uint32
+		out.Options[_0] = in.Options[_0]
+	}
+	out.Yes = in.Yes
+	out.Weight = in.Weight
+	return
+} //End of DeepCopyMsgVote
+
+func EqualMsgVote(a, b MsgVote) bool {
+	if !bytes.Equal(a.Voter, b.Voter) {
+		return false
+	}
+	if a.Proposal != b.Proposal {
+		return false
+	}
+	if len(a.Options) != len(b.Options) {
+		return false
+	}
+	for _0 := range a.Options { //slice of uint32
+		if a.Options[_0] != b.Options[_0] {
+			return false
+		}
+	}
+	if a.Yes != b.Yes {
+		return false
+	}
+	if a.Weight != b.Weight {
+		return false
+	}
+	return true
+} //End of EqualMsgVote
+
+func EncodeJSONMsgVote(v MsgVote) ([]byte, error) {
+	w := &codonJSONWriter{}
+	encodeJSONMsgVote(w, &v)
+	return w.result()
+}
+func encodeJSONMsgVote(w *codonJSONWriter, v *MsgVote) {
+	if m, ok := interface{}(v).(json.Marshaler); ok {
+		w.marshaler(m)
+		return
+	}
+	w.raw("{")
+	w.key("\"Voter\":")
+	w.bytes(v.Voter)
+	w.key("\"Proposal\":")
+	w.quotedUint(uint64(v.Proposal))
+	w.key("\"Options\":")
+	if v.Options == nil {
+		w.raw("null")
+	} else {
+		w.raw("[")
+		for _0 := range v.Options {
+			w.comma('[')
+			w.uint(uint64(v.Options[_0]))
+		}
+		w.raw("]")
+	}
+	w.key("\"Yes\":")
+	w.bool(bool(v.Yes))
+	w.key("\"Weight\":")
+	w.int(int64(v.Weight))
+	w.raw("}")
+	// end of v
+} //End of EncodeJSONMsgVote
+
+func DecodeJSONMsgVote(bz []byte) (v MsgVote, err error) {
+	d := &codonJSONReader{bz: bz}
+	decodeJSONMsgVote(d, &v)
+	return v, d.finish()
+}
+func decodeJSONMsgVote(d *codonJSONReader, v *MsgVote) {
+	if d.null() {
+		*v = MsgVote{}
+		return
+	}
+	if u, ok := interface{}(v).(json.Unmarshaler); ok {
+		d.unmarshaler(u)
+		return
+	}
+	d.beginObject()
+	for d.nextMember() {
+		switch string(d.key) {
+		case "Voter":
+			if d.null() {
+				v.Voter = nil
+			} else {
+				v.Voter = d.bytes()
+			}
+		case "Proposal":
+			if d.null() {
+				v.Proposal = 0
+			} else {
+				v.Proposal = d.quotedUint()
+			}
+		case "Options":
+			if d.null() {
+				v.Options = nil
+			} else {
+				v.Options = nil
+				d.beginArray()
+				for d.nextElem() {
+					var tmp_0 uint32
+					if d.null() {
+						tmp_0 = 0
+					} else {
+						tmp_0 = uint32(d.uint(32))
+					}
+					v.Options = append(v.Options, tmp_0)
+				}
+			}
+		case "Yes":
+			if d.null() {
+				v.Yes = false
+			} else {
+				v.Yes = d.bool()
+			}
+		case "Weight":
+			if d.null() {
+				v.Weight = 0
+			} else {
+				v.Weight = int32(d.int(32))
+			}
+		default:
+			d.skip()
+		} // end switch
+	} // end for
+	// end of v
+} //End of DecodeJSONMsgVote
+
+func (dec *Decoder) DecodeEachMsgVote(fn func(field string, elem interface{}) error) (v MsgVote, err error) {
+	if err = dec.beginStruct(463441835, true, "MsgVote"); err != nil {
+		return
+	}
+	d := &codonDecoder{opts: dec.DecodeOptions}
+	if err = d.enter(); err != nil {
+		return
+	}
+	var n, total int
+	counts := make(map[string]int)
+	for {
+		tag, bz, e := dec.nextField()
+		if e == io.EOF {
+			break
+		}
+		if e != nil {
+			err = e
+			return
+		}
+		switch tag >> 3 {
+		case 1: // v.Voter
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			v.Voter = tmpBz
+		case 2: // v.Proposal
+			v.Proposal = uint64(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 3: // v.Options
+			var elems []uint32
+			if tag&7 == 2 { // packed elems
+				l := codonDecodeUint64(bz, &n, &err)
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				if l > uint64(len(bz)) {
+					err = errors.New("Length Too Large")
+					return
+				}
+				func(bz []byte) {
+					for len(bz) != 0 {
+						if err = d.checkSliceLength(len(elems) + 1); err != nil {
+							return
+						}
+						var tmp uint32
+						tmp = uint32(codonDecodeUint32(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						elems = append(elems, tmp)
+					}
+				}(bz[:l]) // end func
+				if err != nil {
+					return
+				}
+				bz = bz[l:]
+			} else {
+				if err = d.checkSliceLength(len(elems) + 1); err != nil {
+					return
+				}
+				var tmp uint32
+				tmp = uint32(codonDecodeUint32(bz, &n, &err))
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				elems = append(elems, tmp)
+			} // end of packed elems
+			for _, elem := range elems {
+				counts["Options"]++
+				if err = d.checkSliceLength(counts["Options"]); err != nil {
+					return
+				}
+				if err = fn("Options", elem); err != nil {
+					return
+				}
+			}
+		case 4: // v.Yes
+			v.Yes = bool(codonDecodeBool(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 5: // v.Weight
+			v.Weight = int32(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			err = errors.New("Unknown Field")
+			return
+		}
+	} // end for
+	_ = total
+	d.leave()
+	return v, nil
+} //End of DecodeEachMsgVote
+
+// Non-Interface
+func EncodeTx(w *[]byte, v Tx) {
+	s := &codonSizes{}
+	codonGrow(w, sizeTx(v, s))
+	encodeTx(w, v, s)
+}
+func HashTx(h hash.Hash, v Tx) {
+	s := &codonSizes{out: h}
+	sizeTx(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeTx(&w, v, s)
+	h.Write(w)
+}
+func encodeTx(w *[]byte, v Tx, s *codonSizes) {
+	for _0 := 0; _0 < len(v.Msgs); _0++ {
+		codonEncodeLength(1, w, s.next())
+		encodeMsg(w, v.Msgs[_0], s) // interface_encode
+	}
+	s.flush(w)
+	if v.Fee != nil {
+		codonEncodeLength(2, w, s.next())
+		for _0 := 0; _0 < len(v.Fee.Amount); _0++ {
+			codonEncodeLength(1, w, s.next())
+			codonEncodeString(1, w, v.Fee.Amount[_0].Denom)
+			s.flush(w)
+			codonEncodeUvarint(2, w, uint64(v.Fee.Amount[_0].Amount))
+			// end of v.Fee.Amount[_0]
+		}
+		s.flush(w)
+		codonEncodeUvarint(2, w, uint64(v.Fee.Gas))
+		// end of v.Fee
+	} // end of nilable v.Fee
+	s.flush(w)
+	codonEncodeString(3, w, v.Memo)
+	s.flush(w)
+	for _0 := 0; _0 < len(v.Sigs); _0++ {
+		codonEncodeByteSlice(4, w, v.Sigs[_0][:])
+	}
+	s.flush(w)
+} //End of EncodeTx
+
+func SizeTx(v Tx) int {
+	return sizeTx(v, nil)
+}
+func sizeTx(v Tx, s *codonSizes) (total int) {
+	for _0 := 0; _0 < len(v.Msgs); _0++ {
+		{
+			idx := s.reserve()
+			total += 1 + codonByteSliceSize(s.set(idx, sizeMsg(v.Msgs[_0], s)))
+		}
+	}
+	if v.Fee != nil {
+		{
+			idx := s.reserve()
+			total += 1 + codonByteSliceSize(s.set(idx, sizeFee(*(v.Fee), s)))
+		}
+	} // end of nilable v.Fee
+	total += 1 + codonByteSliceSize(len(v.Memo))
+	for _0 := 0; _0 < len(v.Sigs); _0++ {
+		total += 1 + codonByteSliceSize(len(v.Sigs[_0]))
+	}
+	return
+} //End of SizeTx
+
+func DecodeTx(bz []byte) (Tx, int, error) {
+	return decodeTx(bz, nil)
+}
+func DecodeTxNoCopy(bz []byte) (Tx, int, error) {
+	return decodeTx(bz, &codonDecoder{noCopy: true})
+}
+func DecodeTxWithOptions(bz []byte, opts DecodeOptions) (v Tx, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeTx(bz, d)
+}
+func decodeTx(bz []byte, d *codonDecoder) (v Tx, total int, err error) {
+	var n int
+	if err = d.enter(); err != nil {
+		return
+	}
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Msgs
+			if err = d.checkSliceLength(len(v.Msgs) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var tmp Msg
+			tmp, n, err = decodeMsg(bz[:l], d)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if int(l) != n {
+				err = errors.New("Length Mismatch")
+				return
+			}
+			v.Msgs = append(v.Msgs, tmp)
+		case 2: // v.Fee
+			v.Fee = &Fee{}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			if err = d.enter(); err != nil {
+				return
+			}
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // v.Fee.Amount
+						if err = d.checkSliceLength(len(v.Fee.Amount) + 1); err != nil {
+							return
+						}
+						l := codonDecodeUint64(bz, &n, &err)
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						if l > uint64(len(bz)) {
+							err = errors.New("Length Too Large")
+							return
+						}
+						var tmp Coin
+						tmp, n, err = decodeCoin(bz[:l], d)
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						if int(l) != n {
+							err = errors.New("Length Mismatch")
+							return
+						}
+						v.Fee.Amount = append(v.Fee.Amount, tmp)
+					case 2: // v.Fee.Gas
+						v.Fee.Gas = uint64(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					default:
+						err = errors.New("Unknown Field")
+						return
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			d.leave()
+			bz = bz[l:]
+			n += int(l)
+		case 3: // v.Memo
+			v.Memo = string(d.decodeString(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 4: // v.Sigs
+			if err = d.checkSliceLength(len(v.Sigs) + 1); err != nil {
+				return
+			}
+			var tmp []byte
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			tmp = tmpBz
+			v.Sigs = append(v.Sigs, tmp)
+		default:
+			err = errors.New("Unknown Field")
+			return
+		}
+	} // end for
+	d.leave()
+	return v, total, nil
+} //End of DecodeTx
+
+func PeekTx_Msgs(bz []byte) (res []Msg, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Msgs
+			if err = d.checkSliceLength(len(res) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var tmp Msg
+			tmp, n, err = decodeMsg(bz[:l], d)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if int(l) != n {
+				err = errors.New("Length Mismatch")
+				return
+			}
+			res = append(res, tmp)
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekTx_Msgs
+
+func PeekTx_Fee(bz []byte) (res *Fee, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 2: // v.Fee
+			res = &Fee{}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			if err = d.enter(); err != nil {
+				return
+			}
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // res.Amount
+						if err = d.checkSliceLength(len(res.Amount) + 1); err != nil {
+							return
+						}
+						l := codonDecodeUint64(bz, &n, &err)
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						if l > uint64(len(bz)) {
+							err = errors.New("Length Too Large")
+							return
+						}
+						var tmp Coin
+						tmp, n, err = decodeCoin(bz[:l], d)
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						if int(l) != n {
+							err = errors.New("Length Mismatch")
+							return
+						}
+						res.Amount = append(res.Amount, tmp)
+					case 2: // res.Gas
+						res.Gas = uint64(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					default:
+						err = errors.New("Unknown Field")
+						return
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			d.leave()
+			bz = bz[l:]
+			n += int(l)
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekTx_Fee
+
+func PeekTx_Fee_Amount(bz []byte) (res []Coin, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 2: // v.Fee
+			var zero []Coin
+			res = zero
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // v.Fee.Amount
+						if err = d.checkSliceLength(len(res) + 1); err != nil {
+							return
+						}
+						l := codonDecodeUint64(bz, &n, &err)
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						if l > uint64(len(bz)) {
+							err = errors.New("Length Too Large")
+							return
+						}
+						var tmp Coin
+						tmp, n, err = decodeCoin(bz[:l], d)
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						if int(l) != n {
+							err = errors.New("Length Mismatch")
+							return
+						}
+						res = append(res, tmp)
+					default:
+						n, err = codonSkipField(bz, int(tag&7))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					} // end switch
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			bz = bz[l:]
+			total += int(l)
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekTx_Fee_Amount
+
+func PeekTx_Fee_Gas(bz []byte) (res uint64, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 2: // v.Fee
+			var zero uint64
+			res = zero
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 2: // v.Fee.Gas
+						res = uint64(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					default:
+						n, err = codonSkipField(bz, int(tag&7))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					} // end switch
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			bz = bz[l:]
+			total += int(l)
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekTx_Fee_Gas
+
+func PeekTx_Memo(bz []byte) (res string, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 3: // v.Memo
+			res = string(d.decodeString(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekTx_Memo
+
+func PeekTx_Sigs(bz []byte) (res [][]uint8, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 4: // v.Sigs
+			if err = d.checkSliceLength(len(res) + 1); err != nil {
+				return
+			}
+			var tmp []byte
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			tmp = tmpBz
+			res = append(res, tmp)
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekTx_Sigs
+
+func RandTx(r RandSrc) Tx {
+	var length int
+	var v Tx
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	if length == 0 {
+		v.Msgs = nil
+	} else {
+		v.Msgs = make([]Msg, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of interface
+		v.Msgs[_0] = RandMsg(r)
+	}
+	if r.GetUint()%4 != 0 {
+		v.Fee = &Fee{}
+		length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+		if length == 0 {
+			v.Fee.Amount = nil
+		} else {
+			v.Fee.Amount = make([]Coin, length)
+		}
+		for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+			v.Fee.Amount[_0] = RandCoin(r)
+		}
+		v.Fee.Gas = r.GetUint64()
+		// end of v.Fee
+	} // end of nilable v.Fee
+	v.Memo = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	if length == 0 {
+		v.Sigs = nil
+	} else {
+		v.Sigs = make([][]byte, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of slice
+		length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+		v.Sigs[_0] = r.GetBytes(length)
+	}
+	return v
+} //End of RandTx
+
+func DeepCopyTx(in Tx) (out Tx) {
+	var length int
+	length = len(in.Msgs)
+	if length == 0 {
+		out.Msgs = nil
+	} else {
+		out.Msgs = make([]Msg, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of interface
+		out.Msgs[_0] = DeepCopyMsg(in.Msgs[_0])
+	}
+	if in.Fee != nil {
+		out.Fee = &Fee{}
+		length = len(in.Fee.Amount)
+		if length == 0 {
+			out.Fee.Amount = nil
+		} else {
+			out.Fee.Amount = make([]Coin, length)
+		}
+		for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+			out.Fee.Amount[_0] = DeepCopyCoin(in.Fee.Amount[_0])
+		}
+		out.Fee.Gas = in.Fee.Gas
+		// end of .Fee
+	} // end of nilable .Fee
+	out.Memo = in.Memo
+	length = len(in.Sigs)
+	if length == 0 {
+		out.Sigs = nil
+	} else {
+		out.Sigs = make([][]byte, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of slice
+		length = len(in.Sigs[_0])
+		if length == 0 {
+			out.Sigs[_0] = nil
+		} else {
+			out.Sigs[_0] = make([]uint8, length)
+		}
+		copy(out.Sigs[_0][:], in.Sigs[_0][:])
+	}
+	return
+} //End of DeepCopyTx
+
+func EqualTx(a, b Tx) bool {
+	if len(a.Msgs) != len(b.Msgs) {
+		return false
+	}
+	for _0 := range a.Msgs { //slice of interface
+		if !EqualMsg(a.Msgs[_0], b.Msgs[_0]) {
+			return false
+		}
+	}
+	if (a.Fee == nil) != (b.Fee == nil) {
+		return false
+	}
+	if a.Fee != nil {
+		if len(a.Fee.Amount) != len(b.Fee.Amount) {
+			return false
+		}
+		for _0 := range a.Fee.Amount { //slice of struct
+			if a.Fee.Amount[_0].Denom != b.Fee.Amount[_0].Denom {
+				return false
+			}
+			if a.Fee.Amount[_0].Amount != b.Fee.Amount[_0].Amount {
+				return false
+			}
+			// end of .Fee.Amount[_0]
+		}
+		if a.Fee.Gas != b.Fee.Gas {
+			return false
+		}
+		// end of .Fee
+	} // end of nilable .Fee
+	if a.Memo != b.Memo {
+		return false
+	}
+	if len(a.Sigs) != len(b.Sigs) {
+		return false
+	}
+	for _0 := range a.Sigs { //slice of slice
+		if !bytes.Equal(a.Sigs[_0], b.Sigs[_0]) {
+			return false
+		}
+	}
+	return true
+} //End of EqualTx
+
+func EncodeJSONTx(v Tx) ([]byte, error) {
+	w := &codonJSONWriter{}
+	encodeJSONTx(w, &v)
+	return w.result()
+}
+func encodeJSONTx(w *codonJSONWriter, v *Tx) {
+	if m, ok := interface{}(v).(json.Marshaler); ok {
+		w.marshaler(m)
+		return
+	}
+	w.raw("{")
+	w.key("\"Msgs\":")
+	if v.Msgs == nil {
+		w.raw("null")
+	} else {
+		w.raw("[")
+		for _0 := range v.Msgs {
+			w.comma('[')
+			encodeJSONMsg(w, v.Msgs[_0])
+		}
+		w.raw("]")
+	}
+	w.key("\"Fee\":")
+	if v.Fee == nil {
+		w.raw("null")
+	} else {
+		encodeJSONFee(w, v.Fee)
+	}
+	w.key("\"Memo\":")
+	w.string(string(v.Memo))
+	w.key("\"Sigs\":")
+	if v.Sigs == nil {
+		w.raw("null")
+	} else {
+		w.raw("[")
+		for _0 := range v.Sigs {
+			w.comma('[')
+			w.bytes(v.Sigs[_0])
+		}
+		w.raw("]")
+	}
+	w.raw("}")
+	// end of v
+} //End of EncodeJSONTx
+
+func DecodeJSONTx(bz []byte) (v Tx, err error) {
+	d := &codonJSONReader{bz: bz}
+	decodeJSONTx(d, &v)
+	return v, d.finish()
+}
+func decodeJSONTx(d *codonJSONReader, v *Tx) {
+	if d.null() {
+		*v = Tx{}
+		return
+	}
+	if u, ok := interface{}(v).(json.Unmarshaler); ok {
+		d.unmarshaler(u)
+		return
+	}
+	d.beginObject()
+	for d.nextMember() {
+		switch string(d.key) {
+		case "Msgs":
+			if d.null() {
+				v.Msgs = nil
+			} else {
+				v.Msgs = nil
+				d.beginArray()
+				for d.nextElem() {
+					var tmp_0 Msg
+					decodeJSONMsg(d, &tmp_0)
+					v.Msgs = append(v.Msgs, tmp_0)
+				}
+			}
+		case "Fee":
+			if d.null() {
+				v.Fee = nil
+			} else {
+				v.Fee = new(Fee)
+				decodeJSONFee(d, v.Fee)
+			}
+		case "Memo":
+			if d.null() {
+				v.Memo = ""
+			} else {
+				v.Memo = d.string()
+			}
+		case "Sigs":
+			if d.null() {
+				v.Sigs = nil
+			} else {
+				v.Sigs = nil
+				d.beginArray()
+				for d.nextElem() {
+					var tmp_0 []uint8
+					if d.null() {
+						tmp_0 = nil
+					} else {
+						tmp_0 = d.bytes()
+					}
+					v.Sigs = append(v.Sigs, tmp_0)
+				}
+			}
+		default:
+			d.skip()
+		} // end switch
+	} // end for
+	// end of v
+} //End of DecodeJSONTx
+
+func (dec *Decoder) DecodeEachTx(fn func(field string, elem interface{}) error) (v Tx, err error) {
+	if err = dec.beginStruct(2347820667, true, "Tx"); err != nil {
+		return
+	}
+	d := &codonDecoder{opts: dec.DecodeOptions}
+	if err = d.enter(); err != nil {
+		return
+	}
+	var n, total int
+	counts := make(map[string]int)
+	for {
+		tag, bz, e := dec.nextField()
+		if e == io.EOF {
+			break
+		}
+		if e != nil {
+			err = e
+			return
+		}
+		switch tag >> 3 {
+		case 1: // v.Msgs
+			var elems []Msg
+			if err = d.checkSliceLength(len(elems) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var tmp Msg
+			tmp, n, err = decodeMsg(bz[:l], d)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if int(l) != n {
+				err = errors.New("Length Mismatch")
+				return
+			}
+			elems = append(elems, tmp)
+			for _, elem := range elems {
+				counts["Msgs"]++
+				if err = d.checkSliceLength(counts["Msgs"]); err != nil {
+					return
+				}
+				if err = fn("Msgs", elem); err != nil {
+					return
+				}
+			}
+		case 2: // v.Fee
+			v.Fee = &Fee{}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			if err = d.enter(); err != nil {
+				return
+			}
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // v.Fee.Amount
+						if err = d.checkSliceLength(len(v.Fee.Amount) + 1); err != nil {
+							return
+						}
+						l := codonDecodeUint64(bz, &n, &err)
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						if l > uint64(len(bz)) {
+							err = errors.New("Length Too Large")
+							return
+						}
+						var tmp Coin
+						tmp, n, err = decodeCoin(bz[:l], d)
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						if int(l) != n {
+							err = errors.New("Length Mismatch")
+							return
+						}
+						v.Fee.Amount = append(v.Fee.Amount, tmp)
+					case 2: // v.Fee.Gas
+						v.Fee.Gas = uint64(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					default:
+						err = errors.New("Unknown Field")
+						return
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			d.leave()
+			bz = bz[l:]
+			n += int(l)
+		case 3: // v.Memo
+			v.Memo = string(d.decodeString(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 4: // v.Sigs
+			var elems [][]uint8
+			if err = d.checkSliceLength(len(elems) + 1); err != nil {
+				return
+			}
+			var tmp []byte
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			tmp = tmpBz
+			elems = append(elems, tmp)
+			for _, elem := range elems {
+				counts["Sigs"]++
+				if err = d.checkSliceLength(counts["Sigs"]); err != nil {
+					return
+				}
+				if err = fn("Sigs", elem); err != nil {
+					return
+				}
+			}
+		default:
+			err = errors.New("Unknown Field")
+			return
+		}
+	} // end for
+	_ = total
+	d.leave()
+	return v, nil
+} //End of DecodeEachTx
+
+// Non-Interface
+func EncodeExtra(w *[]byte, v Extra) {
+	s := &codonSizes{}
+	codonGrow(w, sizeExtra(v, s))
+	encodeExtra(w, v, s)
+}
+func HashExtra(h hash.Hash, v Extra) {
+	s := &codonSizes{out: h}
+	sizeExtra(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeExtra(&w, v, s)
+	h.Write(w)
+}
+func encodeExtra(w *[]byte, v Extra, s *codonSizes) {
+	codonEncodeUvarint(1, w, uint64(v.Small))
+	if len(v.Smalls) != 0 {
+		codonEncodeLength(2, w, s.next())
+		for _0 := 0; _0 < len(v.Smalls); _0++ {
+			codonWriteUvarint(w, uint64(v.Smalls[_0]))
+		}
+	} // end of packed v.Smalls
+	s.flush(w)
+	codonEncodeFloat64(3, w, v.Ratio)
+	if len(v.Ratios) != 0 {
+		codonEncodeLength(4, w, s.next())
+		for _0 := 0; _0 < len(v.Ratios); _0++ {
+			codonWriteFloat32(w, float32(v.Ratios[_0]))
+		}
+	} // end of packed v.Ratios
+	s.flush(w)
+	{ // map v.Labels
+		keys_0 := make([]string, 0, len(v.Labels))
+		for key_0 := range v.Labels {
+			keys_0 = append(keys_0, key_0)
+		}
+		codonSortKeys(keys_0, func(i, j int) bool { return keys_0[i] < keys_0[j] })
+		for _, key_0 := range keys_0 {
+			codonEncodeLength(5, w, s.next())
+			codonEncodeString(1, w, key_0)
+			codonEncodeUvarint(2, w, uint64(v.Labels[key_0]))
+		}
+	} // end of v.Labels
+	s.flush(w)
+	codonEncodeLength(6, w, s.next())
+	codonEncodeString(1, w, v.Inner.Denom)
+	s.flush(w)
+	codonEncodeUvarint(2, w, uint64(v.Inner.Amount))
+	// end of v.Inner
+	s.flush(w)
+} //End of EncodeExtra
+
+func SizeExtra(v Extra) int {
+	return sizeExtra(v, nil)
+}
+func sizeExtra(v Extra, s *codonSizes) (total int) {
+	total += 1 + codonUvarintSize(uint64(v.Small))
+	if len(v.Smalls) != 0 {
+		{
+			idx := s.reserve()
+			total += 1 + codonByteSliceSize(s.set(idx, func() (total int) {
+				for _0 := 0; _0 < len(v.Smalls); _0++ {
+					total += codonUvarintSize(uint64(v.Smalls[_0]))
+				}
+				return
+			}()))
+		}
+	}
+	total += 9
+	if len(v.Ratios) != 0 {
+		{
+			idx := s.reserve()
+			total += 1 + codonByteSliceSize(s.set(idx, len(v.Ratios)*4))
+		}
+	}
+	{ // map v.Labels
+		keys_0 := make([]string, 0, len(v.Labels))
+		for key_0 := range v.Labels {
+			keys_0 = append(keys_0, key_0)
+		}
+		codonSortKeys(keys_0, func(i, j int) bool { return keys_0[i] < keys_0[j] })
+		for _, key_0 := range keys_0 {
+			{
+				idx := s.reserve()
+				total += 1 + codonByteSliceSize(s.set(idx, func() (total int) {
+					total += 1 + codonByteSliceSize(len(key_0))
+					total += 1 + codonUvarintSize(uint64(v.Labels[key_0]))
+					return
+				}()))
+			}
+		}
+	} // end of v.Labels
+	{
+		idx := s.reserve()
+		total += 1 + codonByteSliceSize(s.set(idx, sizeCoin(v.Inner, s)))
+	}
+	return
+} //End of SizeExtra
+
+func DecodeExtra(bz []byte) (Extra, int, error) {
+	return decodeExtra(bz, nil)
+}
+func DecodeExtraNoCopy(bz []byte) (Extra, int, error) {
+	return decodeExtra(bz, &codonDecoder{noCopy: true})
+}
+func DecodeExtraWithOptions(bz []byte, opts DecodeOptions) (v Extra, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeExtra(bz, d)
+}
+func decodeExtra(bz []byte, d *codonDecoder) (v Extra, total int, err error) {
+	var n int
+	if err = d.enter(); err != nil {
+		return
+	}
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Small
+			v.Small = int8(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 2: // v.Smalls
+			if tag&7 == 2 { // packed v.Smalls
+				l := codonDecodeUint64(bz, &n, &err)
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				if l > uint64(len(bz)) {
+					err = errors.New("Length Too Large")
+					return
+				}
+				func(bz []byte) {
+					for len(bz) != 0 {
+						if err = d.checkSliceLength(len(v.Smalls) + 1); err != nil {
+							return
+						}
+						var tmp int16
+						tmp = int16(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						v.Smalls = append(v.Smalls, tmp)
+					}
+				}(bz[:l]) // end func
+				if err != nil {
+					return
+				}
+				bz = bz[l:]
+			} else {
+				if err = d.checkSliceLength(len(v.Smalls) + 1); err != nil {
+					return
+				}
+				var tmp int16
+				tmp = int16(codonDecodeUint64(bz, &n, &err))
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				v.Smalls = append(v.Smalls, tmp)
+			} // end of packed v.Smalls
+		case 3: // v.Ratio
+			v.Ratio = float64(codonDecodeFloat64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 4: // v.Ratios
+			if tag&7 == 2 { // packed v.Ratios
+				l := codonDecodeUint64(bz, &n, &err)
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				if l > uint64(len(bz)) {
+					err = errors.New("Length Too Large")
+					return
+				}
+				func(bz []byte) {
+					for len(bz) != 0 {
+						if err = d.checkSliceLength(len(v.Ratios) + 1); err != nil {
+							return
+						}
+						var tmp float32
+						tmp = float32(codonDecodeFloat32(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						v.Ratios = append(v.Ratios, tmp)
+					}
+				}(bz[:l]) // end func
+				if err != nil {
+					return
+				}
+				bz = bz[l:]
+			} else {
+				if err = d.checkSliceLength(len(v.Ratios) + 1); err != nil {
+					return
+				}
+				var tmp float32
+				tmp = float32(codonDecodeFloat32(bz, &n, &err))
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				v.Ratios = append(v.Ratios, tmp)
+			} // end of packed v.Ratios
+		case 5: // v.Labels
+			if err = d.checkSliceLength(len(v.Labels) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var key_0 string
+			var value_0 int64
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // key_0
+						key_0 = string(d.decodeString(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					case 2: // value_0
+						value_0 = int64(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					default:
+						err = errors.New("Unknown Field")
+						return
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			bz = bz[l:]
+			n += int(l)
+			if v.Labels == nil {
+				v.Labels = make(map[string]int64)
+			}
+			v.Labels[key_0] = value_0
+		case 6: // v.Inner
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			if err = d.enter(); err != nil {
+				return
+			}
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // v.Inner.Denom
+						v.Inner.Denom = string(d.decodeString(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					case 2: // v.Inner.Amount
+						v.Inner.Amount = int64(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					default:
+						err = errors.New("Unknown Field")
+						return
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			d.leave()
+			bz = bz[l:]
+			n += int(l)
+		default:
+			err = errors.New("Unknown Field")
+			return
+		}
+	} // end for
+	d.leave()
+	return v, total, nil
+} //End of DecodeExtra
+
+func PeekExtra_Small(bz []byte) (res int8, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Small
+			res = int8(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekExtra_Small
+
+func PeekExtra_Smalls(bz []byte) (res []int16, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 2: // v.Smalls
+			if tag&7 == 2 { // packed res
+				l := codonDecodeUint64(bz, &n, &err)
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				if l > uint64(len(bz)) {
+					err = errors.New("Length Too Large")
+					return
+				}
+				func(bz []byte) {
+					for len(bz) != 0 {
+						if err = d.checkSliceLength(len(res) + 1); err != nil {
+							return
+						}
+						var tmp int16
+						tmp = int16(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						res = append(res, tmp)
+					}
+				}(bz[:l]) // end func
+				if err != nil {
+					return
+				}
+				bz = bz[l:]
+			} else {
+				if err = d.checkSliceLength(len(res) + 1); err != nil {
+					return
+				}
+				var tmp int16
+				tmp = int16(codonDecodeUint64(bz, &n, &err))
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				res = append(res, tmp)
+			} // end of packed res
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekExtra_Smalls
+
+func PeekExtra_Ratio(bz []byte) (res float64, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 3: // v.Ratio
+			res = float64(codonDecodeFloat64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekExtra_Ratio
+
+func PeekExtra_Ratios(bz []byte) (res []float32, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 4: // v.Ratios
+			if tag&7 == 2 { // packed res
+				l := codonDecodeUint64(bz, &n, &err)
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				if l > uint64(len(bz)) {
+					err = errors.New("Length Too Large")
+					return
+				}
+				func(bz []byte) {
+					for len(bz) != 0 {
+						if err = d.checkSliceLength(len(res) + 1); err != nil {
+							return
+						}
+						var tmp float32
+						tmp = float32(codonDecodeFloat32(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						res = append(res, tmp)
+					}
+				}(bz[:l]) // end func
+				if err != nil {
+					return
+				}
+				bz = bz[l:]
+			} else {
+				if err = d.checkSliceLength(len(res) + 1); err != nil {
+					return
+				}
+				var tmp float32
+				tmp = float32(codonDecodeFloat32(bz, &n, &err))
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				res = append(res, tmp)
+			} // end of packed res
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekExtra_Ratios
+
+func PeekExtra_Labels(bz []byte) (res map[string]int64, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 5: // v.Labels
+			if err = d.checkSliceLength(len(res) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var key_0 string
+			var value_0 int64
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // key_0
+						key_0 = string(d.decodeString(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					case 2: // value_0
+						value_0 = int64(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					default:
+						err = errors.New("Unknown Field")
+						return
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			bz = bz[l:]
+			n += int(l)
+			if res == nil {
+				res = make(map[string]int64)
+			}
+			res[key_0] = value_0
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekExtra_Labels
+
+func PeekExtra_Inner(bz []byte) (res Coin, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 6: // v.Inner
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			if err = d.enter(); err != nil {
+				return
+			}
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // res.Denom
+						res.Denom = string(d.decodeString(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					case 2: // res.Amount
+						res.Amount = int64(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					default:
+						err = errors.New("Unknown Field")
+						return
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			d.leave()
+			bz = bz[l:]
+			n += int(l)
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekExtra_Inner
+
+func PeekExtra_Inner_Denom(bz []byte) (res string, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 6: // v.Inner
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // v.Inner.Denom
+						res = string(d.decodeString(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					default:
+						n, err = codonSkipField(bz, int(tag&7))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					} // end switch
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			bz = bz[l:]
+			total += int(l)
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekExtra_Inner_Denom
+
+func PeekExtra_Inner_Amount(bz []byte) (res int64, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 6: // v.Inner
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 2: // v.Inner.Amount
+						res = int64(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					default:
+						n, err = codonSkipField(bz, int(tag&7))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					} // end switch
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			bz = bz[l:]
+			total += int(l)
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekExtra_Inner_Amount
+
+func RandExtra(r RandSrc) Extra {
+	var length int
+	var v Extra
+	v.Small = r.GetInt8()
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	if length == 0 {
+		v.Smalls = nil
+	} else {
+		v.Smalls = make([]int16, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of int16
+		v.Smalls[_0] = r.GetInt16()
+	}
+	v.Ratio = r.GetFloat64()
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	if length == 0 {
+		v.Ratios = nil
+	} else {
+		v.Ratios = make([]float32, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of float32
+		v.Ratios[_0] = r.GetFloat32()
+	}
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.Labels = make(map[string]int64, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //map of int64
+		var key_0 string
+		var value_0 int64
+		key_0 = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+		value_0 = r.GetInt64()
+		v.Labels[key_0] = value_0
+	}
+	v.Inner.Denom = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	v.Inner.Amount = r.GetInt64()
+	// end of v.Inner
+	return v
+} //End of RandExtra
+
+func DeepCopyExtra(in Extra) (out Extra) {
+	var length int
+	out.Small = in.Small
+	length = len(in.Smalls)
+	if length == 0 {
+		out.Smalls = nil
+	} else {
+		out.Smalls = make([]int16, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of int16
+		out.Smalls[_0] = in.Smalls[_0]
+	}
+	out.Ratio = in.Ratio
+	length = len(in.Ratios)
+	if length == 0 {
+		out.Ratios = nil
+	} else {
+		out.Ratios = make([]float32, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of float32
+		out.Ratios[_0] = in.Ratios[_0]
+	}
+	length = len(in.Labels)
+	if length == 0 {
+		out.Labels = nil
+	} else {
+		out.Labels = make(map[string]int64, length)
+	}
+	for key_0, inValue_0 := range in.Labels {
+		var outValue_0 int64
+		outValue_0 = inValue_0
+		out.Labels[key_0] = outValue_0
+	}
+	out.Inner.Denom = in.Inner.Denom
+	out.Inner.Amount = in.Inner.Amount
+	// end of .Inner
+	return
+} //End of DeepCopyExtra
+
+func EqualExtra(a, b Extra) bool {
+	if a.Small != b.Small {
+		return false
+	}
+	if len(a.Smalls) != len(b.Smalls) {
+		return false
+	}
+	for _0 := range a.Smalls { //slice of int16
+		if a.Smalls[_0] != b.Smalls[_0] {
+			return false
+		}
+	}
+	if math.Float64bits(float64(a.Ratio)) != math.Float64bits(float64(b.Ratio)) {
+		return false
+	}
+	if len(a.Ratios) != len(b.Ratios) {
+		return false
+	}
+	for _0 := range a.Ratios { //slice of float32
+		if math.Float32bits(float32(a.Ratios[_0])) != math.Float32bits(float32(b.Ratios[_0])) {
+			return false
+		}
+	}
+	if len(a.Labels) != len(b.Labels) {
+		return false
+	}
+	for key_0, aValue_0 := range a.Labels {
+		bValue_0, ok := b.Labels[key_0]
+		if !ok {
+			return false
+		}
+		if aValue_0 != bValue_0 {
+			return false
+		}
+	}
+	if a.Inner.Denom != b.Inner.Denom {
+		return false
+	}
+	if a.Inner.Amount != b.Inner.Amount {
+		return false
+	}
+	// end of .Inner
+	return true
+} //End of EqualExtra
+
+func EncodeJSONExtra(v Extra) ([]byte, error) {
+	w := &codonJSONWriter{}
+	encodeJSONExtra(w, &v)
+	return w.result()
+}
+func encodeJSONExtra(w *codonJSONWriter, v *Extra) {
+	if m, ok := interface{}(v).(json.Marshaler); ok {
+		w.marshaler(m)
+		return
+	}
+	w.raw("{")
+	w.key("\"Small\":")
+	w.int(int64(v.Small))
+	w.key("\"Smalls\":")
+	if v.Smalls == nil {
+		w.raw("null")
+	} else {
+		w.raw("[")
+		for _0 := range v.Smalls {
+			w.comma('[')
+			w.int(int64(v.Smalls[_0]))
+		}
+		w.raw("]")
+	}
+	w.key("\"Ratio\":")
+	w.marshal(float64(v.Ratio))
+	w.key("\"Ratios\":")
+	if v.Ratios == nil {
+		w.raw("null")
+	} else {
+		w.raw("[")
+		for _0 := range v.Ratios {
+			w.comma('[')
+			w.marshal(float32(v.Ratios[_0]))
+		}
+		w.raw("]")
+	}
+	w.key("\"Labels\":")
+	{ // map v.Labels
+		w.raw("{")
+		keys_0 := make([]string, 0, len(v.Labels))
+		for key_0 := range v.Labels {
+			keys_0 = append(keys_0, key_0)
+		}
+		codonSortKeys(keys_0, func(i, j int) bool { return keys_0[i] < keys_0[j] })
+		for _, key_0 := range keys_0 {
+			w.mapKey(string(key_0))
+			value_0 := v.Labels[key_0]
+			w.quotedInt(int64(value_0))
+		}
+		w.raw("}")
+	} // end of v.Labels
+	w.key("\"Inner\":")
+	encodeJSONCoin(w, &v.Inner)
+	w.raw("}")
+	// end of v
+} //End of EncodeJSONExtra
+
+func DecodeJSONExtra(bz []byte) (v Extra, err error) {
+	d := &codonJSONReader{bz: bz}
+	decodeJSONExtra(d, &v)
+	return v, d.finish()
+}
+func decodeJSONExtra(d *codonJSONReader, v *Extra) {
+	if d.null() {
+		*v = Extra{}
+		return
+	}
+	if u, ok := interface{}(v).(json.Unmarshaler); ok {
+		d.unmarshaler(u)
+		return
+	}
+	d.beginObject()
+	for d.nextMember() {
+		switch string(d.key) {
+		case "Small":
+			if d.null() {
+				v.Small = 0
+			} else {
+				v.Small = int8(d.int(8))
+			}
+		case "Smalls":
+			if d.null() {
+				v.Smalls = nil
+			} else {
+				v.Smalls = nil
+				d.beginArray()
+				for d.nextElem() {
+					var tmp_0 int16
+					if d.null() {
+						tmp_0 = 0
+					} else {
+						tmp_0 = int16(d.int(16))
+					}
+					v.Smalls = append(v.Smalls, tmp_0)
+				}
+			}
+		case "Ratio":
+			if d.null() {
+				v.Ratio = 0
+			} else {
+				v.Ratio = d.float(64)
+			}
+		case "Ratios":
+			if d.null() {
+				v.Ratios = nil
+			} else {
+				v.Ratios = nil
+				d.beginArray()
+				for d.nextElem() {
+					var tmp_0 float32
+					if d.null() {
+						tmp_0 = 0
+					} else {
+						tmp_0 = float32(d.float(32))
+					}
+					v.Ratios = append(v.Ratios, tmp_0)
+				}
+			}
+		case "Labels":
+			if d.null() {
+				v.Labels = nil
+			} else {
+				v.Labels = make(map[string]int64)
+				d.beginObject()
+				for d.nextMember() {
+					key_0 := string(d.key)
+					var value_0 int64
+					if d.null() {
+						value_0 = 0
+					} else {
+						value_0 = d.quotedInt()
+					}
+					v.Labels[key_0] = value_0
+				} // end of v.Labels
+			}
+		case "Inner":
+			decodeJSONCoin(d, &v.Inner)
+		default:
+			d.skip()
+		} // end switch
+	} // end for
+	// end of v
+} //End of DecodeJSONExtra
+
+func (dec *Decoder) DecodeEachExtra(fn func(field string, elem interface{}) error) (v Extra, err error) {
+	if err = dec.beginStruct(245521374, true, "Extra"); err != nil {
+		return
+	}
+	d := &codonDecoder{opts: dec.DecodeOptions}
+	if err = d.enter(); err != nil {
+		return
+	}
+	var n, total int
+	counts := make(map[string]int)
+	for {
+		tag, bz, e := dec.nextField()
+		if e == io.EOF {
+			break
+		}
+		if e != nil {
+			err = e
+			return
+		}
+		switch tag >> 3 {
+		case 1: // v.Small
+			v.Small = int8(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 2: // v.Smalls
+			var elems []int16
+			if tag&7 == 2 { // packed elems
+				l := codonDecodeUint64(bz, &n, &err)
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				if l > uint64(len(bz)) {
+					err = errors.New("Length Too Large")
+					return
+				}
+				func(bz []byte) {
+					for len(bz) != 0 {
+						if err = d.checkSliceLength(len(elems) + 1); err != nil {
+							return
+						}
+						var tmp int16
+						tmp = int16(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						elems = append(elems, tmp)
+					}
+				}(bz[:l]) // end func
+				if err != nil {
+					return
+				}
+				bz = bz[l:]
+			} else {
+				if err = d.checkSliceLength(len(elems) + 1); err != nil {
+					return
+				}
+				var tmp int16
+				tmp = int16(codonDecodeUint64(bz, &n, &err))
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				elems = append(elems, tmp)
+			} // end of packed elems
+			for _, elem := range elems {
+				counts["Smalls"]++
+				if err = d.checkSliceLength(counts["Smalls"]); err != nil {
+					return
+				}
+				if err = fn("Smalls", elem); err != nil {
+					return
+				}
+			}
+		case 3: // v.Ratio
+			v.Ratio = float64(codonDecodeFloat64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 4: // v.Ratios
+			var elems []float32
+			if tag&7 == 2 { // packed elems
+				l := codonDecodeUint64(bz, &n, &err)
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				if l > uint64(len(bz)) {
+					err = errors.New("Length Too Large")
+					return
+				}
+				func(bz []byte) {
+					for len(bz) != 0 {
+						if err = d.checkSliceLength(len(elems) + 1); err != nil {
+							return
+						}
+						var tmp float32
+						tmp = float32(codonDecodeFloat32(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						elems = append(elems, tmp)
+					}
+				}(bz[:l]) // end func
+				if err != nil {
+					return
+				}
+				bz = bz[l:]
+			} else {
+				if err = d.checkSliceLength(len(elems) + 1); err != nil {
+					return
+				}
+				var tmp float32
+				tmp = float32(codonDecodeFloat32(bz, &n, &err))
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				elems = append(elems, tmp)
+			} // end of packed elems
+			for _, elem := range elems {
+				counts["Ratios"]++
+				if err = d.checkSliceLength(counts["Ratios"]); err != nil {
+					return
+				}
+				if err = fn("Ratios", elem); err != nil {
+					return
+				}
+			}
+		case 5: // v.Labels
+			if err = d.checkSliceLength(len(v.Labels) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var key_0 string
+			var value_0 int64
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // key_0
+						key_0 = string(d.decodeString(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					case 2: // value_0
+						value_0 = int64(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					default:
+						err = errors.New("Unknown Field")
+						return
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			bz = bz[l:]
+			n += int(l)
+			if v.Labels == nil {
+				v.Labels = make(map[string]int64)
+			}
+			v.Labels[key_0] = value_0
+		case 6: // v.Inner
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			if err = d.enter(); err != nil {
+				return
+			}
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // v.Inner.Denom
+						v.Inner.Denom = string(d.decodeString(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					case 2: // v.Inner.Amount
+						v.Inner.Amount = int64(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					default:
+						err = errors.New("Unknown Field")
+						return
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			d.leave()
+			bz = bz[l:]
+			n += int(l)
+		default:
+			err = errors.New("Unknown Field")
+			return
+		}
+	} // end for
+	_ = total
+	d.leave()
+	return v, nil
+} //End of DecodeEachExtra
+
+// Interface
+func DecodeMsg(bz []byte) (Msg, int, error) {
+	return decodeMsg(bz, nil)
+}
+func DecodeMsgNoCopy(bz []byte) (Msg, int, error) {
+	return decodeMsg(bz, &codonDecoder{noCopy: true})
+}
+func DecodeMsgWithOptions(bz []byte, opts DecodeOptions) (v Msg, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeMsg(bz, d)
+}
+func decodeMsg(bz []byte, d *codonDecoder) (v Msg, total int, err error) {
+
+	var n int
+	if len(bz) < 4 {
+		err = errors.New("Prefix Bytes Too Short")
+		return
+	}
+	magicNum := binary.BigEndian.Uint32(bz[:4])
+	bz = bz[4:]
+	total += 4
+	switch magicNum {
+	case 2361653406:
+		var tmp MsgSend
+		tmp, n, err = decodeMsgSend(bz, d)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		v = tmp
+		return
+	case 463441835:
+		var tmp MsgVote
+		tmp, n, err = decodeMsgVote(bz, d)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		v = tmp
+		return
+	default:
+		err = &ErrUnknownMagicNum{MagicNum: magicNum, Alias: "Msg"}
+	} // end of switch
+	return
+} // end of decodeMsg
+func EncodeMsg(w *[]byte, x interface{}) {
+	s := &codonSizes{}
+	codonGrow(w, sizeMsg(x, s))
+	encodeMsg(w, x, s)
+}
+func HashMsg(h hash.Hash, v interface{}) {
+	s := &codonSizes{out: h}
+	sizeMsg(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeMsg(&w, v, s)
+	h.Write(w)
+}
+func encodeMsg(w *[]byte, x interface{}, s *codonSizes) {
+	switch v := x.(type) {
+	case MsgSend:
+		*w = append(*w, 0x8c, 0xc3, 0xf8, 0x9e) // prefix bytes
+		encodeMsgSend(w, v, s)
+	case *MsgSend:
+		*w = append(*w, 0x8c, 0xc3, 0xf8, 0x9e) // prefix bytes
+		encodeMsgSend(w, *v, s)
+	case MsgVote:
+		*w = append(*w, 0x1b, 0x9f, 0x8f, 0xab) // prefix bytes
+		encodeMsgVote(w, v, s)
+	case *MsgVote:
+		*w = append(*w, 0x1b, 0x9f, 0x8f, 0xab) // prefix bytes
+		encodeMsgVote(w, *v, s)
+	default:
+		panic(fmt.Sprintf("Unknown Type %v %v\n", x, reflect.TypeOf(x)))
+	} // end of switch
+} // end of func
+func SizeMsg(x interface{}) int {
+	return sizeMsg(x, nil)
+}
+func sizeMsg(x interface{}, s *codonSizes) int {
+	switch v := x.(type) {
+	case MsgSend:
+		return 4 + sizeMsgSend(v, s)
+	case *MsgSend:
+		return 4 + sizeMsgSend(*v, s)
+	case MsgVote:
+		return 4 + sizeMsgVote(v, s)
+	case *MsgVote:
+		return 4 + sizeMsgVote(*v, s)
+	default:
+		panic(fmt.Sprintf("Unknown Type %v %v\n", x, reflect.TypeOf(x)))
+	} // end of switch
+} // end of func
+func RandMsg(r RandSrc) Msg {
+	switch r.GetUint() % 2 {
+	case 0:
+		return RandMsgSend(r)
+	case 1:
+		return RandMsgVote(r)
+	default:
+		panic("Unknown Type.")
+	} // end of switch
+} // end of func
+func DeepCopyMsg(x Msg) Msg {
+	switch v := x.(type) {
+	case MsgSend:
+		res := DeepCopyMsgSend(v)
+		return res
+	case *MsgSend:
+		res := DeepCopyMsgSend(*v)
+		return &res
+	case MsgVote:
+		res := DeepCopyMsgVote(v)
+		return res
+	case *MsgVote:
+		res := DeepCopyMsgVote(*v)
+		return &res
+	default:
+		panic(fmt.Sprintf("Unknown Type %v %v\n", x, reflect.TypeOf(x)))
+	} // end of switch
+} // end of func
+func EqualMsg(a, b Msg) bool {
+	switch x := a.(type) {
+	case nil:
+		return b == nil
+	case MsgSend:
+		switch y := b.(type) {
+		case MsgSend:
+			return EqualMsgSend(x, y)
+		case *MsgSend:
+			return y != nil && EqualMsgSend(x, *y)
+		}
+	case *MsgSend:
+		switch y := b.(type) {
+		case MsgSend:
+			return x != nil && EqualMsgSend(*x, y)
+		case *MsgSend:
+			return x == y || (x != nil && y != nil && EqualMsgSend(*x, *y))
+		}
+	case MsgVote:
+		switch y := b.(type) {
+		case MsgVote:
+			return EqualMsgVote(x, y)
+		case *MsgVote:
+			return y != nil && EqualMsgVote(x, *y)
+		}
+	case *MsgVote:
+		switch y := b.(type) {
+		case MsgVote:
+			return x != nil && EqualMsgVote(*x, y)
+		case *MsgVote:
+			return x == y || (x != nil && y != nil && EqualMsgVote(*x, *y))
+		}
+	default:
+		_ = x
+	} // end of switch
+	return false
+} // end of func
+func DecodeJSONMsg(bz []byte) (v Msg, err error) {
+	d := &codonJSONReader{bz: bz}
+	decodeJSONMsg(d, &v)
+	return v, d.finish()
+}
+func decodeJSONMsg(d *codonJSONReader, v *Msg) {
+	if d.null() {
+		*v = nil
+		return
+	}
+	name, value := d.typeValue()
+	switch name {
+	case "fixture/MsgSend":
+		var tmp MsgSend
+		decodeJSONMsgSend(value, &tmp)
+		*v = tmp
+	case "fixture/MsgVote":
+		var tmp MsgVote
+		decodeJSONMsgVote(value, &tmp)
+		*v = tmp
+	default:
+		d.failf("Unknown type name %q when decoding Msg", name)
+	} // end of switch
+	d.merge(value)
+} // end of DecodeJSONMsg
+func EncodeJSONMsg(x interface{}) ([]byte, error) {
+	w := &codonJSONWriter{}
+	encodeJSONMsg(w, x)
+	return w.result()
+}
+func encodeJSONMsg(w *codonJSONWriter, x interface{}) {
+	switch v := x.(type) {
+	case MsgSend:
+		w.beginType("fixture/MsgSend")
+		encodeJSONMsgSend(w, &v)
+		w.raw("}")
+	case *MsgSend:
+		w.beginType("fixture/MsgSend")
+		encodeJSONMsgSend(w, v)
+		w.raw("}")
+	case MsgVote:
+		w.beginType("fixture/MsgVote")
+		encodeJSONMsgVote(w, &v)
+		w.raw("}")
+	case *MsgVote:
+		w.beginType("fixture/MsgVote")
+		encodeJSONMsgVote(w, v)
+		w.raw("}")
+	case nil:
+		w.raw("null")
+	default:
+		w.fail(fmt.Errorf("Unknown Type %T", x))
+	} // end of switch
+} // end of func
+func getMagicNum(name string) uint32 {
+	switch name {
+	case "Coin":
+		return 1844312231
+	case "Extra":
+		return 245521374
+	case "Fee":
+		return 3195000021
+	case "MsgSend":
+		return 2361653406
+	case "MsgVote":
+		return 463441835
+	case "Tx":
+		return 2347820667
+	} // end of switch
+	panic("Should not reach here")
+} // end of getMagicNum
+func getAliasOfMagicNum(magicNum uint32) string {
+	switch magicNum {
+	case 1844312231:
+		return "Coin"
+	case 245521374:
+		return "Extra"
+	case 3195000021:
+		return "Fee"
+	case 2361653406:
+		return "MsgSend"
+	case 463441835:
+		return "MsgVote"
+	case 2347820667:
+		return "Tx"
+	} // end of switch
+	return ""
+} // end of getAliasOfMagicNum
+func getMagicNumOfVar(x interface{}) (uint32, bool) {
+	switch x.(type) {
+	case *Coin, Coin:
+		return 1844312231, true
+	case *Extra, Extra:
+		return 245521374, true
+	case *Fee, Fee:
+		return 3195000021, true
+	case *MsgSend, MsgSend:
+		return 2361653406, true
+	case *MsgVote, MsgVote:
+		return 463441835, true
+	case *Tx, Tx:
+		return 2347820667, true
+	default:
+		return 0, false
+	} // end of switch
+} // end of func
+func EncodeAny(w *[]byte, x interface{}) {
+	s := &codonSizes{}
+	codonGrow(w, sizeAny(x, s))
+	encodeAny(w, x, s)
+}
+func HashAny(h hash.Hash, v interface{}) {
+	s := &codonSizes{out: h}
+	sizeAny(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeAny(&w, v, s)
+	h.Write(w)
+}
+func encodeAny(w *[]byte, x interface{}, s *codonSizes) {
+	switch v := x.(type) {
+	case Coin:
+		*w = append(*w, 0x6d, 0xed, 0xf8, 0xa7) // prefix bytes
+		encodeCoin(w, v, s)
+	case *Coin:
+		*w = append(*w, 0x6d, 0xed, 0xf8, 0xa7) // prefix bytes
+		encodeCoin(w, *v, s)
+	case Extra:
+		*w = append(*w, 0x0e, 0xa2, 0x5b, 0xde) // prefix bytes
+		encodeExtra(w, v, s)
+	case *Extra:
+		*w = append(*w, 0x0e, 0xa2, 0x5b, 0xde) // prefix bytes
+		encodeExtra(w, *v, s)
+	case Fee:
+		*w = append(*w, 0xbe, 0x6f, 0xd4, 0xd5) // prefix bytes
+		encodeFee(w, v, s)
+	case *Fee:
+		*w = append(*w, 0xbe, 0x6f, 0xd4, 0xd5) // prefix bytes
+		encodeFee(w, *v, s)
+	case MsgSend:
+		*w = append(*w, 0x8c, 0xc3, 0xf8, 0x9e) // prefix bytes
+		encodeMsgSend(w, v, s)
+	case *MsgSend:
+		*w = append(*w, 0x8c, 0xc3, 0xf8, 0x9e) // prefix bytes
+		encodeMsgSend(w, *v, s)
+	case MsgVote:
+		*w = append(*w, 0x1b, 0x9f, 0x8f, 0xab) // prefix bytes
+		encodeMsgVote(w, v, s)
+	case *MsgVote:
+		*w = append(*w, 0x1b, 0x9f, 0x8f, 0xab) // prefix bytes
+		encodeMsgVote(w, *v, s)
+	case Tx:
+		*w = append(*w, 0x8b, 0xf0, 0xe6, 0x7b) // prefix bytes
+		encodeTx(w, v, s)
+	case *Tx:
+		*w = append(*w, 0x8b, 0xf0, 0xe6, 0x7b) // prefix bytes
+		encodeTx(w, *v, s)
+	default:
+		panic(fmt.Sprintf("Unknown Type %v %v\n", x, reflect.TypeOf(x)))
+	} // end of switch
+} // end of func
+func SizeAny(x interface{}) int {
+	return sizeAny(x, nil)
+}
+func sizeAny(x interface{}, s *codonSizes) int {
+	switch v := x.(type) {
+	case Coin:
+		return 4 + sizeCoin(v, s)
+	case *Coin:
+		return 4 + sizeCoin(*v, s)
+	case Extra:
+		return 4 + sizeExtra(v, s)
+	case *Extra:
+		return 4 + sizeExtra(*v, s)
+	case Fee:
+		return 4 + sizeFee(v, s)
+	case *Fee:
+		return 4 + sizeFee(*v, s)
+	case MsgSend:
+		return 4 + sizeMsgSend(v, s)
+	case *MsgSend:
+		return 4 + sizeMsgSend(*v, s)
+	case MsgVote:
+		return 4 + sizeMsgVote(v, s)
+	case *MsgVote:
+		return 4 + sizeMsgVote(*v, s)
+	case Tx:
+		return 4 + sizeTx(v, s)
+	case *Tx:
+		return 4 + sizeTx(*v, s)
+	default:
+		panic(fmt.Sprintf("Unknown Type %v %v\n", x, reflect.TypeOf(x)))
+	} // end of switch
+} // end of func
+func DecodeAny(bz []byte) (interface{}, int, error) {
+	return decodeAny(bz, nil)
+}
+func DecodeAnyNoCopy(bz []byte) (interface{}, int, error) {
+	return decodeAny(bz, &codonDecoder{noCopy: true})
+}
+func DecodeAnyWithOptions(bz []byte, opts DecodeOptions) (v interface{}, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeAny(bz, d)
+}
+func decodeAny(bz []byte, d *codonDecoder) (v interface{}, total int, err error) {
+
+	var n int
+	if len(bz) < 4 {
+		err = errors.New("Prefix Bytes Too Short")
+		return
+	}
+	magicNum := binary.BigEndian.Uint32(bz[:4])
+	bz = bz[4:]
+	total += 4
+	switch magicNum {
+	case 1844312231:
+		var tmp Coin
+		tmp, n, err = decodeCoin(bz, d)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		v = tmp
+		return
+	case 245521374:
+		var tmp Extra
+		tmp, n, err = decodeExtra(bz, d)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		v = tmp
+		return
+	case 3195000021:
+		var tmp Fee
+		tmp, n, err = decodeFee(bz, d)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		v = tmp
+		return
+	case 2361653406:
+		var tmp MsgSend
+		tmp, n, err = decodeMsgSend(bz, d)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		v = tmp
+		return
+	case 463441835:
+		var tmp MsgVote
+		tmp, n, err = decodeMsgVote(bz, d)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		v = tmp
+		return
+	case 2347820667:
+		var tmp Tx
+		tmp, n, err = decodeTx(bz, d)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		v = tmp
+		return
+	default:
+		err = &ErrUnknownMagicNum{MagicNum: magicNum, Alias: "interface{}"}
+	} // end of switch
+	return
+} // end of decodeAny
+func EncodeJSONAny(x interface{}) ([]byte, error) {
+	w := &codonJSONWriter{}
+	encodeJSONAny(w, x, true)
+	return w.result()
+}
+func encodeJSONAny(w *codonJSONWriter, x interface{}, wrap bool) {
+	switch v := x.(type) {
+	case Coin:
+		if wrap {
+			w.beginType("fixture/Coin")
+		}
+		encodeJSONCoin(w, &v)
+		if wrap {
+			w.raw("}")
+		}
+	case *Coin:
+		if wrap {
+			w.beginType("fixture/Coin")
+		}
+		encodeJSONCoin(w, v)
+		if wrap {
+			w.raw("}")
+		}
+	case Extra:
+		if wrap {
+			w.beginType("fixture/Extra")
+		}
+		encodeJSONExtra(w, &v)
+		if wrap {
+			w.raw("}")
+		}
+	case *Extra:
+		if wrap {
+			w.beginType("fixture/Extra")
+		}
+		encodeJSONExtra(w, v)
+		if wrap {
+			w.raw("}")
+		}
+	case Fee:
+		if wrap {
+			w.beginType("fixture/Fee")
+		}
+		encodeJSONFee(w, &v)
+		if wrap {
+			w.raw("}")
+		}
+	case *Fee:
+		if wrap {
+			w.beginType("fixture/Fee")
+		}
+		encodeJSONFee(w, v)
+		if wrap {
+			w.raw("}")
+		}
+	case MsgSend:
+		if wrap {
+			w.beginType("fixture/MsgSend")
+		}
+		encodeJSONMsgSend(w, &v)
+		if wrap {
+			w.raw("}")
+		}
+	case *MsgSend:
+		if wrap {
+			w.beginType("fixture/MsgSend")
+		}
+		encodeJSONMsgSend(w, v)
+		if wrap {
+			w.raw("}")
+		}
+	case MsgVote:
+		if wrap {
+			w.beginType("fixture/MsgVote")
+		}
+		encodeJSONMsgVote(w, &v)
+		if wrap {
+			w.raw("}")
+		}
+	case *MsgVote:
+		if wrap {
+			w.beginType("fixture/MsgVote")
+		}
+		encodeJSONMsgVote(w, v)
+		if wrap {
+			w.raw("}")
+		}
+	case Tx:
+		if wrap {
+			w.beginType("fixture/Tx")
+		}
+		encodeJSONTx(w, &v)
+		if wrap {
+			w.raw("}")
+		}
+	case *Tx:
+		if wrap {
+			w.beginType("fixture/Tx")
+		}
+		encodeJSONTx(w, v)
+		if wrap {
+			w.raw("}")
+		}
+	case nil:
+		w.raw("null")
+	default:
+		w.fail(fmt.Errorf("Unknown Type %T", x))
+	} // end of switch
+} // end of func
+func DecodeJSONAny(bz []byte) (v interface{}, err error) {
+	d := &codonJSONReader{bz: bz}
+	decodeJSONAny(d, &v)
+	return v, d.finish()
+}
+func decodeJSONAny(d *codonJSONReader, v *interface{}) {
+	if d.null() {
+		*v = nil
+		return
+	}
+	name, value := d.typeValue()
+	switch name {
+	case "fixture/Coin":
+		var tmp Coin
+		decodeJSONCoin(value, &tmp)
+		*v = tmp
+	case "fixture/Extra":
+		var tmp Extra
+		decodeJSONExtra(value, &tmp)
+		*v = tmp
+	case "fixture/Fee":
+		var tmp Fee
+		decodeJSONFee(value, &tmp)
+		*v = tmp
+	case "fixture/MsgSend":
+		var tmp MsgSend
+		decodeJSONMsgSend(value, &tmp)
+		*v = tmp
+	case "fixture/MsgVote":
+		var tmp MsgVote
+		decodeJSONMsgVote(value, &tmp)
+		*v = tmp
+	case "fixture/Tx":
+		var tmp Tx
+		decodeJSONTx(value, &tmp)
+		*v = tmp
+	default:
+		d.failf("Unknown type name %q when decoding interface{}", name)
+	} // end of switch
+	d.merge(value)
+} // end of DecodeJSONAny
+func decodeJSONPtr(d *codonJSONReader, ptr interface{}) bool {
+	switch p := ptr.(type) {
+	case *Coin:
+		decodeJSONCoin(d, p)
+	case *Extra:
+		decodeJSONExtra(d, p)
+	case *Fee:
+		decodeJSONFee(d, p)
+	case *MsgSend:
+		decodeJSONMsgSend(d, p)
+	case *MsgVote:
+		decodeJSONMsgVote(d, p)
+	case *Tx:
+		decodeJSONTx(d, p)
+	case *Msg:
+		decodeJSONMsg(d, p)
+	default:
+		return false
+	} // end of switch
+	return true
+} // end of decodeJSONPtr
+func AssignIfcPtrFromStruct(ifcPtrIn interface{}, structObjIn interface{}) error {
+	switch ifcPtr := ifcPtrIn.(type) {
+	case *Msg:
+		switch structObj := structObjIn.(type) {
+		case MsgSend:
+			*ifcPtr = &structObj
+		case MsgVote:
+			*ifcPtr = &structObj
+		default:
+			return newErrTypeMismatch(structObjIn, ifcPtrIn)
+		} // end switch of structs
+	default:
+		return newErrTypeMismatch(structObjIn, ifcPtrIn)
+	} // end switch of interfaces
+	return nil
+}
+func RandAny(r RandSrc) interface{} {
+	switch r.GetUint() % 6 {
+	case 0:
+		return RandCoin(r)
+	case 1:
+		return RandExtra(r)
+	case 2:
+		return RandFee(r)
+	case 3:
+		return RandMsgSend(r)
+	case 4:
+		return RandMsgVote(r)
+	case 5:
+		return RandTx(r)
+	default:
+		panic("Unknown Type.")
+	} // end of switch
+} // end of func
+func DeepCopyAny(x interface{}) interface{} {
+	switch v := x.(type) {
+	case Coin:
+		res := DeepCopyCoin(v)
+		return res
+	case *Coin:
+		res := DeepCopyCoin(*v)
+		return &res
+	case Extra:
+		res := DeepCopyExtra(v)
+		return res
+	case *Extra:
+		res := DeepCopyExtra(*v)
+		return &res
+	case Fee:
+		res := DeepCopyFee(v)
+		return res
+	case *Fee:
+		res := DeepCopyFee(*v)
+		return &res
+	case MsgSend:
+		res := DeepCopyMsgSend(v)
+		return res
+	case *MsgSend:
+		res := DeepCopyMsgSend(*v)
+		return &res
+	case MsgVote:
+		res := DeepCopyMsgVote(v)
+		return res
+	case *MsgVote:
+		res := DeepCopyMsgVote(*v)
+		return &res
+	case Tx:
+		res := DeepCopyTx(v)
+		return res
+	case *Tx:
+		res := DeepCopyTx(*v)
+		return &res
+	default:
+		panic(fmt.Sprintf("Unknown Type %v %v\n", x, reflect.TypeOf(x)))
+	} // end of switch
+} // end of func
+func EqualAny(a, b interface{}) bool {
+	switch x := a.(type) {
+	case nil:
+		return b == nil
+	case Coin:
+		switch y := b.(type) {
+		case Coin:
+			return EqualCoin(x, y)
+		case *Coin:
+			return y != nil && EqualCoin(x, *y)
+		}
+	case *Coin:
+		switch y := b.(type) {
+		case Coin:
+			return x != nil && EqualCoin(*x, y)
+		case *Coin:
+			return x == y || (x != nil && y != nil && EqualCoin(*x, *y))
+		}
+	case Extra:
+		switch y := b.(type) {
+		case Extra:
+			return EqualExtra(x, y)
+		case *Extra:
+			return y != nil && EqualExtra(x, *y)
+		}
+	case *Extra:
+		switch y := b.(type) {
+		case Extra:
+			return x != nil && EqualExtra(*x, y)
+		case *Extra:
+			return x == y || (x != nil && y != nil && EqualExtra(*x, *y))
+		}
+	case Fee:
+		switch y := b.(type) {
+		case Fee:
+			return EqualFee(x, y)
+		case *Fee:
+			return y != nil && EqualFee(x, *y)
+		}
+	case *Fee:
+		switch y := b.(type) {
+		case Fee:
+			return x != nil && EqualFee(*x, y)
+		case *Fee:
+			return x == y || (x != nil && y != nil && EqualFee(*x, *y))
+		}
+	case MsgSend:
+		switch y := b.(type) {
+		case MsgSend:
+			return EqualMsgSend(x, y)
+		case *MsgSend:
+			return y != nil && EqualMsgSend(x, *y)
+		}
+	case *MsgSend:
+		switch y := b.(type) {
+		case MsgSend:
+			return x != nil && EqualMsgSend(*x, y)
+		case *MsgSend:
+			return x == y || (x != nil && y != nil && EqualMsgSend(*x, *y))
+		}
+	case MsgVote:
+		switch y := b.(type) {
+		case MsgVote:
+			return EqualMsgVote(x, y)
+		case *MsgVote:
+			return y != nil && EqualMsgVote(x, *y)
+		}
+	case *MsgVote:
+		switch y := b.(type) {
+		case MsgVote:
+			return x != nil && EqualMsgVote(*x, y)
+		case *MsgVote:
+			return x == y || (x != nil && y != nil && EqualMsgVote(*x, *y))
+		}
+	case Tx:
+		switch y := b.(type) {
+		case Tx:
+			return EqualTx(x, y)
+		case *Tx:
+			return y != nil && EqualTx(x, *y)
+		}
+	case *Tx:
+		switch y := b.(type) {
+		case Tx:
+			return x != nil && EqualTx(*x, y)
+		case *Tx:
+			return x == y || (x != nil && y != nil && EqualTx(*x, *y))
+		}
+	default:
+		_ = x
+	} // end of switch
+	return false
+} // end of func
+func GetSupportList() []string {
+	return []string{
+		"github.com/coinexchain/codon/internal/fixture.Coin",
+		"github.com/coinexchain/codon/internal/fixture.Extra",
+		"github.com/coinexchain/codon/internal/fixture.Fee",
+		"github.com/coinexchain/codon/internal/fixture.Msg",
+		"github.com/coinexchain/codon/internal/fixture.MsgSend",
+		"github.com/coinexchain/codon/internal/fixture.MsgVote",
+		"github.com/coinexchain/codon/internal/fixture.Tx",
+	}
+} // end of GetSupportList
+func RoundTripSelfTest(r RandSrc, count int) error {
+
+	for i := 0; i < count; i++ {
+		v := RandMsg(r)
+		buf := make([]byte, 0, 64)
+		EncodeMsg(&buf, v)
+		if len(buf) != SizeMsg(v) {
+			return fmt.Errorf("Msg: size is %d but encoded %d bytes", SizeMsg(v), len(buf))
+		}
+		res, n, err := DecodeMsg(buf)
+		if err != nil {
+			return fmt.Errorf("Msg: %v", err)
+		}
+		if n != len(buf) {
+			return fmt.Errorf("Msg: decoded %d bytes out of %d", n, len(buf))
+		}
+		buf2 := make([]byte, 0, 64)
+		EncodeMsg(&buf2, res)
+		if !bytes.Equal(buf, buf2) {
+			return errors.New("Msg: mismatch after round trip")
+		}
+		if !EqualMsg(v, res) {
+			return errors.New("Msg: value changed after round trip")
+		}
+	}
+
+	for i := 0; i < count; i++ {
+		v := RandCoin(r)
+		buf := make([]byte, 0, 64)
+		EncodeCoin(&buf, v)
+		if len(buf) != SizeCoin(v) {
+			return fmt.Errorf("Coin: size is %d but encoded %d bytes", SizeCoin(v), len(buf))
+		}
+		res, n, err := DecodeCoin(buf)
+		if err != nil {
+			return fmt.Errorf("Coin: %v", err)
+		}
+		if n != len(buf) {
+			return fmt.Errorf("Coin: decoded %d bytes out of %d", n, len(buf))
+		}
+		buf2 := make([]byte, 0, 64)
+		EncodeCoin(&buf2, res)
+		if !bytes.Equal(buf, buf2) {
+			return errors.New("Coin: mismatch after round trip")
+		}
+		if !EqualCoin(v, res) {
+			return errors.New("Coin: value changed after round trip")
+		}
+	}
+
+	for i := 0; i < count; i++ {
+		v := RandFee(r)
+		buf := make([]byte, 0, 64)
+		EncodeFee(&buf, v)
+		if len(buf) != SizeFee(v) {
+			return fmt.Errorf("Fee: size is %d but encoded %d bytes", SizeFee(v), len(buf))
+		}
+		res, n, err := DecodeFee(buf)
+		if err != nil {
+			return fmt.Errorf("Fee: %v", err)
+		}
+		if n != len(buf) {
+			return fmt.Errorf("Fee: decoded %d bytes out of %d", n, len(buf))
+		}
+		buf2 := make([]byte, 0, 64)
+		EncodeFee(&buf2, res)
+		if !bytes.Equal(buf, buf2) {
+			return errors.New("Fee: mismatch after round trip")
+		}
+		if !EqualFee(v, res) {
+			return errors.New("Fee: value changed after round trip")
+		}
+	}
+
+	for i := 0; i < count; i++ {
+		v := RandMsgSend(r)
+		buf := make([]byte, 0, 64)
+		EncodeMsgSend(&buf, v)
+		if len(buf) != SizeMsgSend(v) {
+			return fmt.Errorf("MsgSend: size is %d but encoded %d bytes", SizeMsgSend(v), len(buf))
+		}
+		res, n, err := DecodeMsgSend(buf)
+		if err != nil {
+			return fmt.Errorf("MsgSend: %v", err)
+		}
+		if n != len(buf) {
+			return fmt.Errorf("MsgSend: decoded %d bytes out of %d", n, len(buf))
+		}
+		buf2 := make([]byte, 0, 64)
+		EncodeMsgSend(&buf2, res)
+		if !bytes.Equal(buf, buf2) {
+			return errors.New("MsgSend: mismatch after round trip")
+		}
+		if !EqualMsgSend(v, res) {
+			return errors.New("MsgSend: value changed after round trip")
+		}
+	}
+
+	for i := 0; i < count; i++ {
+		v := RandMsgVote(r)
+		buf := make([]byte, 0, 64)
+		EncodeMsgVote(&buf, v)
+		if len(buf) != SizeMsgVote(v) {
+			return fmt.Errorf("MsgVote: size is %d but encoded %d bytes", SizeMsgVote(v), len(buf))
+		}
+		res, n, err := DecodeMsgVote(buf)
+		if err != nil {
+			return fmt.Errorf("MsgVote: %v", err)
+		}
+		if n != len(buf) {
+			return fmt.Errorf("MsgVote: decoded %d bytes out of %d", n, len(buf))
+		}
+		buf2 := make([]byte, 0, 64)
+		EncodeMsgVote(&buf2, res)
+		if !bytes.Equal(buf, buf2) {
+			return errors.New("MsgVote: mismatch after round trip")
+		}
+		if !EqualMsgVote(v, res) {
+			return errors.New("MsgVote: value changed after round trip")
+		}
+	}
+
+	for i := 0; i < count; i++ {
+		v := RandTx(r)
+		buf := make([]byte, 0, 64)
+		EncodeTx(&buf, v)
+		if len(buf) != SizeTx(v) {
+			return fmt.Errorf("Tx: size is %d but encoded %d bytes", SizeTx(v), len(buf))
+		}
+		res, n, err := DecodeTx(buf)
+		if err != nil {
+			return fmt.Errorf("Tx: %v", err)
+		}
+		if n != len(buf) {
+			return fmt.Errorf("Tx: decoded %d bytes out of %d", n, len(buf))
+		}
+		buf2 := make([]byte, 0, 64)
+		EncodeTx(&buf2, res)
+		if !bytes.Equal(buf, buf2) {
+			return errors.New("Tx: mismatch after round trip")
+		}
+		if !EqualTx(v, res) {
+			return errors.New("Tx: value changed after round trip")
+		}
+	}
+
+	for i := 0; i < count; i++ {
+		v := RandExtra(r)
+		buf := make([]byte, 0, 64)
+		EncodeExtra(&buf, v)
+		if len(buf) != SizeExtra(v) {
+			return fmt.Errorf("Extra: size is %d but encoded %d bytes", SizeExtra(v), len(buf))
+		}
+		res, n, err := DecodeExtra(buf)
+		if err != nil {
+			return fmt.Errorf("Extra: %v", err)
+		}
+		if n != len(buf) {
+			return fmt.Errorf("Extra: decoded %d bytes out of %d", n, len(buf))
+		}
+		buf2 := make([]byte, 0, 64)
+		EncodeExtra(&buf2, res)
+		if !bytes.Equal(buf, buf2) {
+			return errors.New("Extra: mismatch after round trip")
+		}
+		if !EqualExtra(v, res) {
+			return errors.New("Extra: value changed after round trip")
+		}
+	}
+	return nil
+} // end of RoundTripSelfTest
@@ -0,0 +1,279 @@
+// Code generated by codon. DO NOT EDIT.
+
+//nolint:all
+package codec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// codonFuzzSrc is a RandSrc backed by the fuzzer's input bytes. After the input is used up, it returns zeros.
+type codonFuzzSrc struct {
+	bz []byte
+}
+
+func (s *codonFuzzSrc) next(n int) []byte {
+	res := make([]byte, n)
+	m := copy(res, s.bz)
+	s.bz = s.bz[m:]
+	return res
+}
+
+func (s *codonFuzzSrc) GetBool() bool       { return s.next(1)[0]&1 != 0 }
+func (s *codonFuzzSrc) GetInt() int         { return int(s.GetUint64()) }
+func (s *codonFuzzSrc) GetInt8() int8       { return int8(s.next(1)[0]) }
+func (s *codonFuzzSrc) GetInt16() int16     { return int16(s.GetUint16()) }
+func (s *codonFuzzSrc) GetInt32() int32     { return int32(s.GetUint32()) }
+func (s *codonFuzzSrc) GetInt64() int64     { return int64(s.GetUint64()) }
+func (s *codonFuzzSrc) GetUint() uint       { return uint(s.GetUint64()) }
+func (s *codonFuzzSrc) GetUint8() uint8     { return s.next(1)[0] }
+func (s *codonFuzzSrc) GetUint16() uint16   { return binary.LittleEndian.Uint16(s.next(2)) }
+func (s *codonFuzzSrc) GetUint32() uint32   { return binary.LittleEndian.Uint32(s.next(4)) }
+func (s *codonFuzzSrc) GetUint64() uint64   { return binary.LittleEndian.Uint64(s.next(8)) }
+func (s *codonFuzzSrc) GetFloat32() float32 { return math.Float32frombits(s.GetUint32()) }
+func (s *codonFuzzSrc) GetFloat64() float64 { return math.Float64frombits(s.GetUint64()) }
+func (s *codonFuzzSrc) GetString(n int) string {
+	return string(s.next(n))
+}
+func (s *codonFuzzSrc) GetBytes(n int) []byte {
+	return s.next(n)
+}
+
+// Seeds the corpus with the encoded bytes of random values, which are filled by Rand* functions
+func codonFuzzSeeds(f *testing.F, encodeRand func(r RandSrc) []byte) {
+	f.Add([]byte{})
+	rnd := rand.New(rand.NewSource(0))
+	for i := 0; i < 16; i++ {
+		input := make([]byte, 1024)
+		rnd.Read(input)
+		f.Add(encodeRand(&codonFuzzSrc{bz: input}))
+	}
+}
+
+func FuzzDecodeMsg(f *testing.F) {
+	codonFuzzSeeds(f, func(r RandSrc) []byte {
+		var buf []byte
+		EncodeMsg(&buf, RandMsg(r))
+		return buf
+	})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		v, _, err := DecodeMsg(bz)
+		if err != nil {
+			return
+		}
+		var buf []byte
+		EncodeMsg(&buf, v)
+		v2, n, err := DecodeMsg(buf)
+		if err != nil {
+			t.Fatalf("Msg: cannot decode the re-encoded bytes: %v", err)
+		}
+		if n != len(buf) {
+			t.Fatalf("Msg: decoded %d bytes out of %d", n, len(buf))
+		}
+		var buf2 []byte
+		EncodeMsg(&buf2, v2)
+		if !bytes.Equal(buf, buf2) {
+			t.Fatalf("Msg: encoded bytes changed after decode->encode->decode")
+		}
+	})
+}
+
+func FuzzDecodeCoin(f *testing.F) {
+	codonFuzzSeeds(f, func(r RandSrc) []byte {
+		var buf []byte
+		EncodeCoin(&buf, RandCoin(r))
+		return buf
+	})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		v, _, err := DecodeCoin(bz)
+		if err != nil {
+			return
+		}
+		var buf []byte
+		EncodeCoin(&buf, v)
+		v2, n, err := DecodeCoin(buf)
+		if err != nil {
+			t.Fatalf("Coin: cannot decode the re-encoded bytes: %v", err)
+		}
+		if n != len(buf) {
+			t.Fatalf("Coin: decoded %d bytes out of %d", n, len(buf))
+		}
+		var buf2 []byte
+		EncodeCoin(&buf2, v2)
+		if !bytes.Equal(buf, buf2) {
+			t.Fatalf("Coin: encoded bytes changed after decode->encode->decode")
+		}
+	})
+}
+
+func FuzzDecodeFee(f *testing.F) {
+	codonFuzzSeeds(f, func(r RandSrc) []byte {
+		var buf []byte
+		EncodeFee(&buf, RandFee(r))
+		return buf
+	})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		v, _, err := DecodeFee(bz)
+		if err != nil {
+			return
+		}
+		var buf []byte
+		EncodeFee(&buf, v)
+		v2, n, err := DecodeFee(buf)
+		if err != nil {
+			t.Fatalf("Fee: cannot decode the re-encoded bytes: %v", err)
+		}
+		if n != len(buf) {
+			t.Fatalf("Fee: decoded %d bytes out of %d", n, len(buf))
+		}
+		var buf2 []byte
+		EncodeFee(&buf2, v2)
+		if !bytes.Equal(buf, buf2) {
+			t.Fatalf("Fee: encoded bytes changed after decode->encode->decode")
+		}
+	})
+}
+
+func FuzzDecodeMsgSend(f *testing.F) {
+	codonFuzzSeeds(f, func(r RandSrc) []byte {
+		var buf []byte
+		EncodeMsgSend(&buf, RandMsgSend(r))
+		return buf
+	})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		v, _, err := DecodeMsgSend(bz)
+		if err != nil {
+			return
+		}
+		var buf []byte
+		EncodeMsgSend(&buf, v)
+		v2, n, err := DecodeMsgSend(buf)
+		if err != nil {
+			t.Fatalf("MsgSend: cannot decode the re-encoded bytes: %v", err)
+		}
+		if n != len(buf) {
+			t.Fatalf("MsgSend: decoded %d bytes out of %d", n, len(buf))
+		}
+		var buf2 []byte
+		EncodeMsgSend(&buf2, v2)
+		if !bytes.Equal(buf, buf2) {
+			t.Fatalf("MsgSend: encoded bytes changed after decode->encode->decode")
+		}
+	})
+}
+
+func FuzzDecodeMsgVote(f *testing.F) {
+	codonFuzzSeeds(f, func(r RandSrc) []byte {
+		var buf []byte
+		EncodeMsgVote(&buf, RandMsgVote(r))
+		return buf
+	})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		v, _, err := DecodeMsgVote(bz)
+		if err != nil {
+			return
+		}
+		var buf []byte
+		EncodeMsgVote(&buf, v)
+		v2, n, err := DecodeMsgVote(buf)
+		if err != nil {
+			t.Fatalf("MsgVote: cannot decode the re-encoded bytes: %v", err)
+		}
+		if n != len(buf) {
+			t.Fatalf("MsgVote: decoded %d bytes out of %d", n, len(buf))
+		}
+		var buf2 []byte
+		EncodeMsgVote(&buf2, v2)
+		if !bytes.Equal(buf, buf2) {
+			t.Fatalf("MsgVote: encoded bytes changed after decode->encode->decode")
+		}
+	})
+}
+
+func FuzzDecodeTx(f *testing.F) {
+	codonFuzzSeeds(f, func(r RandSrc) []byte {
+		var buf []byte
+		EncodeTx(&buf, RandTx(r))
+		return buf
+	})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		v, _, err := DecodeTx(bz)
+		if err != nil {
+			return
+		}
+		var buf []byte
+		EncodeTx(&buf, v)
+		v2, n, err := DecodeTx(buf)
+		if err != nil {
+			t.Fatalf("Tx: cannot decode the re-encoded bytes: %v", err)
+		}
+		if n != len(buf) {
+			t.Fatalf("Tx: decoded %d bytes out of %d", n, len(buf))
+		}
+		var buf2 []byte
+		EncodeTx(&buf2, v2)
+		if !bytes.Equal(buf, buf2) {
+			t.Fatalf("Tx: encoded bytes changed after decode->encode->decode")
+		}
+	})
+}
+
+func FuzzDecodeExtra(f *testing.F) {
+	codonFuzzSeeds(f, func(r RandSrc) []byte {
+		var buf []byte
+		EncodeExtra(&buf, RandExtra(r))
+		return buf
+	})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		v, _, err := DecodeExtra(bz)
+		if err != nil {
+			return
+		}
+		var buf []byte
+		EncodeExtra(&buf, v)
+		v2, n, err := DecodeExtra(buf)
+		if err != nil {
+			t.Fatalf("Extra: cannot decode the re-encoded bytes: %v", err)
+		}
+		if n != len(buf) {
+			t.Fatalf("Extra: decoded %d bytes out of %d", n, len(buf))
+		}
+		var buf2 []byte
+		EncodeExtra(&buf2, v2)
+		if !bytes.Equal(buf, buf2) {
+			t.Fatalf("Extra: encoded bytes changed after decode->encode->decode")
+		}
+	})
+}
+
+func FuzzDecodeAny(f *testing.F) {
+	codonFuzzSeeds(f, func(r RandSrc) []byte {
+		var buf []byte
+		EncodeAny(&buf, RandAny(r))
+		return buf
+	})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		v, _, err := DecodeAny(bz)
+		if err != nil {
+			return
+		}
+		var buf []byte
+		EncodeAny(&buf, v)
+		v2, n, err := DecodeAny(buf)
+		if err != nil {
+			t.Fatalf("Any: cannot decode the re-encoded bytes: %v", err)
+		}
+		if n != len(buf) {
+			t.Fatalf("Any: decoded %d bytes out of %d", n, len(buf))
+		}
+		var buf2 []byte
+		EncodeAny(&buf2, v2)
+		if !bytes.Equal(buf, buf2) {
+			t.Fatalf("Any: encoded bytes changed after decode->encode->decode")
+		}
+	})
+}
@@ -0,0 +1,280 @@
+package codec
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	amino "github.com/coinexchain/codon/wrap-amino"
+)
+
+func newRandSrc(seed int64) RandSrc {
+	input := make([]byte, 1<<20)
+	rand.New(rand.NewSource(seed)).Read(input)
+	return &codonFuzzSrc{bz: input}
+}
+
+func sampleTx() Tx {
+	return Tx{
+		Msgs: []Msg{
+			MsgSend{From: []byte("alice"), To: []byte("bob"), Amount: []Coin{{Denom: "cet", Amount: -5}}},
+			MsgVote{Voter: []byte("carol"), Proposal: 7, Options: []uint32{1, 300, 70000}, Yes: true, Weight: -1},
+		},
+		Fee:  &Fee{Amount: []Coin{{Denom: "cet", Amount: 100}, {Denom: "usdt", Amount: 2}}, Gas: 200000},
+		Memo: "memo",
+		Sigs: [][]byte{[]byte("sig1"), []byte("sig2"), []byte("sig3")},
+	}
+}
+
+// larger than the chunks written by the Hash functions
+func bigTx() Tx {
+	tx := sampleTx()
+	for i := 0; i < 100; i++ {
+		tx.Sigs = append(tx.Sigs, bytes.Repeat([]byte{byte(i)}, 100))
+	}
+	return tx
+}
+
+func TestRoundTripSelfTest(t *testing.T) {
+	if err := RoundTripSelfTest(newRandSrc(1), 200); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHashIsSHA256OfEncode(t *testing.T) {
+	r := newRandSrc(2)
+	txs := []Tx{sampleTx(), bigTx(), {}}
+	for i := 0; i < 50; i++ {
+		txs = append(txs, RandTx(r))
+	}
+	for _, tx := range txs {
+		var buf []byte
+		EncodeTx(&buf, tx)
+		h := sha256.New()
+		HashTx(h, tx)
+		if sum := sha256.Sum256(buf); !bytes.Equal(h.Sum(nil), sum[:]) {
+			t.Fatalf("HashTx is not the SHA256 of EncodeTx's bytes for %+v", tx)
+		}
+	}
+	extra := RandExtra(r)
+	var buf []byte
+	EncodeExtra(&buf, extra)
+	h := sha256.New()
+	HashExtra(h, extra)
+	if sum := sha256.Sum256(buf); !bytes.Equal(h.Sum(nil), sum[:]) {
+		t.Fatalf("HashExtra is not the SHA256 of EncodeExtra's bytes")
+	}
+}
+
+func TestPeekAgreesWithDecode(t *testing.T) {
+	r := newRandSrc(3)
+	txs := []Tx{sampleTx(), {Memo: "no fee"}}
+	for i := 0; i < 50; i++ {
+		txs = append(txs, RandTx(r))
+	}
+	for _, tx := range txs {
+		var buf []byte
+		EncodeTx(&buf, tx)
+		v, _, err := DecodeTx(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fee := v.Fee
+		if fee == nil {
+			fee = &Fee{}
+		}
+		check := func(name string, peeked, decoded interface{}, err error) {
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if !reflect.DeepEqual(peeked, decoded) {
+				t.Fatalf("%s returns %+v, but the decoded value is %+v", name, peeked, decoded)
+			}
+		}
+		msgs, err := PeekTx_Msgs(buf)
+		check("PeekTx_Msgs", msgs, v.Msgs, err)
+		peekedFee, err := PeekTx_Fee(buf)
+		check("PeekTx_Fee", peekedFee, v.Fee, err)
+		amount, err := PeekTx_Fee_Amount(buf)
+		check("PeekTx_Fee_Amount", amount, fee.Amount, err)
+		gas, err := PeekTx_Fee_Gas(buf)
+		check("PeekTx_Fee_Gas", gas, fee.Gas, err)
+		memo, err := PeekTx_Memo(buf)
+		check("PeekTx_Memo", memo, v.Memo, err)
+		sigs, err := PeekTx_Sigs(buf)
+		check("PeekTx_Sigs", sigs, v.Sigs, err)
+	}
+}
+
+// the serializable file of the fixture is generated with the same options as this codec
+func TestToBytesAgreesWithEncode(t *testing.T) {
+	r := newRandSrc(5)
+	votes := []MsgVote{sampleTx().Msgs[1].(MsgVote), {Weight: -1 << 31}}
+	fees := []Fee{*sampleTx().Fee, {Amount: []Coin{{Denom: "cet", Amount: -1}}}}
+	for i := 0; i < 50; i++ {
+		votes = append(votes, RandMsgVote(r))
+		fees = append(fees, RandFee(r))
+	}
+	for _, vote := range votes {
+		var buf []byte
+		EncodeMsgVote(&buf, vote)
+		v := vote
+		if bz := v.ToBytes(); !bytes.Equal(bz, buf) {
+			t.Fatalf("ToBytes writes %x for %+v, but EncodeMsgVote writes %x", bz, vote, buf)
+		}
+	}
+	for _, fee := range fees {
+		var buf []byte
+		EncodeFee(&buf, fee)
+		v := fee
+		if bz := v.ToBytes(); !bytes.Equal(bz, buf) {
+			t.Fatalf("ToBytes writes %x for %+v, but EncodeFee writes %x", bz, fee, buf)
+		}
+	}
+}
+
+// this fixture is generated without SkipUnknownFields
+func TestUnknownField(t *testing.T) {
+	var buf []byte
+	EncodeMsgVote(&buf, MsgVote{Voter: []byte("carol"), Proposal: 7, Options: []uint32{1}})
+	if _, _, err := DecodeCoin(buf); err == nil || err.Error() != "Unknown Field" {
+		t.Fatalf("want the error of an unknown field, but got %v", err)
+	}
+}
+
+func checkLimit(t *testing.T, err error, limit string) {
+	t.Helper()
+	var e *ErrLimitExceeded
+	if !errors.As(err, &e) || e.Limit != limit {
+		t.Fatalf("want an error of %s, but got %v", limit, err)
+	}
+}
+
+func TestDecodeLimits(t *testing.T) {
+	var buf []byte
+	EncodeTx(&buf, sampleTx())
+	if _, _, err := DecodeTxWithOptions(buf, DecodeOptions{MaxSliceLength: 3, MaxBytesLength: 5, MaxDepth: 3,
+		MaxTotalBytes: len(buf)}); err != nil {
+		t.Fatal(err)
+	}
+	_, _, err := DecodeTxWithOptions(buf, DecodeOptions{MaxSliceLength: 2})
+	checkLimit(t, err, "MaxSliceLength")
+	_, _, err = DecodeTxWithOptions(buf, DecodeOptions{MaxBytesLength: 4})
+	checkLimit(t, err, "MaxBytesLength")
+	_, _, err = DecodeTxWithOptions(buf, DecodeOptions{MaxDepth: 2})
+	checkLimit(t, err, "MaxDepth")
+	_, _, err = DecodeTxWithOptions(buf, DecodeOptions{MaxTotalBytes: len(buf) - 1})
+	checkLimit(t, err, "MaxTotalBytes")
+
+	// the packed elements are counted one by one
+	buf = buf[:0]
+	EncodeMsgVote(&buf, MsgVote{Options: []uint32{1, 2, 3}})
+	_, _, err = DecodeMsgVoteWithOptions(buf, DecodeOptions{MaxSliceLength: 2})
+	checkLimit(t, err, "MaxSliceLength")
+}
+
+func decodeEachTx(tx Tx, opts DecodeOptions) (fields []string, err error) {
+	var stream bytes.Buffer
+	enc := NewEncoder(&stream)
+	if err = enc.Encode(tx); err != nil {
+		return
+	}
+	if err = enc.Flush(); err != nil {
+		return
+	}
+	dec := NewDecoder(&stream)
+	dec.DecodeOptions = opts
+	_, err = dec.DecodeEachTx(func(field string, elem interface{}) error {
+		fields = append(fields, field)
+		return nil
+	})
+	return
+}
+
+func TestDecodeEachLimits(t *testing.T) {
+	fields, err := decodeEachTx(sampleTx(), DecodeOptions{MaxSliceLength: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"Msgs", "Msgs", "Sigs", "Sigs", "Sigs"}
+	if !reflect.DeepEqual(fields, want) {
+		t.Fatalf("DecodeEachTx passes %v, want %v", fields, want)
+	}
+	// each signature has its own tag, and they are counted across the tags
+	_, err = decodeEachTx(Tx{Sigs: [][]byte{{1}, {2}, {3}}}, DecodeOptions{MaxSliceLength: 2})
+	checkLimit(t, err, "MaxSliceLength")
+	_, err = decodeEachTx(sampleTx(), DecodeOptions{MaxBytesLength: 4})
+	checkLimit(t, err, "MaxBytesLength")
+}
+
+func TestMarshalJSONAnyOfNil(t *testing.T) {
+	cdc := &CodecImp{}
+	for _, o := range []interface{}{nil, (*Fee)(nil), (*Msg)(nil)} {
+		bz, err := cdc.MarshalJSONAny(o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(bz) != "null" {
+			t.Fatalf("MarshalJSONAny writes %s for %#v, want null", bz, o)
+		}
+	}
+}
+
+func TestShadowModeAgainstAmino(t *testing.T) {
+	oldStub := amino.Stub
+	amino.Stub = &CodonStub{}
+	defer func() {
+		amino.Stub = oldStub
+	}()
+	cdc := amino.NewCodec()
+	cdc.RegisterInterface((*Msg)(nil), nil)
+	cdc.RegisterConcrete(MsgSend{}, "fixture/MsgSend", nil)
+	cdc.RegisterConcrete(MsgVote{}, "fixture/MsgVote", nil)
+	cdc.RegisterConcrete(Tx{}, "fixture/Tx", nil)
+	cdc.Seal()
+	cdc.EnableVerification(func(m *amino.Mismatch) {
+		t.Error(m)
+	}, false)
+
+	r := newRandSrc(4)
+	txs := []Tx{sampleTx(), bigTx()}
+	for i := 0; i < 50; i++ {
+		txs = append(txs, RandTx(r))
+	}
+	for _, tx := range txs {
+		bz, err := cdc.MarshalBinaryBare(tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var v Tx
+		if err = cdc.UnmarshalBinaryBare(bz, &v); err != nil {
+			t.Fatal(err)
+		}
+		bz, err = cdc.MarshalBinaryLengthPrefixed(tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = cdc.UnmarshalBinaryLengthPrefixed(bz, &v); err != nil {
+			t.Fatal(err)
+		}
+		if bz, err = cdc.MarshalJSON(tx); err != nil {
+			t.Fatal(err)
+		}
+		if err = cdc.UnmarshalJSON(bz, &v); err != nil {
+			t.Fatal(err)
+		}
+		for _, msg := range tx.Msgs {
+			bz, err = cdc.MarshalBinaryBare(msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var m Msg
+			if err = cdc.UnmarshalBinaryBare(bz, &m); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package codec
+
+import "github.com/coinexchain/codon/internal/fixture"
+
+type (
+	Msg     = fixture.Msg
+	Coin    = fixture.Coin
+	Fee     = fixture.Fee
+	MsgSend = fixture.MsgSend
+	MsgVote = fixture.MsgVote
+	Tx      = fixture.Tx
+	Extra   = fixture.Extra
+)
+
+// The limits used by the Rand functions
+const (
+	MaxStringLength = 10
+	MaxSliceLength  = 5
+)
@@ -0,0 +1,5922 @@
+// Code generated by codon. DO NOT EDIT.
+
+//nolint:all
+package codec
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"unsafe"
+)
+
+type RandSrc interface {
+	GetBool() bool
+	GetInt() int
+	GetInt8() int8
+	GetInt16() int16
+	GetInt32() int32
+	GetInt64() int64
+	GetUint() uint
+	GetUint8() uint8
+	GetUint16() uint16
+	GetUint32() uint32
+	GetUint64() uint64
+	GetFloat32() float32
+	GetFloat64() float64
+	GetString(n int) string
+	GetBytes(n int) []byte
+}
+
+func codonWriteVarint(w *[]byte, v int64) {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(buf[:], v)
+	*w = append(*w, buf[0:n]...)
+}
+func codonWriteUvarint(w *[]byte, v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	*w = append(*w, buf[0:n]...)
+}
+
+func codonWriteBool(w *[]byte, v bool) {
+	if v {
+		codonWriteUvarint(w, uint64(1))
+	} else {
+		codonWriteUvarint(w, uint64(0))
+	}
+}
+func codonWriteFloat32(w *[]byte, v float32) {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], math.Float32bits(v))
+	*w = append(*w, buf[:]...)
+}
+func codonWriteFloat64(w *[]byte, v float64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], math.Float64bits(v))
+	*w = append(*w, buf[:]...)
+}
+
+func codonEncodeBool(n int, w *[]byte, v bool) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteBool(w, v)
+}
+func codonEncodeVarint(n int, w *[]byte, v int64) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteVarint(w, int64(v))
+}
+func codonEncodeInt8(n int, w *[]byte, v int8) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteVarint(w, int64(v))
+}
+func codonEncodeInt16(n int, w *[]byte, v int16) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteVarint(w, int64(v))
+}
+func codonEncodeUvarint(n int, w *[]byte, v uint64) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteUvarint(w, v)
+}
+func codonEncodeUint8(n int, w *[]byte, v uint8) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteUvarint(w, uint64(v))
+}
+func codonEncodeUint16(n int, w *[]byte, v uint16) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteUvarint(w, uint64(v))
+}
+
+func codonEncodeByteSlice(n int, w *[]byte, v []byte) {
+	codonWriteUvarint(w, (uint64(n)<<3)|2)
+	codonWriteUvarint(w, uint64(len(v)))
+	*w = append(*w, v...)
+}
+func codonEncodeString(n int, w *[]byte, v string) {
+	codonEncodeByteSlice(n, w, []byte(v))
+}
+func codonEncodeFloat32(n int, w *[]byte, v float32) {
+	codonWriteUvarint(w, (uint64(n)<<3)|5)
+	codonWriteFloat32(w, v)
+}
+func codonEncodeFloat64(n int, w *[]byte, v float64) {
+	codonWriteUvarint(w, (uint64(n)<<3)|1)
+	codonWriteFloat64(w, v)
+}
+
+// writes the tag and the length prefix of a length-delimited field, whose content must follow
+func codonEncodeLength(n int, w *[]byte, length int) {
+	codonWriteUvarint(w, (uint64(n)<<3)|2)
+	codonWriteUvarint(w, uint64(length))
+}
+func codonUvarintSize(v uint64) int {
+	n := 1
+	for v >= 0x80 {
+		v >>= 7
+		n++
+	}
+	return n
+}
+func codonVarintSize(v int64) int {
+	uv := uint64(v) << 1
+	if v < 0 {
+		uv = ^uv
+	}
+	return codonUvarintSize(uv)
+}
+
+// the size of a length-delimited field's content plus its length prefix, excluding the tag
+func codonByteSliceSize(length int) int {
+	return codonUvarintSize(uint64(length)) + length
+}
+
+// codonSizes records the sizes of nested messages in the order they are encoded, such that
+// the length prefixes can be written from the precomputed sizes. A nil *codonSizes records nothing.
+// When out is not nil, the encoded bytes are streamed into it in chunks by flush, and the first
+// error returned by out is kept in err.
+type codonSizes struct {
+	list []int
+	pos  int
+	out  io.Writer
+	err  error
+}
+
+func (s *codonSizes) reserve() int {
+	if s == nil {
+		return 0
+	}
+	s.list = append(s.list, 0)
+	return len(s.list) - 1
+}
+func (s *codonSizes) set(idx, size int) int {
+	if s != nil {
+		s.list[idx] = size
+	}
+	return size
+}
+func (s *codonSizes) next() int {
+	size := s.list[s.pos]
+	s.pos++
+	return size
+}
+
+const codonChunkSize = 4096
+
+func (s *codonSizes) flush(w *[]byte) {
+	if s.out != nil && len(*w) >= codonChunkSize {
+		if s.err == nil {
+			_, s.err = s.out.Write(*w)
+		}
+		*w = (*w)[:0]
+	}
+}
+
+// makes sure n more bytes can be appended to w without reallocation
+func codonGrow(w *[]byte, n int) {
+	if cap(*w)-len(*w) < n {
+		buf := make([]byte, len(*w), len(*w)+n)
+		copy(buf, *w)
+		*w = buf
+	}
+}
+
+// keys must be a slice of map keys, which are sorted before encoding the map
+func codonSortKeys(keys interface{}, less func(i, j int) bool) {
+	sort.Slice(keys, less)
+}
+func codonDecodeBool(bz []byte, n *int, err *error) bool {
+	return codonDecodeInt64(bz, n, err) != 0
+}
+func codonDecodeInt(bz []byte, n *int, err *error) int {
+	return int(codonDecodeInt64(bz, n, err))
+}
+func codonDecodeInt8(bz []byte, n *int, err *error) int8 {
+	return int8(codonDecodeInt64(bz, n, err))
+}
+func codonDecodeInt16(bz []byte, n *int, err *error) int16 {
+	return int16(codonDecodeInt64(bz, n, err))
+}
+func codonDecodeInt32(bz []byte, n *int, err *error) int32 {
+	return int32(codonDecodeInt64(bz, n, err))
+}
+func codonDecodeInt64(bz []byte, m *int, err *error) int64 {
+	i, n := binary.Varint(bz)
+	if n == 0 {
+		// buf too small
+		*err = errors.New("buffer too small")
+	} else if n < 0 {
+		// value larger than 64 bits (overflow)
+		// and -n is the number of bytes read
+		n = -n
+		*err = errors.New("EOF decoding varint")
+	}
+	*m = n
+	return int64(i)
+}
+func codonDecodeUint(bz []byte, n *int, err *error) uint {
+	return uint(codonDecodeUint64(bz, n, err))
+}
+func codonDecodeUint8(bz []byte, n *int, err *error) uint8 {
+	return uint8(codonDecodeUint64(bz, n, err))
+}
+func codonDecodeUint16(bz []byte, n *int, err *error) uint16 {
+	return uint16(codonDecodeUint64(bz, n, err))
+}
+func codonDecodeUint32(bz []byte, n *int, err *error) uint32 {
+	return uint32(codonDecodeUint64(bz, n, err))
+}
+func codonDecodeUint64(bz []byte, m *int, err *error) uint64 {
+	i, n := binary.Uvarint(bz)
+	if n == 0 {
+		// buf too small
+		*err = errors.New("buffer too small")
+	} else if n < 0 {
+		// value larger than 64 bits (overflow)
+		// and -n is the number of bytes read
+		n = -n
+		*err = errors.New("EOF decoding varint")
+	}
+	*m = n
+	return uint64(i)
+}
+func codonDecodeFloat32(bz []byte, n *int, err *error) float32 {
+	if len(bz) < 4 {
+		*err = errors.New("Not enough bytes to read")
+		return 0
+	}
+	*n = 4
+	return math.Float32frombits(binary.LittleEndian.Uint32(bz[:4]))
+}
+func codonDecodeFloat64(bz []byte, n *int, err *error) float64 {
+	if len(bz) < 8 {
+		*err = errors.New("Not enough bytes to read")
+		return 0
+	}
+	*n = 8
+	return math.Float64frombits(binary.LittleEndian.Uint64(bz[:8]))
+}
+
+// Returns how many bytes a field's value occupies, according to its wire type
+func codonSkipField(bz []byte, wireType int) (int, error) {
+	switch wireType {
+	case 0: // varint
+		_, n := binary.Uvarint(bz)
+		if n <= 0 {
+			return 0, errors.New("EOF decoding varint")
+		}
+		return n, nil
+	case 1: // fixed64
+		if len(bz) < 8 {
+			return 0, errors.New("Not enough bytes to read")
+		}
+		return 8, nil
+	case 2: // length-delimited
+		length, n := binary.Uvarint(bz)
+		if n <= 0 {
+			return 0, errors.New("EOF decoding varint")
+		}
+		if uint64(len(bz)-n) < length {
+			return 0, errors.New("Not enough bytes to read")
+		}
+		return n + int(length), nil
+	case 5: // fixed32
+		if len(bz) < 4 {
+			return 0, errors.New("Not enough bytes to read")
+		}
+		return 4, nil
+	default:
+		return 0, errors.New("Unknown wire type")
+	}
+}
+func codonGetByteSlice(res *[]byte, bz []byte) (int, error) {
+	length, n := binary.Uvarint(bz)
+	if n == 0 {
+		// buf too small
+		return n, errors.New("buffer too small")
+	} else if n < 0 {
+		// value larger than 64 bits (overflow)
+		// and -n is the number of bytes read
+		n = -n
+		return n, errors.New("EOF decoding varint")
+	}
+	if length == 0 {
+		*res = nil
+		return n, nil
+	}
+	bz = bz[n:]
+	if uint64(len(bz)) < length {
+		*res = nil
+		return 0, errors.New("Not enough bytes to read")
+	}
+	if *res == nil {
+		*res = append(*res, bz[:length]...)
+	} else {
+		*res = append((*res)[:0], bz[:length]...)
+	}
+	return n + int(length), nil
+}
+
+// the same as codonGetByteSlice, except that res refers to bz instead of a copy. Its capacity is
+// limited, such that appending to it does not overwrite bz.
+func codonGetByteSliceNoCopy(res *[]byte, bz []byte) (int, error) {
+	length, n := binary.Uvarint(bz)
+	if n == 0 {
+		return n, errors.New("buffer too small")
+	} else if n < 0 {
+		n = -n
+		return n, errors.New("EOF decoding varint")
+	}
+	if length == 0 {
+		*res = nil
+		return n, nil
+	}
+	bz = bz[n:]
+	if uint64(len(bz)) < length {
+		*res = nil
+		return 0, errors.New("Not enough bytes to read")
+	}
+	*res = bz[:length:length]
+	return n + int(length), nil
+}
+func codonDecodeString(bz []byte, n *int, err *error) string {
+	var res []byte
+	*n, *err = codonGetByteSlice(&res, bz)
+	return string(res)
+}
+
+// DecodeOptions limits the resources used by the decoders. A zero member means no limit.
+type DecodeOptions struct {
+	// The maximum length of the decoded bytes
+	MaxTotalBytes int
+	// The maximum nesting depth of the structs
+	MaxDepth int
+	// The maximum number of elements in a repeated field or a map
+	MaxSliceLength int
+	// The maximum length of a string or a byte slice
+	MaxBytesLength int
+}
+
+// ErrLimitExceeded is returned when the decoded bytes break a limit in DecodeOptions
+type ErrLimitExceeded struct {
+	// The name of the member in DecodeOptions, such as "MaxDepth"
+	Limit  string
+	Max    int
+	Actual int
+}
+
+func (e *ErrLimitExceeded) Error() string {
+	return fmt.Sprintf("%s is exceeded: %d > %d", e.Limit, e.Actual, e.Max)
+}
+
+// codonDecoder checks DecodeOptions during decoding. A nil *codonDecoder checks nothing.
+// In the noCopy mode, the decoded byte slices and strings share memory with the input.
+type codonDecoder struct {
+	opts   DecodeOptions
+	depth  int
+	noCopy bool
+}
+
+func newCodonDecoder(bz []byte, opts DecodeOptions) (*codonDecoder, error) {
+	if opts.MaxTotalBytes > 0 && len(bz) > opts.MaxTotalBytes {
+		return nil, &ErrLimitExceeded{Limit: "MaxTotalBytes", Max: opts.MaxTotalBytes, Actual: len(bz)}
+	}
+	return &codonDecoder{opts: opts}, nil
+}
+func (d *codonDecoder) enter() error {
+	if d == nil {
+		return nil
+	}
+	d.depth++
+	if d.opts.MaxDepth > 0 && d.depth > d.opts.MaxDepth {
+		return &ErrLimitExceeded{Limit: "MaxDepth", Max: d.opts.MaxDepth, Actual: d.depth}
+	}
+	return nil
+}
+func (d *codonDecoder) leave() {
+	if d != nil {
+		d.depth--
+	}
+}
+func (d *codonDecoder) checkSliceLength(length int) error {
+	if d != nil && d.opts.MaxSliceLength > 0 && length > d.opts.MaxSliceLength {
+		return &ErrLimitExceeded{Limit: "MaxSliceLength", Max: d.opts.MaxSliceLength, Actual: length}
+	}
+	return nil
+}
+
+// the length is checked before the bytes are copied
+func (d *codonDecoder) getByteSlice(res *[]byte, bz []byte) (int, error) {
+	if d != nil && d.opts.MaxBytesLength > 0 {
+		length, n := binary.Uvarint(bz)
+		if n > 0 && length > uint64(d.opts.MaxBytesLength) {
+			return n, &ErrLimitExceeded{Limit: "MaxBytesLength", Max: d.opts.MaxBytesLength, Actual: int(length)}
+		}
+	}
+	if d != nil && d.noCopy {
+		return codonGetByteSliceNoCopy(res, bz)
+	}
+	return codonGetByteSlice(res, bz)
+}
+func (d *codonDecoder) decodeString(bz []byte, n *int, err *error) string {
+	var res []byte
+	*n, *err = d.getByteSlice(&res, bz)
+	if d != nil && d.noCopy {
+		return unsafe.String(unsafe.SliceData(res), len(res))
+	}
+	return string(res)
+}
+
+// ErrUnknownMagicNum is returned when the decoded bytes have a magic number unknown to the decoder
+type ErrUnknownMagicNum struct {
+	MagicNum uint32
+	// The alias of the decoded interface, or "interface{}" for DecodeAny
+	Alias string
+}
+
+func (e *ErrUnknownMagicNum) Error() string {
+	return fmt.Sprintf("Unknown magic number %d when decoding %s", e.MagicNum, e.Alias)
+}
+
+// ErrTypeMismatch is returned when a decoded struct cannot be assigned to the target
+type ErrTypeMismatch struct {
+	// The magic number and alias of the decoded struct
+	MagicNum uint32
+	Alias    string
+	// The type of the target pointer
+	Target string
+}
+
+func (e *ErrTypeMismatch) Error() string {
+	return fmt.Sprintf("Type mismatch: cannot assign %s (magic number %d) to %s", e.Alias, e.MagicNum, e.Target)
+}
+
+func newErrTypeMismatch(structObj interface{}, target interface{}) error {
+	magicNum, _ := getMagicNumOfVar(structObj)
+	return &ErrTypeMismatch{
+		MagicNum: magicNum,
+		Alias:    getAliasOfMagicNum(magicNum),
+		Target:   fmt.Sprintf("%T", target),
+	}
+}
+
+// codonJSONWriter writes amino-compatible JSON. Only the first error is kept.
+type codonJSONWriter struct {
+	buf []byte
+	err error
+}
+
+func (w *codonJSONWriter) fail(err error) {
+	if w.err == nil {
+		w.err = err
+	}
+}
+
+func (w *codonJSONWriter) result() ([]byte, error) {
+	if w.err != nil {
+		return nil, w.err
+	}
+	return w.buf, nil
+}
+
+func (w *codonJSONWriter) raw(s string) {
+	w.buf = append(w.buf, s...)
+}
+
+// writes a comma unless it is the first member of an object or the first element of an array
+func (w *codonJSONWriter) comma(open byte) {
+	if len(w.buf) != 0 && w.buf[len(w.buf)-1] != open {
+		w.buf = append(w.buf, ',')
+	}
+}
+
+// writes a struct member's key, which is already escaped and followed by a colon
+func (w *codonJSONWriter) key(k string) {
+	w.comma('{')
+	w.buf = append(w.buf, k...)
+}
+
+func (w *codonJSONWriter) mapKey(k string) {
+	w.comma('{')
+	w.string(k)
+	w.buf = append(w.buf, ':')
+}
+
+// begins the wrapper of a registered type, which must be closed with a "}"
+func (w *codonJSONWriter) beginType(name string) {
+	w.buf = append(w.buf, "{\"type\":\""...)
+	w.buf = append(w.buf, name...)
+	w.buf = append(w.buf, "\",\"value\":"...)
+}
+
+func (w *codonJSONWriter) bool(b bool) {
+	if b {
+		w.raw("true")
+	} else {
+		w.raw("false")
+	}
+}
+
+func (w *codonJSONWriter) int(i int64) {
+	w.buf = strconv.AppendInt(w.buf, i, 10)
+}
+
+func (w *codonJSONWriter) uint(u uint64) {
+	w.buf = strconv.AppendUint(w.buf, u, 10)
+}
+
+// 64-bit integers are quoted, because javascript cannot handle them
+func (w *codonJSONWriter) quotedInt(i int64) {
+	w.buf = append(w.buf, '"')
+	w.buf = strconv.AppendInt(w.buf, i, 10)
+	w.buf = append(w.buf, '"')
+}
+
+func (w *codonJSONWriter) quotedUint(u uint64) {
+	w.buf = append(w.buf, '"')
+	w.buf = strconv.AppendUint(w.buf, u, 10)
+	w.buf = append(w.buf, '"')
+}
+
+// escapes s just like encoding/json
+func (w *codonJSONWriter) string(s string) {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c < 0x20 || c >= 0x7f || c == '"' || c == '\\' || c == '<' || c == '>' || c == '&' {
+			w.marshal(s)
+			return
+		}
+	}
+	w.buf = append(w.buf, '"')
+	w.buf = append(w.buf, s...)
+	w.buf = append(w.buf, '"')
+}
+
+// writes bz in base64, or null if it is nil
+func (w *codonJSONWriter) bytes(bz []byte) {
+	if bz == nil {
+		w.raw("null")
+		return
+	}
+	w.buf = append(w.buf, '"')
+	w.buf = base64.StdEncoding.AppendEncode(w.buf, bz)
+	w.buf = append(w.buf, '"')
+}
+
+// uses v's MarshalJSON if it has one, otherwise encoding/json
+func (w *codonJSONWriter) marshal(v interface{}) {
+	if m, ok := v.(json.Marshaler); ok {
+		w.marshaler(m)
+		return
+	}
+	bz, err := json.Marshal(v)
+	if err != nil {
+		w.fail(err)
+		return
+	}
+	w.buf = append(w.buf, bz...)
+}
+
+// the output of MarshalJSON is written unchanged, as amino does
+func (w *codonJSONWriter) marshaler(m json.Marshaler) {
+	bz, err := m.MarshalJSON()
+	if err != nil {
+		w.fail(err)
+		return
+	}
+	w.buf = append(w.buf, bz...)
+}
+
+// codonJSONReader reads the JSON written by codonJSONWriter or amino. Only the first error is kept,
+// and after it every value is read as null.
+type codonJSONReader struct {
+	bz  []byte
+	pos int
+	err error
+	// the key of the object member being read
+	key []byte
+	// whether no member or element of the current object or array has been read
+	first bool
+}
+
+func (d *codonJSONReader) fail(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+}
+
+func (d *codonJSONReader) failf(format string, args ...interface{}) {
+	d.fail(fmt.Errorf(format, args...))
+}
+
+// skips the spaces and returns the next byte, or 0 at the end
+func (d *codonJSONReader) peek() byte {
+	for ; d.pos < len(d.bz); d.pos++ {
+		switch c := d.bz[d.pos]; c {
+		case ' ', '\t', '\n', '\r':
+		default:
+			return c
+		}
+	}
+	return 0
+}
+
+func (d *codonJSONReader) unexpected(what string) {
+	if d.pos >= len(d.bz) {
+		d.failf("unexpected end of JSON input, expecting %s", what)
+	} else {
+		d.failf("invalid character %q at offset %d, expecting %s", d.bz[d.pos], d.pos, what)
+	}
+}
+
+func (d *codonJSONReader) expect(c byte) bool {
+	if d.err != nil {
+		return false
+	}
+	if d.peek() != c {
+		d.unexpected(strconv.QuoteRune(rune(c)))
+		return false
+	}
+	d.pos++
+	return true
+}
+
+func (d *codonJSONReader) literal(s string) {
+	if !bytes.HasPrefix(d.bz[d.pos:], []byte(s)) {
+		d.unexpected(s)
+		return
+	}
+	d.pos += len(s)
+}
+
+// consumes a null and returns true. After an error it returns true too, such that nothing more is read.
+func (d *codonJSONReader) null() bool {
+	if d.err != nil {
+		return true
+	}
+	if d.peek() == 'n' {
+		d.literal("null")
+		return true
+	}
+	return false
+}
+
+func (d *codonJSONReader) beginObject() {
+	if d.expect('{') {
+		d.first = true
+	}
+}
+
+// reads the key of the next member into d.key, and returns false at the end of the object
+func (d *codonJSONReader) nextMember() bool {
+	if d.err != nil {
+		return false
+	}
+	if d.peek() == '}' {
+		d.pos++
+		d.first = false
+		return false
+	}
+	if !d.first && !d.expect(',') {
+		return false
+	}
+	d.first = false
+	d.key = d.stringBytes()
+	return d.expect(':')
+}
+
+func (d *codonJSONReader) beginArray() {
+	if d.expect('[') {
+		d.first = true
+	}
+}
+
+// returns false at the end of the array
+func (d *codonJSONReader) nextElem() bool {
+	if d.err != nil {
+		return false
+	}
+	if d.peek() == ']' {
+		d.pos++
+		d.first = false
+		return false
+	}
+	if !d.first && !d.expect(',') {
+		return false
+	}
+	d.first = false
+	return true
+}
+
+// the returned slice may share the memory of the input
+func (d *codonJSONReader) stringBytes() []byte {
+	if !d.expect('"') {
+		return nil
+	}
+	start := d.pos
+	for i := start; i < len(d.bz); i++ {
+		c := d.bz[i]
+		if c == '"' {
+			d.pos = i + 1
+			return d.bz[start:i]
+		}
+		if c == '\\' || c < 0x20 || c >= 0x80 {
+			return d.unquote(start - 1)
+		}
+	}
+	d.pos = len(d.bz)
+	d.unexpected("'\"'")
+	return nil
+}
+
+// unquotes the string beginning at start with encoding/json, which handles the escapes and invalid UTF-8
+func (d *codonJSONReader) unquote(start int) []byte {
+	end := start + 1
+	for ; end < len(d.bz) && d.bz[end] != '"'; end++ {
+		if d.bz[end] == '\\' {
+			end++
+		}
+	}
+	if end >= len(d.bz) {
+		d.pos = len(d.bz)
+		d.unexpected("'\"'")
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(d.bz[start:end+1], &s); err != nil {
+		d.fail(err)
+		return nil
+	}
+	d.pos = end + 1
+	return []byte(s)
+}
+
+func (d *codonJSONReader) string() string {
+	return string(d.stringBytes())
+}
+
+// reads base64 bytes, an empty string is read as nil
+func (d *codonJSONReader) bytes() []byte {
+	s := d.stringBytes()
+	if d.err != nil || len(s) == 0 {
+		return nil
+	}
+	res, err := base64.StdEncoding.AppendDecode(nil, s)
+	if err != nil {
+		d.fail(err)
+		return nil
+	}
+	return res
+}
+
+// reads base64 bytes into a byte array, whose length must match
+func (d *codonJSONReader) byteArray(a []byte) {
+	bz := d.bytes()
+	if d.err == nil && len(bz) != len(a) {
+		d.failf("byte-length mismatch, got %d want %d", len(bz), len(a))
+		return
+	}
+	copy(a, bz)
+}
+
+func (d *codonJSONReader) bool() bool {
+	if d.err != nil {
+		return false
+	}
+	switch d.peek() {
+	case 't':
+		d.literal("true")
+		return d.err == nil
+	case 'f':
+		d.literal("false")
+	default:
+		d.unexpected("a boolean")
+	}
+	return false
+}
+
+// returns the end of the JSON number beginning at start, or start if there is no number
+func codonScanJSONNumber(bz []byte, start int) int {
+	isDigit := func(i int) bool {
+		return i < len(bz) && bz[i] >= '0' && bz[i] <= '9'
+	}
+	i := start
+	if i < len(bz) && bz[i] == '-' {
+		i++
+	}
+	if i < len(bz) && bz[i] == '0' {
+		i++
+	} else if isDigit(i) {
+		for i++; isDigit(i); i++ {
+		}
+	} else {
+		return start
+	}
+	if i < len(bz) && bz[i] == '.' && isDigit(i+1) {
+		for i += 2; isDigit(i); i++ {
+		}
+	}
+	if i < len(bz) && (bz[i] == 'e' || bz[i] == 'E') {
+		j := i + 1
+		if j < len(bz) && (bz[j] == '+' || bz[j] == '-') {
+			j++
+		}
+		if isDigit(j) {
+			for i = j + 1; isDigit(i); i++ {
+			}
+		}
+	}
+	return i
+}
+
+func (d *codonJSONReader) number() string {
+	if d.err != nil {
+		return ""
+	}
+	d.peek()
+	end := codonScanJSONNumber(d.bz, d.pos)
+	if end == d.pos {
+		d.unexpected("a number")
+		return ""
+	}
+	s := string(d.bz[d.pos:end])
+	d.pos = end
+	return s
+}
+
+// 64-bit integers must be quoted
+func (d *codonJSONReader) quoted() string {
+	s := d.stringBytes()
+	if d.err == nil && (len(s) == 0 || codonScanJSONNumber(s, 0) != len(s)) {
+		d.failf("invalid quoted number %q", s)
+	}
+	return string(s)
+}
+
+func (d *codonJSONReader) parseInt(s string, bitSize int) int64 {
+	if d.err != nil {
+		return 0
+	}
+	i, err := strconv.ParseInt(s, 10, bitSize)
+	if err != nil {
+		d.fail(err)
+	}
+	return i
+}
+
+func (d *codonJSONReader) parseUint(s string, bitSize int) uint64 {
+	if d.err != nil {
+		return 0
+	}
+	u, err := strconv.ParseUint(s, 10, bitSize)
+	if err != nil {
+		d.fail(err)
+	}
+	return u
+}
+
+func (d *codonJSONReader) int(bitSize int) int64 {
+	return d.parseInt(d.number(), bitSize)
+}
+
+func (d *codonJSONReader) uint(bitSize int) uint64 {
+	return d.parseUint(d.number(), bitSize)
+}
+
+func (d *codonJSONReader) quotedInt() int64 {
+	return d.parseInt(d.quoted(), 64)
+}
+
+func (d *codonJSONReader) quotedUint() uint64 {
+	return d.parseUint(d.quoted(), 64)
+}
+
+func (d *codonJSONReader) float(bitSize int) float64 {
+	s := d.number()
+	if d.err != nil {
+		return 0
+	}
+	f, err := strconv.ParseFloat(s, bitSize)
+	if err != nil {
+		d.fail(err)
+	}
+	return f
+}
+
+// the keys of maps are strings, even for integers and bools
+func (d *codonJSONReader) keyBool() bool {
+	switch string(d.key) {
+	case "true":
+		return true
+	case "false":
+	default:
+		d.failf("invalid bool key %q", d.key)
+	}
+	return false
+}
+
+func (d *codonJSONReader) keyInt(bitSize int) int64 {
+	return d.parseInt(string(d.key), bitSize)
+}
+
+func (d *codonJSONReader) keyUint(bitSize int) uint64 {
+	return d.parseUint(string(d.key), bitSize)
+}
+
+const codonMaxJSONDepth = 10000
+
+// skips the next value and checks its syntax
+func (d *codonJSONReader) skip() {
+	d.skipValue(0)
+}
+
+func (d *codonJSONReader) skipValue(depth int) {
+	if depth > codonMaxJSONDepth {
+		d.failf("exceeded max depth %d", codonMaxJSONDepth)
+		return
+	}
+	switch d.peek() {
+	case '{':
+		d.beginObject()
+		for d.nextMember() {
+			d.skipValue(depth + 1)
+		}
+	case '[':
+		d.beginArray()
+		for d.nextElem() {
+			d.skipValue(depth + 1)
+		}
+	case '"':
+		d.stringBytes()
+	case 't', 'f':
+		d.bool()
+	case 'n':
+		d.null()
+	default:
+		d.number()
+	}
+}
+
+// returns the bytes of the next value
+func (d *codonJSONReader) raw() []byte {
+	d.peek()
+	start := d.pos
+	d.skip()
+	if d.err != nil {
+		return nil
+	}
+	return d.bz[start:d.pos]
+}
+
+func (d *codonJSONReader) unmarshaler(u json.Unmarshaler) {
+	if raw := d.raw(); d.err == nil {
+		if err := u.UnmarshalJSON(raw); err != nil {
+			d.fail(err)
+		}
+	}
+}
+
+func (d *codonJSONReader) unmarshal(v interface{}) {
+	if raw := d.raw(); d.err == nil {
+		if err := json.Unmarshal(raw, v); err != nil {
+			d.fail(err)
+		}
+	}
+}
+
+// reads the wrapper of a registered type, and returns the type's name and a reader of its value.
+// The value is read after the name is known, so the order of "type" and "value" does not matter.
+func (d *codonJSONReader) typeValue() (name string, value *codonJSONReader) {
+	var raw []byte
+	d.beginObject()
+	for d.nextMember() {
+		switch string(d.key) {
+		case "type":
+			name = d.string()
+		case "value":
+			raw = d.raw()
+		default:
+			d.skip()
+		}
+	}
+	if d.err == nil && len(name) == 0 {
+		d.failf("JSON encoding of interfaces require non-empty type field")
+	} else if d.err == nil && len(raw) == 0 {
+		d.failf("interface JSON wrapper should have non-empty value field")
+	}
+	return name, &codonJSONReader{bz: raw, err: d.err}
+}
+
+// takes the error of the reader returned by typeValue
+func (d *codonJSONReader) merge(value *codonJSONReader) {
+	if value.err != nil {
+		d.fail(value.err)
+	}
+}
+
+// checks that only spaces follow the value, and returns the first error
+func (d *codonJSONReader) finish() error {
+	if d.err == nil {
+		d.peek()
+		if d.pos < len(d.bz) {
+			d.unexpected("the end of JSON input")
+		}
+	}
+	return d.err
+}
+
+// Encoder writes a sequence of length-prefixed values to an io.Writer. The encoded bytes of a value
+// are written in chunks, so a big value does not need a big buffer.
+type Encoder struct {
+	w   *bufio.Writer
+	buf []byte
+}
+
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: bufio.NewWriter(w)}
+}
+
+// Encode writes a registered value with its length prefix, just like MarshalBinaryLengthPrefixed
+func (e *Encoder) Encode(v interface{}) error {
+	if _, ok := getMagicNumOfVar(v); !ok {
+		return errors.New("Not Supported Type")
+	}
+	s := &codonSizes{out: e.w}
+	size := sizeAny(v, s)
+	e.buf = e.buf[:0]
+	codonWriteUvarint(&e.buf, uint64(size))
+	encodeAny(&e.buf, v, s)
+	if s.err == nil {
+		_, s.err = e.w.Write(e.buf)
+	}
+	return s.err
+}
+
+// Flush writes the buffered bytes to the underlying io.Writer
+func (e *Encoder) Flush() error {
+	return e.w.Flush()
+}
+
+// Decoder reads the values written by Encoder from an io.Reader. After an error, the rest of the stream
+// cannot be read.
+type Decoder struct {
+	// The limits used when decoding each value. MaxTotalBytes also limits the length prefixes.
+	DecodeOptions DecodeOptions
+	r             *bufio.Reader
+	buf           []byte
+	// the number of bytes left in the struct read by a DecodeEach function
+	remaining uint64
+}
+
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: bufio.NewReader(r)}
+}
+
+// Decode reads a value written by Encoder.Encode. io.EOF is returned at the end of the stream.
+func (dec *Decoder) Decode() (interface{}, error) {
+	length, err := dec.readLength()
+	if err != nil {
+		return nil, err
+	}
+	bz, err := dec.read(dec.buf[:0], length)
+	if err != nil {
+		return nil, err
+	}
+	dec.buf = bz
+	v, _, err := DecodeAnyWithOptions(bz, dec.DecodeOptions)
+	return v, err
+}
+
+func (dec *Decoder) readLength() (uint64, error) {
+	length, err := binary.ReadUvarint(dec.r)
+	if err != nil {
+		return 0, err
+	}
+	if max := dec.DecodeOptions.MaxTotalBytes; max > 0 && length > uint64(max) {
+		return 0, &ErrLimitExceeded{Limit: "MaxTotalBytes", Max: max, Actual: int(length)}
+	}
+	return length, nil
+}
+
+// appends n bytes read from the stream to buf
+func (dec *Decoder) read(buf []byte, n uint64) ([]byte, error) {
+	start := len(buf)
+	if uint64(cap(buf)-start) < n {
+		newBuf := make([]byte, start, uint64(start)+n)
+		copy(newBuf, buf)
+		buf = newBuf
+	}
+	buf = buf[:uint64(start)+n]
+	_, err := io.ReadFull(dec.r, buf[start:])
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return buf, err
+}
+
+// appends a varint read from the stream to buf, and returns its value
+func (dec *Decoder) readVarint(buf []byte) ([]byte, uint64, error) {
+	start := len(buf)
+	for i := 0; i < binary.MaxVarintLen64; i++ {
+		b, err := dec.r.ReadByte()
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return buf, 0, err
+		}
+		buf = append(buf, b)
+		if b < 0x80 {
+			v, n := binary.Uvarint(buf[start:])
+			if n <= 0 {
+				return buf, 0, errors.New("EOF decoding varint")
+			}
+			return buf, v, nil
+		}
+	}
+	return buf, 0, errors.New("EOF decoding varint")
+}
+
+// begins to read a struct written by Encoder.Encode, and checks its magic number (or its prefix bytes
+// in the amino-compatible mode) against the alias. Then its fields can be read by nextField.
+func (dec *Decoder) beginStruct(magicNum uint32, aminoCompatible bool, alias string) error {
+	length, err := dec.readLength()
+	if err != nil {
+		return err
+	}
+	var header []byte
+	var found uint32
+	if aminoCompatible {
+		if length < 4 {
+			return errors.New("Prefix Bytes Too Short")
+		}
+		if header, err = dec.read(nil, 4); err != nil {
+			return err
+		}
+		found = binary.BigEndian.Uint32(header)
+		dec.remaining = length - 4
+	} else {
+		var tag, size uint64
+		if header, tag, err = dec.readVarint(nil); err != nil {
+			return err
+		}
+		if header, size, err = dec.readVarint(header); err != nil {
+			return err
+		}
+		if tag&7 != 2 || uint64(len(header)) > length || size != length-uint64(len(header)) {
+			return errors.New("Length Mismatch")
+		}
+		found = uint32(tag >> 3)
+		dec.remaining = size
+	}
+	if found != magicNum {
+		return &ErrTypeMismatch{MagicNum: found, Alias: getAliasOfMagicNum(found), Target: alias}
+	}
+	return nil
+}
+
+// reads the next field of the struct begun by beginStruct, and returns its tag and the bytes after
+// the tag. io.EOF is returned at the end of the struct.
+func (dec *Decoder) nextField() (tag uint64, bz []byte, err error) {
+	if dec.remaining == 0 {
+		return 0, nil, io.EOF
+	}
+	var tagBuf [binary.MaxVarintLen64]byte
+	var tagBz []byte
+	if tagBz, tag, err = dec.readVarint(tagBuf[:0]); err != nil {
+		return
+	}
+	bz = dec.buf[:0]
+	switch tag & 7 {
+	case 0: // varint
+		bz, _, err = dec.readVarint(bz)
+	case 1: // fixed64
+		bz, err = dec.read(bz, 8)
+	case 2: // length-delimited
+		var length uint64
+		if bz, length, err = dec.readVarint(bz); err != nil {
+			return
+		}
+		if length > dec.remaining {
+			err = errors.New("Not enough bytes to read")
+			return
+		}
+		bz, err = dec.read(bz, length)
+	case 5: // fixed32
+		bz, err = dec.read(bz, 4)
+	default:
+		err = fmt.Errorf("Unsupported wire type %d", tag&7)
+	}
+	if err != nil {
+		return
+	}
+	dec.buf = bz
+	if n := uint64(len(tagBz) + len(bz)); n <= dec.remaining {
+		dec.remaining -= n
+	} else {
+		err = errors.New("Length Mismatch")
+	}
+	return
+}
+
+// Non-Interface
+func EncodeSquare(w *[]byte, v Square) {
+	s := &codonSizes{}
+	codonGrow(w, sizeSquare(v, s))
+	encodeSquare(w, v, s)
+}
+func HashSquare(h hash.Hash, v Square) {
+	s := &codonSizes{out: h}
+	sizeSquare(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeSquare(&w, v, s)
+	h.Write(w)
+}
+func encodeSquare(w *[]byte, v Square, s *codonSizes) {
+	codonEncodeVarint(1, w, int64(v.Side))
+} //End of EncodeSquare
+
+func SizeSquare(v Square) int {
+	return sizeSquare(v, nil)
+}
+func sizeSquare(v Square, s *codonSizes) (total int) {
+	total += 1 + codonVarintSize(int64(v.Side))
+	return
+} //End of SizeSquare
+
+func DecodeSquare(bz []byte) (Square, int, error) {
+	return decodeSquare(bz, nil)
+}
+func DecodeSquareNoCopy(bz []byte) (Square, int, error) {
+	return decodeSquare(bz, &codonDecoder{noCopy: true})
+}
+func DecodeSquareWithOptions(bz []byte, opts DecodeOptions) (v Square, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeSquare(bz, d)
+}
+func decodeSquare(bz []byte, d *codonDecoder) (v Square, total int, err error) {
+	var n int
+	if err = d.enter(); err != nil {
+		return
+	}
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Side
+			v.Side = int32(codonDecodeInt32(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		}
+	} // end for
+	d.leave()
+	return v, total, nil
+} //End of DecodeSquare
+
+func PeekSquare_Side(bz []byte) (res int32, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Side
+			res = int32(codonDecodeInt32(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekSquare_Side
+
+func RandSquare(r RandSrc) Square {
+	var v Square
+	v.Side = r.GetInt32()
+	return v
+} //End of RandSquare
+
+func DeepCopySquare(in Square) (out Square) {
+	out.Side = in.Side
+	return
+} //End of DeepCopySquare
+
+func EqualSquare(a, b Square) bool {
+	if a.Side != b.Side {
+		return false
+	}
+	return true
+} //End of EqualSquare
+
+func EncodeJSONSquare(v Square) ([]byte, error) {
+	w := &codonJSONWriter{}
+	encodeJSONSquare(w, &v)
+	return w.result()
+}
+func encodeJSONSquare(w *codonJSONWriter, v *Square) {
+	if m, ok := interface{}(v).(json.Marshaler); ok {
+		w.marshaler(m)
+		return
+	}
+	w.raw("{")
+	w.key("\"Side\":")
+	w.int(int64(v.Side))
+	w.raw("}")
+	// end of v
+} //End of EncodeJSONSquare
+
+func DecodeJSONSquare(bz []byte) (v Square, err error) {
+	d := &codonJSONReader{bz: bz}
+	decodeJSONSquare(d, &v)
+	return v, d.finish()
+}
+func decodeJSONSquare(d *codonJSONReader, v *Square) {
+	if d.null() {
+		*v = Square{}
+		return
+	}
+	if u, ok := interface{}(v).(json.Unmarshaler); ok {
+		d.unmarshaler(u)
+		return
+	}
+	d.beginObject()
+	for d.nextMember() {
+		switch string(d.key) {
+		case "Side":
+			if d.null() {
+				v.Side = 0
+			} else {
+				v.Side = int32(d.int(32))
+			}
+		default:
+			d.skip()
+		} // end switch
+	} // end for
+	// end of v
+} //End of DecodeJSONSquare
+
+// Non-Interface
+func EncodeCircle(w *[]byte, v Circle) {
+	s := &codonSizes{}
+	codonGrow(w, sizeCircle(v, s))
+	encodeCircle(w, v, s)
+}
+func HashCircle(h hash.Hash, v Circle) {
+	s := &codonSizes{out: h}
+	sizeCircle(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeCircle(&w, v, s)
+	h.Write(w)
+}
+func encodeCircle(w *[]byte, v Circle, s *codonSizes) {
+	codonEncodeVarint(1, w, int64(v.Radius))
+} //End of EncodeCircle
+
+func SizeCircle(v Circle) int {
+	return sizeCircle(v, nil)
+}
+func sizeCircle(v Circle, s *codonSizes) (total int) {
+	total += 1 + codonVarintSize(int64(v.Radius))
+	return
+} //End of SizeCircle
+
+func DecodeCircle(bz []byte) (Circle, int, error) {
+	return decodeCircle(bz, nil)
+}
+func DecodeCircleNoCopy(bz []byte) (Circle, int, error) {
+	return decodeCircle(bz, &codonDecoder{noCopy: true})
+}
+func DecodeCircleWithOptions(bz []byte, opts DecodeOptions) (v Circle, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeCircle(bz, d)
+}
+func decodeCircle(bz []byte, d *codonDecoder) (v Circle, total int, err error) {
+	var n int
+	if err = d.enter(); err != nil {
+		return
+	}
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Radius
+			v.Radius = int64(codonDecodeInt64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		}
+	} // end for
+	d.leave()
+	return v, total, nil
+} //End of DecodeCircle
+
+func PeekCircle_Radius(bz []byte) (res int64, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Radius
+			res = int64(codonDecodeInt64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekCircle_Radius
+
+func RandCircle(r RandSrc) Circle {
+	var v Circle
+	v.Radius = r.GetInt64()
+	return v
+} //End of RandCircle
+
+func DeepCopyCircle(in Circle) (out Circle) {
+	out.Radius = in.Radius
+	return
+} //End of DeepCopyCircle
+
+func EqualCircle(a, b Circle) bool {
+	if a.Radius != b.Radius {
+		return false
+	}
+	return true
+} //End of EqualCircle
+
+func EncodeJSONCircle(v Circle) ([]byte, error) {
+	w := &codonJSONWriter{}
+	encodeJSONCircle(w, &v)
+	return w.result()
+}
+func encodeJSONCircle(w *codonJSONWriter, v *Circle) {
+	if m, ok := interface{}(v).(json.Marshaler); ok {
+		w.marshaler(m)
+		return
+	}
+	w.raw("{")
+	w.key("\"Radius\":")
+	w.quotedInt(int64(v.Radius))
+	w.raw("}")
+	// end of v
+} //End of EncodeJSONCircle
+
+func DecodeJSONCircle(bz []byte) (v Circle, err error) {
+	d := &codonJSONReader{bz: bz}
+	decodeJSONCircle(d, &v)
+	return v, d.finish()
+}
+func decodeJSONCircle(d *codonJSONReader, v *Circle) {
+	if d.null() {
+		*v = Circle{}
+		return
+	}
+	if u, ok := interface{}(v).(json.Unmarshaler); ok {
+		d.unmarshaler(u)
+		return
+	}
+	d.beginObject()
+	for d.nextMember() {
+		switch string(d.key) {
+		case "Radius":
+			if d.null() {
+				v.Radius = 0
+			} else {
+				v.Radius = d.quotedInt()
+			}
+		default:
+			d.skip()
+		} // end switch
+	} // end for
+	// end of v
+} //End of DecodeJSONCircle
+
+// Non-Interface
+func EncodeItem(w *[]byte, v Item) {
+	s := &codonSizes{}
+	codonGrow(w, sizeItem(v, s))
+	encodeItem(w, v, s)
+}
+func HashItem(h hash.Hash, v Item) {
+	s := &codonSizes{out: h}
+	sizeItem(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeItem(&w, v, s)
+	h.Write(w)
+}
+func encodeItem(w *[]byte, v Item, s *codonSizes) {
+	codonEncodeString(1, w, v.Name)
+	s.flush(w)
+	codonEncodeVarint(2, w, int64(v.Count))
+} //End of EncodeItem
+
+func SizeItem(v Item) int {
+	return sizeItem(v, nil)
+}
+func sizeItem(v Item, s *codonSizes) (total int) {
+	total += 1 + codonByteSliceSize(len(v.Name))
+	total += 1 + codonVarintSize(int64(v.Count))
+	return
+} //End of SizeItem
+
+func DecodeItem(bz []byte) (Item, int, error) {
+	return decodeItem(bz, nil)
+}
+func DecodeItemNoCopy(bz []byte) (Item, int, error) {
+	return decodeItem(bz, &codonDecoder{noCopy: true})
+}
+func DecodeItemWithOptions(bz []byte, opts DecodeOptions) (v Item, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeItem(bz, d)
+}
+func decodeItem(bz []byte, d *codonDecoder) (v Item, total int, err error) {
+	var n int
+	if err = d.enter(); err != nil {
+		return
+	}
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Name
+			v.Name = string(d.decodeString(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 2: // v.Count
+			v.Count = int64(codonDecodeInt64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		}
+	} // end for
+	d.leave()
+	return v, total, nil
+} //End of DecodeItem
+
+func PeekItem_Name(bz []byte) (res string, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Name
+			res = string(d.decodeString(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekItem_Name
+
+func PeekItem_Count(bz []byte) (res int64, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 2: // v.Count
+			res = int64(codonDecodeInt64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekItem_Count
+
+func RandItem(r RandSrc) Item {
+	var v Item
+	v.Name = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	v.Count = r.GetInt64()
+	return v
+} //End of RandItem
+
+func DeepCopyItem(in Item) (out Item) {
+	out.Name = in.Name
+	out.Count = in.Count
+	return
+} //End of DeepCopyItem
+
+func EqualItem(a, b Item) bool {
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Count != b.Count {
+		return false
+	}
+	return true
+} //End of EqualItem
+
+func EncodeJSONItem(v Item) ([]byte, error) {
+	w := &codonJSONWriter{}
+	encodeJSONItem(w, &v)
+	return w.result()
+}
+func encodeJSONItem(w *codonJSONWriter, v *Item) {
+	if m, ok := interface{}(v).(json.Marshaler); ok {
+		w.marshaler(m)
+		return
+	}
+	w.raw("{")
+	w.key("\"Name\":")
+	w.string(string(v.Name))
+	w.key("\"Count\":")
+	w.quotedInt(int64(v.Count))
+	w.raw("}")
+	// end of v
+} //End of EncodeJSONItem
+
+func DecodeJSONItem(bz []byte) (v Item, err error) {
+	d := &codonJSONReader{bz: bz}
+	decodeJSONItem(d, &v)
+	return v, d.finish()
+}
+func decodeJSONItem(d *codonJSONReader, v *Item) {
+	if d.null() {
+		*v = Item{}
+		return
+	}
+	if u, ok := interface{}(v).(json.Unmarshaler); ok {
+		d.unmarshaler(u)
+		return
+	}
+	d.beginObject()
+	for d.nextMember() {
+		switch string(d.key) {
+		case "Name":
+			if d.null() {
+				v.Name = ""
+			} else {
+				v.Name = d.string()
+			}
+		case "Count":
+			if d.null() {
+				v.Count = 0
+			} else {
+				v.Count = d.quotedInt()
+			}
+		default:
+			d.skip()
+		} // end switch
+	} // end for
+	// end of v
+} //End of DecodeJSONItem
+
+// Non-Interface
+func EncodeRecord(w *[]byte, v Record) {
+	s := &codonSizes{}
+	codonGrow(w, sizeRecord(v, s))
+	encodeRecord(w, v, s)
+}
+func HashRecord(h hash.Hash, v Record) {
+	s := &codonSizes{out: h}
+	sizeRecord(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeRecord(&w, v, s)
+	h.Write(w)
+}
+func encodeRecord(w *[]byte, v Record, s *codonSizes) {
+	codonEncodeUvarint(4, w, uint64(v.ID))
+	codonEncodeString(1, w, v.Title)
+	s.flush(w)
+	codonEncodeByteSlice(2, w, v.Data[:])
+	s.flush(w)
+	for _0 := 0; _0 < len(v.Shapes); _0++ {
+		codonEncodeLength(3, w, s.next())
+		encodeShape(w, v.Shapes[_0], s) // interface_encode
+	}
+	s.flush(w)
+	*w = append(*w, v.XXX_unrecognized...)
+} //End of EncodeRecord
+
+func SizeRecord(v Record) int {
+	return sizeRecord(v, nil)
+}
+func sizeRecord(v Record, s *codonSizes) (total int) {
+	total += 1 + codonUvarintSize(uint64(v.ID))
+	total += 1 + codonByteSliceSize(len(v.Title))
+	total += 1 + codonByteSliceSize(len(v.Data))
+	for _0 := 0; _0 < len(v.Shapes); _0++ {
+		{
+			idx := s.reserve()
+			total += 1 + codonByteSliceSize(s.set(idx, sizeShape(v.Shapes[_0], s)))
+		}
+	}
+	total += len(v.XXX_unrecognized)
+	return
+} //End of SizeRecord
+
+func DecodeRecord(bz []byte) (Record, int, error) {
+	return decodeRecord(bz, nil)
+}
+func DecodeRecordNoCopy(bz []byte) (Record, int, error) {
+	return decodeRecord(bz, &codonDecoder{noCopy: true})
+}
+func DecodeRecordWithOptions(bz []byte, opts DecodeOptions) (v Record, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeRecord(bz, d)
+}
+func decodeRecord(bz []byte, d *codonDecoder) (v Record, total int, err error) {
+	var n int
+	if err = d.enter(); err != nil {
+		return
+	}
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 4: // v.ID
+			v.ID = uint64(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 1: // v.Title
+			v.Title = string(d.decodeString(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 2: // v.Data
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			v.Data = tmpBz
+		case 3: // v.Shapes
+			if err = d.checkSliceLength(len(v.Shapes) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var tmp Shape
+			tmp, n, err = decodeShape(bz[:l], d)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if int(l) != n {
+				err = errors.New("Length Mismatch")
+				return
+			}
+			v.Shapes = append(v.Shapes, tmp)
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			codonWriteUvarint(&v.XXX_unrecognized, tag)
+			v.XXX_unrecognized = append(v.XXX_unrecognized, bz[:n]...)
+			bz = bz[n:]
+			total += n
+		}
+	} // end for
+	d.leave()
+	return v, total, nil
+} //End of DecodeRecord
+
+func PeekRecord_ID(bz []byte) (res uint64, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 4: // v.ID
+			res = uint64(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekRecord_ID
+
+func PeekRecord_Title(bz []byte) (res string, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Title
+			res = string(d.decodeString(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekRecord_Title
+
+func PeekRecord_Data(bz []byte) (res []uint8, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 2: // v.Data
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			res = tmpBz
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekRecord_Data
+
+func PeekRecord_Shapes(bz []byte) (res []Shape, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 3: // v.Shapes
+			if err = d.checkSliceLength(len(res) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var tmp Shape
+			tmp, n, err = decodeShape(bz[:l], d)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if int(l) != n {
+				err = errors.New("Length Mismatch")
+				return
+			}
+			res = append(res, tmp)
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekRecord_Shapes
+
+func RandRecord(r RandSrc) Record {
+	var length int
+	var v Record
+	v.ID = r.GetUint64()
+	v.Title = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.Data = r.GetBytes(length)
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	if length == 0 {
+		v.Shapes = nil
+	} else {
+		v.Shapes = make([]Shape, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of interface
+		v.Shapes[_0] = RandShape(r)
+	}
+	return v
+} //End of RandRecord
+
+func DeepCopyRecord(in Record) (out Record) {
+	var length int
+	out.ID = in.ID
+	length = len(in.XXX_unrecognized)
+	if length == 0 {
+		out.XXX_unrecognized = nil
+	} else {
+		out.XXX_unrecognized = make([]uint8, length)
+	}
+	copy(out.XXX_unrecognized[:], in.XXX_unrecognized[:])
+	out.Title = in.Title
+	length = len(in.Data)
+	if length == 0 {
+		out.Data = nil
+	} else {
+		out.Data = make([]uint8, length)
+	}
+	copy(out.Data[:], in.Data[:])
+	length = len(in.Shapes)
+	if length == 0 {
+		out.Shapes = nil
+	} else {
+		out.Shapes = make([]Shape, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of interface
+		out.Shapes[_0] = DeepCopyShape(in.Shapes[_0])
+	}
+	return
+} //End of DeepCopyRecord
+
+func EqualRecord(a, b Record) bool {
+	if a.ID != b.ID {
+		return false
+	}
+	if !bytes.Equal(a.XXX_unrecognized, b.XXX_unrecognized) {
+		return false
+	}
+	if a.Title != b.Title {
+		return false
+	}
+	if !bytes.Equal(a.Data, b.Data) {
+		return false
+	}
+	if len(a.Shapes) != len(b.Shapes) {
+		return false
+	}
+	for _0 := range a.Shapes { //slice of interface
+		if !EqualShape(a.Shapes[_0], b.Shapes[_0]) {
+			return false
+		}
+	}
+	return true
+} //End of EqualRecord
+
+func EncodeJSONRecord(v Record) ([]byte, error) {
+	w := &codonJSONWriter{}
+	encodeJSONRecord(w, &v)
+	return w.result()
+}
+func encodeJSONRecord(w *codonJSONWriter, v *Record) {
+	if m, ok := interface{}(v).(json.Marshaler); ok {
+		w.marshaler(m)
+		return
+	}
+	w.raw("{")
+	w.key("\"ID\":")
+	w.quotedUint(uint64(v.ID))
+	w.key("\"Title\":")
+	w.string(string(v.Title))
+	w.key("\"Data\":")
+	w.bytes(v.Data)
+	w.key("\"Shapes\":")
+	if v.Shapes == nil {
+		w.raw("null")
+	} else {
+		w.raw("[")
+		for _0 := range v.Shapes {
+			w.comma('[')
+			encodeJSONShape(w, v.Shapes[_0])
+		}
+		w.raw("]")
+	}
+	w.raw("}")
+	// end of v
+} //End of EncodeJSONRecord
+
+func DecodeJSONRecord(bz []byte) (v Record, err error) {
+	d := &codonJSONReader{bz: bz}
+	decodeJSONRecord(d, &v)
+	return v, d.finish()
+}
+func decodeJSONRecord(d *codonJSONReader, v *Record) {
+	if d.null() {
+		*v = Record{}
+		return
+	}
+	if u, ok := interface{}(v).(json.Unmarshaler); ok {
+		d.unmarshaler(u)
+		return
+	}
+	d.beginObject()
+	for d.nextMember() {
+		switch string(d.key) {
+		case "ID":
+			if d.null() {
+				v.ID = 0
+			} else {
+				v.ID = d.quotedUint()
+			}
+		case "Title":
+			if d.null() {
+				v.Title = ""
+			} else {
+				v.Title = d.string()
+			}
+		case "Data":
+			if d.null() {
+				v.Data = nil
+			} else {
+				v.Data = d.bytes()
+			}
+		case "Shapes":
+			if d.null() {
+				v.Shapes = nil
+			} else {
+				v.Shapes = nil
+				d.beginArray()
+				for d.nextElem() {
+					var tmp_0 Shape
+					decodeJSONShape(d, &tmp_0)
+					v.Shapes = append(v.Shapes, tmp_0)
+				}
+			}
+		default:
+			d.skip()
+		} // end switch
+	} // end for
+	// end of v
+} //End of DecodeJSONRecord
+
+func (dec *Decoder) DecodeEachRecord(fn func(field string, elem interface{}) error) (v Record, err error) {
+	if err = dec.beginStruct(517113075, false, "Record"); err != nil {
+		return
+	}
+	d := &codonDecoder{opts: dec.DecodeOptions}
+	if err = d.enter(); err != nil {
+		return
+	}
+	var n, total int
+	counts := make(map[string]int)
+	for {
+		tag, bz, e := dec.nextField()
+		if e == io.EOF {
+			break
+		}
+		if e != nil {
+			err = e
+			return
+		}
+		switch tag >> 3 {
+		case 4: // v.ID
+			v.ID = uint64(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 1: // v.Title
+			v.Title = string(d.decodeString(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 2: // v.Data
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			v.Data = tmpBz
+		case 3: // v.Shapes
+			var elems []Shape
+			if err = d.checkSliceLength(len(elems) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var tmp Shape
+			tmp, n, err = decodeShape(bz[:l], d)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if int(l) != n {
+				err = errors.New("Length Mismatch")
+				return
+			}
+			elems = append(elems, tmp)
+			for _, elem := range elems {
+				counts["Shapes"]++
+				if err = d.checkSliceLength(counts["Shapes"]); err != nil {
+					return
+				}
+				if err = fn("Shapes", elem); err != nil {
+					return
+				}
+			}
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			codonWriteUvarint(&v.XXX_unrecognized, tag)
+			v.XXX_unrecognized = append(v.XXX_unrecognized, bz[:n]...)
+			bz = bz[n:]
+			total += n
+		}
+	} // end for
+	_ = total
+	d.leave()
+	return v, nil
+} //End of DecodeEachRecord
+
+// Non-Interface
+func EncodeRecordV1(w *[]byte, v RecordV1) {
+	s := &codonSizes{}
+	codonGrow(w, sizeRecordV1(v, s))
+	encodeRecordV1(w, v, s)
+}
+func HashRecordV1(h hash.Hash, v RecordV1) {
+	s := &codonSizes{out: h}
+	sizeRecordV1(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeRecordV1(&w, v, s)
+	h.Write(w)
+}
+func encodeRecordV1(w *[]byte, v RecordV1, s *codonSizes) {
+	codonEncodeString(1, w, v.Title)
+	s.flush(w)
+	*w = append(*w, v.XXX_unrecognized...)
+} //End of EncodeRecordV1
+
+func SizeRecordV1(v RecordV1) int {
+	return sizeRecordV1(v, nil)
+}
+func sizeRecordV1(v RecordV1, s *codonSizes) (total int) {
+	total += 1 + codonByteSliceSize(len(v.Title))
+	total += len(v.XXX_unrecognized)
+	return
+} //End of SizeRecordV1
+
+func DecodeRecordV1(bz []byte) (RecordV1, int, error) {
+	return decodeRecordV1(bz, nil)
+}
+func DecodeRecordV1NoCopy(bz []byte) (RecordV1, int, error) {
+	return decodeRecordV1(bz, &codonDecoder{noCopy: true})
+}
+func DecodeRecordV1WithOptions(bz []byte, opts DecodeOptions) (v RecordV1, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeRecordV1(bz, d)
+}
+func decodeRecordV1(bz []byte, d *codonDecoder) (v RecordV1, total int, err error) {
+	var n int
+	if err = d.enter(); err != nil {
+		return
+	}
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Title
+			v.Title = string(d.decodeString(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			codonWriteUvarint(&v.XXX_unrecognized, tag)
+			v.XXX_unrecognized = append(v.XXX_unrecognized, bz[:n]...)
+			bz = bz[n:]
+			total += n
+		}
+	} // end for
+	d.leave()
+	return v, total, nil
+} //End of DecodeRecordV1
+
+func PeekRecordV1_Title(bz []byte) (res string, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Title
+			res = string(d.decodeString(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekRecordV1_Title
+
+func RandRecordV1(r RandSrc) RecordV1 {
+	var v RecordV1
+	v.Title = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	return v
+} //End of RandRecordV1
+
+func DeepCopyRecordV1(in RecordV1) (out RecordV1) {
+	var length int
+	out.Title = in.Title
+	length = len(in.XXX_unrecognized)
+	if length == 0 {
+		out.XXX_unrecognized = nil
+	} else {
+		out.XXX_unrecognized = make([]uint8, length)
+	}
+	copy(out.XXX_unrecognized[:], in.XXX_unrecognized[:])
+	return
+} //End of DeepCopyRecordV1
+
+func EqualRecordV1(a, b RecordV1) bool {
+	if a.Title != b.Title {
+		return false
+	}
+	if !bytes.Equal(a.XXX_unrecognized, b.XXX_unrecognized) {
+		return false
+	}
+	return true
+} //End of EqualRecordV1
+
+func EncodeJSONRecordV1(v RecordV1) ([]byte, error) {
+	w := &codonJSONWriter{}
+	encodeJSONRecordV1(w, &v)
+	return w.result()
+}
+func encodeJSONRecordV1(w *codonJSONWriter, v *RecordV1) {
+	if m, ok := interface{}(v).(json.Marshaler); ok {
+		w.marshaler(m)
+		return
+	}
+	w.raw("{")
+	w.key("\"Title\":")
+	w.string(string(v.Title))
+	w.raw("}")
+	// end of v
+} //End of EncodeJSONRecordV1
+
+func DecodeJSONRecordV1(bz []byte) (v RecordV1, err error) {
+	d := &codonJSONReader{bz: bz}
+	decodeJSONRecordV1(d, &v)
+	return v, d.finish()
+}
+func decodeJSONRecordV1(d *codonJSONReader, v *RecordV1) {
+	if d.null() {
+		*v = RecordV1{}
+		return
+	}
+	if u, ok := interface{}(v).(json.Unmarshaler); ok {
+		d.unmarshaler(u)
+		return
+	}
+	d.beginObject()
+	for d.nextMember() {
+		switch string(d.key) {
+		case "Title":
+			if d.null() {
+				v.Title = ""
+			} else {
+				v.Title = d.string()
+			}
+		default:
+			d.skip()
+		} // end switch
+	} // end for
+	// end of v
+} //End of DecodeJSONRecordV1
+
+// Non-Interface
+func EncodeNote(w *[]byte, v Note) {
+	s := &codonSizes{}
+	codonGrow(w, sizeNote(v, s))
+	encodeNote(w, v, s)
+}
+func HashNote(h hash.Hash, v Note) {
+	s := &codonSizes{out: h}
+	sizeNote(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeNote(&w, v, s)
+	h.Write(w)
+}
+func encodeNote(w *[]byte, v Note, s *codonSizes) {
+	codonEncodeString(1, w, v.Title)
+	s.flush(w)
+	codonEncodeString(2, w, v.Body)
+	s.flush(w)
+	*w = append(*w, v.XXX_unrecognized...)
+} //End of EncodeNote
+
+func SizeNote(v Note) int {
+	return sizeNote(v, nil)
+}
+func sizeNote(v Note, s *codonSizes) (total int) {
+	total += 1 + codonByteSliceSize(len(v.Title))
+	total += 1 + codonByteSliceSize(len(v.Body))
+	total += len(v.XXX_unrecognized)
+	return
+} //End of SizeNote
+
+func DecodeNote(bz []byte) (Note, int, error) {
+	return decodeNote(bz, nil)
+}
+func DecodeNoteNoCopy(bz []byte) (Note, int, error) {
+	return decodeNote(bz, &codonDecoder{noCopy: true})
+}
+func DecodeNoteWithOptions(bz []byte, opts DecodeOptions) (v Note, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeNote(bz, d)
+}
+func decodeNote(bz []byte, d *codonDecoder) (v Note, total int, err error) {
+	var n int
+	if err = d.enter(); err != nil {
+		return
+	}
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Title
+			v.Title = string(d.decodeString(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 2: // v.Body
+			v.Body = string(d.decodeString(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			codonWriteUvarint(&v.XXX_unrecognized, tag)
+			v.XXX_unrecognized = append(v.XXX_unrecognized, bz[:n]...)
+			bz = bz[n:]
+			total += n
+		}
+	} // end for
+	d.leave()
+	return v, total, nil
+} //End of DecodeNote
+
+func PeekNote_Title(bz []byte) (res string, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Title
+			res = string(d.decodeString(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekNote_Title
+
+func PeekNote_Body(bz []byte) (res string, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 2: // v.Body
+			res = string(d.decodeString(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekNote_Body
+
+func RandNote(r RandSrc) Note {
+	var v Note
+	v.Title = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	v.Body = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	return v
+} //End of RandNote
+
+func DeepCopyNote(in Note) (out Note) {
+	var length int
+	out.Title = in.Title
+	length = len(in.XXX_unrecognized)
+	if length == 0 {
+		out.XXX_unrecognized = nil
+	} else {
+		out.XXX_unrecognized = make([]uint8, length)
+	}
+	copy(out.XXX_unrecognized[:], in.XXX_unrecognized[:])
+	out.Body = in.Body
+	return
+} //End of DeepCopyNote
+
+func EqualNote(a, b Note) bool {
+	if a.Title != b.Title {
+		return false
+	}
+	if !bytes.Equal(a.XXX_unrecognized, b.XXX_unrecognized) {
+		return false
+	}
+	if a.Body != b.Body {
+		return false
+	}
+	return true
+} //End of EqualNote
+
+func EncodeJSONNote(v Note) ([]byte, error) {
+	w := &codonJSONWriter{}
+	encodeJSONNote(w, &v)
+	return w.result()
+}
+func encodeJSONNote(w *codonJSONWriter, v *Note) {
+	if m, ok := interface{}(v).(json.Marshaler); ok {
+		w.marshaler(m)
+		return
+	}
+	w.raw("{")
+	w.key("\"Title\":")
+	w.string(string(v.Title))
+	w.key("\"Body\":")
+	w.string(string(v.Body))
+	w.raw("}")
+	// end of v
+} //End of EncodeJSONNote
+
+func DecodeJSONNote(bz []byte) (v Note, err error) {
+	d := &codonJSONReader{bz: bz}
+	decodeJSONNote(d, &v)
+	return v, d.finish()
+}
+func decodeJSONNote(d *codonJSONReader, v *Note) {
+	if d.null() {
+		*v = Note{}
+		return
+	}
+	if u, ok := interface{}(v).(json.Unmarshaler); ok {
+		d.unmarshaler(u)
+		return
+	}
+	d.beginObject()
+	for d.nextMember() {
+		switch string(d.key) {
+		case "Title":
+			if d.null() {
+				v.Title = ""
+			} else {
+				v.Title = d.string()
+			}
+		case "Body":
+			if d.null() {
+				v.Body = ""
+			} else {
+				v.Body = d.string()
+			}
+		default:
+			d.skip()
+		} // end switch
+	} // end for
+	// end of v
+} //End of DecodeJSONNote
+
+// Non-Interface
+func EncodeNoteV1(w *[]byte, v NoteV1) {
+	s := &codonSizes{}
+	codonGrow(w, sizeNoteV1(v, s))
+	encodeNoteV1(w, v, s)
+}
+func HashNoteV1(h hash.Hash, v NoteV1) {
+	s := &codonSizes{out: h}
+	sizeNoteV1(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeNoteV1(&w, v, s)
+	h.Write(w)
+}
+func encodeNoteV1(w *[]byte, v NoteV1, s *codonSizes) {
+	codonEncodeString(1, w, v.Title)
+	s.flush(w)
+	*w = append(*w, v.XXX_unrecognized...)
+} //End of EncodeNoteV1
+
+func SizeNoteV1(v NoteV1) int {
+	return sizeNoteV1(v, nil)
+}
+func sizeNoteV1(v NoteV1, s *codonSizes) (total int) {
+	total += 1 + codonByteSliceSize(len(v.Title))
+	total += len(v.XXX_unrecognized)
+	return
+} //End of SizeNoteV1
+
+func DecodeNoteV1(bz []byte) (NoteV1, int, error) {
+	return decodeNoteV1(bz, nil)
+}
+func DecodeNoteV1NoCopy(bz []byte) (NoteV1, int, error) {
+	return decodeNoteV1(bz, &codonDecoder{noCopy: true})
+}
+func DecodeNoteV1WithOptions(bz []byte, opts DecodeOptions) (v NoteV1, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeNoteV1(bz, d)
+}
+func decodeNoteV1(bz []byte, d *codonDecoder) (v NoteV1, total int, err error) {
+	var n int
+	if err = d.enter(); err != nil {
+		return
+	}
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Title
+			v.Title = string(d.decodeString(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			codonWriteUvarint(&v.XXX_unrecognized, tag)
+			v.XXX_unrecognized = append(v.XXX_unrecognized, bz[:n]...)
+			bz = bz[n:]
+			total += n
+		}
+	} // end for
+	d.leave()
+	return v, total, nil
+} //End of DecodeNoteV1
+
+func PeekNoteV1_Title(bz []byte) (res string, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Title
+			res = string(d.decodeString(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekNoteV1_Title
+
+func RandNoteV1(r RandSrc) NoteV1 {
+	var v NoteV1
+	v.Title = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	return v
+} //End of RandNoteV1
+
+func DeepCopyNoteV1(in NoteV1) (out NoteV1) {
+	var length int
+	out.Title = in.Title
+	length = len(in.XXX_unrecognized)
+	if length == 0 {
+		out.XXX_unrecognized = nil
+	} else {
+		out.XXX_unrecognized = make([]uint8, length)
+	}
+	copy(out.XXX_unrecognized[:], in.XXX_unrecognized[:])
+	return
+} //End of DeepCopyNoteV1
+
+func EqualNoteV1(a, b NoteV1) bool {
+	if a.Title != b.Title {
+		return false
+	}
+	if !bytes.Equal(a.XXX_unrecognized, b.XXX_unrecognized) {
+		return false
+	}
+	return true
+} //End of EqualNoteV1
+
+func EncodeJSONNoteV1(v NoteV1) ([]byte, error) {
+	w := &codonJSONWriter{}
+	encodeJSONNoteV1(w, &v)
+	return w.result()
+}
+func encodeJSONNoteV1(w *codonJSONWriter, v *NoteV1) {
+	if m, ok := interface{}(v).(json.Marshaler); ok {
+		w.marshaler(m)
+		return
+	}
+	w.raw("{")
+	w.key("\"Title\":")
+	w.string(string(v.Title))
+	w.raw("}")
+	// end of v
+} //End of EncodeJSONNoteV1
+
+func DecodeJSONNoteV1(bz []byte) (v NoteV1, err error) {
+	d := &codonJSONReader{bz: bz}
+	decodeJSONNoteV1(d, &v)
+	return v, d.finish()
+}
+func decodeJSONNoteV1(d *codonJSONReader, v *NoteV1) {
+	if d.null() {
+		*v = NoteV1{}
+		return
+	}
+	if u, ok := interface{}(v).(json.Unmarshaler); ok {
+		d.unmarshaler(u)
+		return
+	}
+	d.beginObject()
+	for d.nextMember() {
+		switch string(d.key) {
+		case "Title":
+			if d.null() {
+				v.Title = ""
+			} else {
+				v.Title = d.string()
+			}
+		default:
+			d.skip()
+		} // end switch
+	} // end for
+	// end of v
+} //End of DecodeJSONNoteV1
+
+// Non-Interface
+func EncodeCatalog(w *[]byte, v Catalog) {
+	s := &codonSizes{}
+	codonGrow(w, sizeCatalog(v, s))
+	encodeCatalog(w, v, s)
+}
+func HashCatalog(h hash.Hash, v Catalog) {
+	s := &codonSizes{out: h}
+	sizeCatalog(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeCatalog(&w, v, s)
+	h.Write(w)
+}
+func encodeCatalog(w *[]byte, v Catalog, s *codonSizes) {
+	{ // map v.Items
+		keys_0 := make([]string, 0, len(v.Items))
+		for key_0 := range v.Items {
+			keys_0 = append(keys_0, key_0)
+		}
+		codonSortKeys(keys_0, func(i, j int) bool { return keys_0[i] < keys_0[j] })
+		for _, key_0 := range keys_0 {
+			codonEncodeLength(1, w, s.next())
+			codonEncodeString(1, w, key_0)
+			codonEncodeLength(2, w, s.next())
+			codonEncodeString(1, w, v.Items[key_0].Name)
+			s.flush(w)
+			codonEncodeVarint(2, w, int64(v.Items[key_0].Count))
+			// end of v.Items[key_0]
+		}
+	} // end of v.Items
+	s.flush(w)
+	{ // map v.Ptrs
+		keys_0 := make([]int32, 0, len(v.Ptrs))
+		for key_0 := range v.Ptrs {
+			keys_0 = append(keys_0, key_0)
+		}
+		codonSortKeys(keys_0, func(i, j int) bool { return keys_0[i] < keys_0[j] })
+		for _, key_0 := range keys_0 {
+			codonEncodeLength(2, w, s.next())
+			codonEncodeVarint(1, w, int64(key_0))
+			if v.Ptrs[key_0] != nil {
+				codonEncodeLength(2, w, s.next())
+				codonEncodeString(1, w, v.Ptrs[key_0].Name)
+				s.flush(w)
+				codonEncodeVarint(2, w, int64(v.Ptrs[key_0].Count))
+				// end of v.Ptrs[key_0]
+			} // end of nilable v.Ptrs[key_0]
+		}
+	} // end of v.Ptrs
+	s.flush(w)
+	{ // map v.Shapes
+		keys_0 := make([]uint64, 0, len(v.Shapes))
+		for key_0 := range v.Shapes {
+			keys_0 = append(keys_0, key_0)
+		}
+		codonSortKeys(keys_0, func(i, j int) bool { return keys_0[i] < keys_0[j] })
+		for _, key_0 := range keys_0 {
+			codonEncodeLength(3, w, s.next())
+			codonEncodeUvarint(1, w, uint64(key_0))
+			if v.Shapes[key_0] != nil {
+				codonEncodeLength(2, w, s.next())
+				encodeShape(w, v.Shapes[key_0], s) // interface_encode
+			} // end of nilable v.Shapes[key_0]
+		}
+	} // end of v.Shapes
+	s.flush(w)
+	codonEncodeInt8(4, w, v.Small)
+	if len(v.Deltas) != 0 {
+		codonEncodeLength(5, w, s.next())
+		for _0 := 0; _0 < len(v.Deltas); _0++ {
+			codonWriteVarint(w, int64(v.Deltas[_0]))
+		}
+	} // end of packed v.Deltas
+	s.flush(w)
+} //End of EncodeCatalog
+
+func SizeCatalog(v Catalog) int {
+	return sizeCatalog(v, nil)
+}
+func sizeCatalog(v Catalog, s *codonSizes) (total int) {
+	{ // map v.Items
+		keys_0 := make([]string, 0, len(v.Items))
+		for key_0 := range v.Items {
+			keys_0 = append(keys_0, key_0)
+		}
+		codonSortKeys(keys_0, func(i, j int) bool { return keys_0[i] < keys_0[j] })
+		for _, key_0 := range keys_0 {
+			{
+				idx := s.reserve()
+				total += 1 + codonByteSliceSize(s.set(idx, func() (total int) {
+					total += 1 + codonByteSliceSize(len(key_0))
+					{
+						idx := s.reserve()
+						total += 1 + codonByteSliceSize(s.set(idx, sizeItem(v.Items[key_0], s)))
+					}
+					return
+				}()))
+			}
+		}
+	} // end of v.Items
+	{ // map v.Ptrs
+		keys_0 := make([]int32, 0, len(v.Ptrs))
+		for key_0 := range v.Ptrs {
+			keys_0 = append(keys_0, key_0)
+		}
+		codonSortKeys(keys_0, func(i, j int) bool { return keys_0[i] < keys_0[j] })
+		for _, key_0 := range keys_0 {
+			{
+				idx := s.reserve()
+				total += 1 + codonByteSliceSize(s.set(idx, func() (total int) {
+					total += 1 + codonVarintSize(int64(key_0))
+					if v.Ptrs[key_0] != nil {
+						{
+							idx := s.reserve()
+							total += 1 + codonByteSliceSize(s.set(idx, sizeItem(*(v.Ptrs[key_0]), s)))
+						}
+					} // end of nilable v.Ptrs[key_0]
+					return
+				}()))
+			}
+		}
+	} // end of v.Ptrs
+	{ // map v.Shapes
+		keys_0 := make([]uint64, 0, len(v.Shapes))
+		for key_0 := range v.Shapes {
+			keys_0 = append(keys_0, key_0)
+		}
+		codonSortKeys(keys_0, func(i, j int) bool { return keys_0[i] < keys_0[j] })
+		for _, key_0 := range keys_0 {
+			{
+				idx := s.reserve()
+				total += 1 + codonByteSliceSize(s.set(idx, func() (total int) {
+					total += 1 + codonUvarintSize(uint64(key_0))
+					if v.Shapes[key_0] != nil {
+						{
+							idx := s.reserve()
+							total += 1 + codonByteSliceSize(s.set(idx, sizeShape(v.Shapes[key_0], s)))
+						}
+					} // end of nilable v.Shapes[key_0]
+					return
+				}()))
+			}
+		}
+	} // end of v.Shapes
+	total += 1 + codonVarintSize(int64(v.Small))
+	if len(v.Deltas) != 0 {
+		{
+			idx := s.reserve()
+			total += 1 + codonByteSliceSize(s.set(idx, func() (total int) {
+				for _0 := 0; _0 < len(v.Deltas); _0++ {
+					total += codonVarintSize(int64(v.Deltas[_0]))
+				}
+				return
+			}()))
+		}
+	}
+	return
+} //End of SizeCatalog
+
+func DecodeCatalog(bz []byte) (Catalog, int, error) {
+	return decodeCatalog(bz, nil)
+}
+func DecodeCatalogNoCopy(bz []byte) (Catalog, int, error) {
+	return decodeCatalog(bz, &codonDecoder{noCopy: true})
+}
+func DecodeCatalogWithOptions(bz []byte, opts DecodeOptions) (v Catalog, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeCatalog(bz, d)
+}
+func decodeCatalog(bz []byte, d *codonDecoder) (v Catalog, total int, err error) {
+	var n int
+	if err = d.enter(); err != nil {
+		return
+	}
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Items
+			if err = d.checkSliceLength(len(v.Items) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var key_0 string
+			var value_0 Item
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // key_0
+						key_0 = string(d.decodeString(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					case 2: // value_0
+						l := codonDecodeUint64(bz, &n, &err)
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						if l > uint64(len(bz)) {
+							err = errors.New("Length Too Large")
+							return
+						}
+						if err = d.enter(); err != nil {
+							return
+						}
+						func(bz []byte) {
+							for len(bz) != 0 {
+								tag := codonDecodeUint64(bz, &n, &err)
+								if err != nil {
+									return
+								}
+								bz = bz[n:]
+								total += n
+								switch tag >> 3 {
+								case 1: // value_0.Name
+									value_0.Name = string(d.decodeString(bz, &n, &err))
+									if err != nil {
+										return
+									}
+									bz = bz[n:]
+									total += n
+								case 2: // value_0.Count
+									value_0.Count = int64(codonDecodeInt64(bz, &n, &err))
+									if err != nil {
+										return
+									}
+									bz = bz[n:]
+									total += n
+								default:
+									n, err = codonSkipField(bz, int(tag&7))
+									if err != nil {
+										return
+									}
+									bz = bz[n:]
+									total += n
+								}
+							} // end for
+						}(bz[:l]) // end func
+						if err != nil {
+							return
+						}
+						d.leave()
+						bz = bz[l:]
+						n += int(l)
+					default:
+						n, err = codonSkipField(bz, int(tag&7))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			bz = bz[l:]
+			n += int(l)
+			if v.Items == nil {
+				v.Items = make(map[string]Item)
+			}
+			v.Items[key_0] = value_0
+		case 2: // v.Ptrs
+			if err = d.checkSliceLength(len(v.Ptrs) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var key_0 int32
+			var value_0 *Item
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // key_0
+						key_0 = int32(codonDecodeInt32(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					case 2: // value_0
+						value_0 = &Item{}
+						l := codonDecodeUint64(bz, &n, &err)
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						if l > uint64(len(bz)) {
+							err = errors.New("Length Too Large")
+							return
+						}
+						if err = d.enter(); err != nil {
+							return
+						}
+						func(bz []byte) {
+							for len(bz) != 0 {
+								tag := codonDecodeUint64(bz, &n, &err)
+								if err != nil {
+									return
+								}
+								bz = bz[n:]
+								total += n
+								switch tag >> 3 {
+								case 1: // value_0.Name
+									value_0.Name = string(d.decodeString(bz, &n, &err))
+									if err != nil {
+										return
+									}
+									bz = bz[n:]
+									total += n
+								case 2: // value_0.Count
+									value_0.Count = int64(codonDecodeInt64(bz, &n, &err))
+									if err != nil {
+										return
+									}
+									bz = bz[n:]
+									total += n
+								default:
+									n, err = codonSkipField(bz, int(tag&7))
+									if err != nil {
+										return
+									}
+									bz = bz[n:]
+									total += n
+								}
+							} // end for
+						}(bz[:l]) // end func
+						if err != nil {
+							return
+						}
+						d.leave()
+						bz = bz[l:]
+						n += int(l)
+					default:
+						n, err = codonSkipField(bz, int(tag&7))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			bz = bz[l:]
+			n += int(l)
+			if v.Ptrs == nil {
+				v.Ptrs = make(map[int32]*Item)
+			}
+			v.Ptrs[key_0] = value_0
+		case 3: // v.Shapes
+			if err = d.checkSliceLength(len(v.Shapes) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var key_0 uint64
+			var value_0 Shape
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // key_0
+						key_0 = uint64(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					case 2: // value_0
+						l := codonDecodeUint64(bz, &n, &err)
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						if l > uint64(len(bz)) {
+							err = errors.New("Length Too Large")
+							return
+						}
+						value_0, n, err = decodeShape(bz[:l], d)
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n // interface_decode
+						if int(l) != n {
+							err = errors.New("Length Mismatch")
+							return
+						}
+					default:
+						n, err = codonSkipField(bz, int(tag&7))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			bz = bz[l:]
+			n += int(l)
+			if v.Shapes == nil {
+				v.Shapes = make(map[uint64]Shape)
+			}
+			v.Shapes[key_0] = value_0
+		case 4: // v.Small
+			v.Small = int8(codonDecodeInt8(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 5: // v.Deltas
+			if tag&7 == 2 { // packed v.Deltas
+				l := codonDecodeUint64(bz, &n, &err)
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				if l > uint64(len(bz)) {
+					err = errors.New("Length Too Large")
+					return
+				}
+				func(bz []byte) {
+					for len(bz) != 0 {
+						if err = d.checkSliceLength(len(v.Deltas) + 1); err != nil {
+							return
+						}
+						var tmp int16
+						tmp = int16(codonDecodeInt16(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						v.Deltas = append(v.Deltas, tmp)
+					}
+				}(bz[:l]) // end func
+				if err != nil {
+					return
+				}
+				bz = bz[l:]
+			} else {
+				if err = d.checkSliceLength(len(v.Deltas) + 1); err != nil {
+					return
+				}
+				var tmp int16
+				tmp = int16(codonDecodeInt16(bz, &n, &err))
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				v.Deltas = append(v.Deltas, tmp)
+			} // end of packed v.Deltas
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		}
+	} // end for
+	d.leave()
+	return v, total, nil
+} //End of DecodeCatalog
+
+func PeekCatalog_Items(bz []byte) (res map[string]Item, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Items
+			if err = d.checkSliceLength(len(res) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var key_0 string
+			var value_0 Item
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // key_0
+						key_0 = string(d.decodeString(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					case 2: // value_0
+						l := codonDecodeUint64(bz, &n, &err)
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						if l > uint64(len(bz)) {
+							err = errors.New("Length Too Large")
+							return
+						}
+						if err = d.enter(); err != nil {
+							return
+						}
+						func(bz []byte) {
+							for len(bz) != 0 {
+								tag := codonDecodeUint64(bz, &n, &err)
+								if err != nil {
+									return
+								}
+								bz = bz[n:]
+								total += n
+								switch tag >> 3 {
+								case 1: // value_0.Name
+									value_0.Name = string(d.decodeString(bz, &n, &err))
+									if err != nil {
+										return
+									}
+									bz = bz[n:]
+									total += n
+								case 2: // value_0.Count
+									value_0.Count = int64(codonDecodeInt64(bz, &n, &err))
+									if err != nil {
+										return
+									}
+									bz = bz[n:]
+									total += n
+								default:
+									n, err = codonSkipField(bz, int(tag&7))
+									if err != nil {
+										return
+									}
+									bz = bz[n:]
+									total += n
+								}
+							} // end for
+						}(bz[:l]) // end func
+						if err != nil {
+							return
+						}
+						d.leave()
+						bz = bz[l:]
+						n += int(l)
+					default:
+						n, err = codonSkipField(bz, int(tag&7))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			bz = bz[l:]
+			n += int(l)
+			if res == nil {
+				res = make(map[string]Item)
+			}
+			res[key_0] = value_0
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekCatalog_Items
+
+func PeekCatalog_Ptrs(bz []byte) (res map[int32]*Item, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 2: // v.Ptrs
+			if err = d.checkSliceLength(len(res) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var key_0 int32
+			var value_0 *Item
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // key_0
+						key_0 = int32(codonDecodeInt32(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					case 2: // value_0
+						value_0 = &Item{}
+						l := codonDecodeUint64(bz, &n, &err)
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						if l > uint64(len(bz)) {
+							err = errors.New("Length Too Large")
+							return
+						}
+						if err = d.enter(); err != nil {
+							return
+						}
+						func(bz []byte) {
+							for len(bz) != 0 {
+								tag := codonDecodeUint64(bz, &n, &err)
+								if err != nil {
+									return
+								}
+								bz = bz[n:]
+								total += n
+								switch tag >> 3 {
+								case 1: // value_0.Name
+									value_0.Name = string(d.decodeString(bz, &n, &err))
+									if err != nil {
+										return
+									}
+									bz = bz[n:]
+									total += n
+								case 2: // value_0.Count
+									value_0.Count = int64(codonDecodeInt64(bz, &n, &err))
+									if err != nil {
+										return
+									}
+									bz = bz[n:]
+									total += n
+								default:
+									n, err = codonSkipField(bz, int(tag&7))
+									if err != nil {
+										return
+									}
+									bz = bz[n:]
+									total += n
+								}
+							} // end for
+						}(bz[:l]) // end func
+						if err != nil {
+							return
+						}
+						d.leave()
+						bz = bz[l:]
+						n += int(l)
+					default:
+						n, err = codonSkipField(bz, int(tag&7))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			bz = bz[l:]
+			n += int(l)
+			if res == nil {
+				res = make(map[int32]*Item)
+			}
+			res[key_0] = value_0
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekCatalog_Ptrs
+
+func PeekCatalog_Shapes(bz []byte) (res map[uint64]Shape, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 3: // v.Shapes
+			if err = d.checkSliceLength(len(res) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var key_0 uint64
+			var value_0 Shape
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // key_0
+						key_0 = uint64(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					case 2: // value_0
+						l := codonDecodeUint64(bz, &n, &err)
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						if l > uint64(len(bz)) {
+							err = errors.New("Length Too Large")
+							return
+						}
+						value_0, n, err = decodeShape(bz[:l], d)
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n // interface_decode
+						if int(l) != n {
+							err = errors.New("Length Mismatch")
+							return
+						}
+					default:
+						n, err = codonSkipField(bz, int(tag&7))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			bz = bz[l:]
+			n += int(l)
+			if res == nil {
+				res = make(map[uint64]Shape)
+			}
+			res[key_0] = value_0
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekCatalog_Shapes
+
+func PeekCatalog_Small(bz []byte) (res int8, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 4: // v.Small
+			res = int8(codonDecodeInt8(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekCatalog_Small
+
+func PeekCatalog_Deltas(bz []byte) (res []int16, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 5: // v.Deltas
+			if tag&7 == 2 { // packed res
+				l := codonDecodeUint64(bz, &n, &err)
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				if l > uint64(len(bz)) {
+					err = errors.New("Length Too Large")
+					return
+				}
+				func(bz []byte) {
+					for len(bz) != 0 {
+						if err = d.checkSliceLength(len(res) + 1); err != nil {
+							return
+						}
+						var tmp int16
+						tmp = int16(codonDecodeInt16(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						res = append(res, tmp)
+					}
+				}(bz[:l]) // end func
+				if err != nil {
+					return
+				}
+				bz = bz[l:]
+			} else {
+				if err = d.checkSliceLength(len(res) + 1); err != nil {
+					return
+				}
+				var tmp int16
+				tmp = int16(codonDecodeInt16(bz, &n, &err))
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				res = append(res, tmp)
+			} // end of packed res
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekCatalog_Deltas
+
+func RandCatalog(r RandSrc) Catalog {
+	var length int
+	var v Catalog
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.Items = make(map[string]Item, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //map of struct
+		var key_0 string
+		var value_0 Item
+		key_0 = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+		value_0.Name = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+		value_0.Count = r.GetInt64()
+		// end of value_0
+		v.Items[key_0] = value_0
+	}
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.Ptrs = make(map[int32]*Item, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //map of ptr
+		var key_0 int32
+		var value_0 *Item
+		key_0 = r.GetInt32()
+		if r.GetUint()%4 != 0 {
+			value_0 = &Item{}
+			value_0.Name = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+			value_0.Count = r.GetInt64()
+			// end of value_0
+		} // end of nilable value_0
+		v.Ptrs[key_0] = value_0
+	}
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.Shapes = make(map[uint64]Shape, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //map of interface
+		var key_0 uint64
+		var value_0 Shape
+		key_0 = r.GetUint64()
+		if r.GetUint()%4 != 0 {
+			value_0 = RandShape(r) // interface_decode
+		} // end of nilable value_0
+		v.Shapes[key_0] = value_0
+	}
+	v.Small = r.GetInt8()
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	if length == 0 {
+		v.Deltas = nil
+	} else {
+		v.Deltas = make([]int16, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of int16
+		v.Deltas[_0] = r.GetInt16()
+	}
+	return v
+} //End of RandCatalog
+
+func DeepCopyCatalog(in Catalog) (out Catalog) {
+	var length int
+	length = len(in.Items)
+	if length == 0 {
+		out.Items = nil
+	} else {
+		out.Items = make(map[string]Item, length)
+	}
+	for key_0, inValue_0 := range in.Items {
+		var outValue_0 Item
+		outValue_0.Name = inValue_0.Name
+		outValue_0.Count = inValue_0.Count
+		// end of Value_0
+		out.Items[key_0] = outValue_0
+	}
+	length = len(in.Ptrs)
+	if length == 0 {
+		out.Ptrs = nil
+	} else {
+		out.Ptrs = make(map[int32]*Item, length)
+	}
+	for key_0, inValue_0 := range in.Ptrs {
+		var outValue_0 *Item
+		if inValue_0 != nil {
+			outValue_0 = &Item{}
+			outValue_0.Name = inValue_0.Name
+			outValue_0.Count = inValue_0.Count
+			// end of Value_0
+		} // end of nilable Value_0
+		out.Ptrs[key_0] = outValue_0
+	}
+	length = len(in.Shapes)
+	if length == 0 {
+		out.Shapes = nil
+	} else {
+		out.Shapes = make(map[uint64]Shape, length)
+	}
+	for key_0, inValue_0 := range in.Shapes {
+		var outValue_0 Shape
+		if inValue_0 != nil {
+			outValue_0 = DeepCopyShape(inValue_0)
+		} // end of nilable Value_0
+		out.Shapes[key_0] = outValue_0
+	}
+	out.Small = in.Small
+	length = len(in.Deltas)
+	if length == 0 {
+		out.Deltas = nil
+	} else {
+		out.Deltas = make([]int16, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of int16
+		out.Deltas[_0] = in.Deltas[_0]
+	}
+	return
+} //End of DeepCopyCatalog
+
+func EqualCatalog(a, b Catalog) bool {
+	if len(a.Items) != len(b.Items) {
+		return false
+	}
+	for key_0, aValue_0 := range a.Items {
+		bValue_0, ok := b.Items[key_0]
+		if !ok {
+			return false
+		}
+		if aValue_0.Name != bValue_0.Name {
+			return false
+		}
+		if aValue_0.Count != bValue_0.Count {
+			return false
+		}
+		// end of Value_0
+	}
+	if len(a.Ptrs) != len(b.Ptrs) {
+		return false
+	}
+	for key_0, aValue_0 := range a.Ptrs {
+		bValue_0, ok := b.Ptrs[key_0]
+		if !ok {
+			return false
+		}
+		if (aValue_0 == nil) != (bValue_0 == nil) {
+			return false
+		}
+		if aValue_0 != nil {
+			if aValue_0.Name != bValue_0.Name {
+				return false
+			}
+			if aValue_0.Count != bValue_0.Count {
+				return false
+			}
+			// end of Value_0
+		} // end of nilable Value_0
+	}
+	if len(a.Shapes) != len(b.Shapes) {
+		return false
+	}
+	for key_0, aValue_0 := range a.Shapes {
+		bValue_0, ok := b.Shapes[key_0]
+		if !ok {
+			return false
+		}
+		if !EqualShape(aValue_0, bValue_0) {
+			return false
+		}
+	}
+	if a.Small != b.Small {
+		return false
+	}
+	if len(a.Deltas) != len(b.Deltas) {
+		return false
+	}
+	for _0 := range a.Deltas { //slice of int16
+		if a.Deltas[_0] != b.Deltas[_0] {
+			return false
+		}
+	}
+	return true
+} //End of EqualCatalog
+
+func EncodeJSONCatalog(v Catalog) ([]byte, error) {
+	w := &codonJSONWriter{}
+	encodeJSONCatalog(w, &v)
+	return w.result()
+}
+func encodeJSONCatalog(w *codonJSONWriter, v *Catalog) {
+	if m, ok := interface{}(v).(json.Marshaler); ok {
+		w.marshaler(m)
+		return
+	}
+	w.raw("{")
+	w.key("\"Items\":")
+	{ // map v.Items
+		w.raw("{")
+		keys_0 := make([]string, 0, len(v.Items))
+		for key_0 := range v.Items {
+			keys_0 = append(keys_0, key_0)
+		}
+		codonSortKeys(keys_0, func(i, j int) bool { return keys_0[i] < keys_0[j] })
+		for _, key_0 := range keys_0 {
+			w.mapKey(string(key_0))
+			value_0 := v.Items[key_0]
+			encodeJSONItem(w, &value_0)
+		}
+		w.raw("}")
+	} // end of v.Items
+	w.key("\"Ptrs\":")
+	{ // map v.Ptrs
+		w.raw("{")
+		keys_0 := make([]int32, 0, len(v.Ptrs))
+		for key_0 := range v.Ptrs {
+			keys_0 = append(keys_0, key_0)
+		}
+		codonSortKeys(keys_0, func(i, j int) bool { return keys_0[i] < keys_0[j] })
+		for _, key_0 := range keys_0 {
+			w.mapKey(strconv.FormatInt(int64(key_0), 10))
+			value_0 := v.Ptrs[key_0]
+			if value_0 == nil {
+				w.raw("null")
+			} else {
+				encodeJSONItem(w, value_0)
+			}
+		}
+		w.raw("}")
+	} // end of v.Ptrs
+	w.key("\"Shapes\":")
+	{ // map v.Shapes
+		w.raw("{")
+		keys_0 := make([]uint64, 0, len(v.Shapes))
+		for key_0 := range v.Shapes {
+			keys_0 = append(keys_0, key_0)
+		}
+		codonSortKeys(keys_0, func(i, j int) bool { return keys_0[i] < keys_0[j] })
+		for _, key_0 := range keys_0 {
+			w.mapKey(strconv.FormatUint(uint64(key_0), 10))
+			value_0 := v.Shapes[key_0]
+			encodeJSONShape(w, value_0)
+		}
+		w.raw("}")
+	} // end of v.Shapes
+	w.key("\"Small\":")
+	w.int(int64(v.Small))
+	w.key("\"Deltas\":")
+	if v.Deltas == nil {
+		w.raw("null")
+	} else {
+		w.raw("[")
+		for _0 := range v.Deltas {
+			w.comma('[')
+			w.int(int64(v.Deltas[_0]))
+		}
+		w.raw("]")
+	}
+	w.raw("}")
+	// end of v
+} //End of EncodeJSONCatalog
+
+func DecodeJSONCatalog(bz []byte) (v Catalog, err error) {
+	d := &codonJSONReader{bz: bz}
+	decodeJSONCatalog(d, &v)
+	return v, d.finish()
+}
+func decodeJSONCatalog(d *codonJSONReader, v *Catalog) {
+	if d.null() {
+		*v = Catalog{}
+		return
+	}
+	if u, ok := interface{}(v).(json.Unmarshaler); ok {
+		d.unmarshaler(u)
+		return
+	}
+	d.beginObject()
+	for d.nextMember() {
+		switch string(d.key) {
+		case "Items":
+			if d.null() {
+				v.Items = nil
+			} else {
+				v.Items = make(map[string]Item)
+				d.beginObject()
+				for d.nextMember() {
+					key_0 := string(d.key)
+					var value_0 Item
+					decodeJSONItem(d, &value_0)
+					v.Items[key_0] = value_0
+				} // end of v.Items
+			}
+		case "Ptrs":
+			if d.null() {
+				v.Ptrs = nil
+			} else {
+				v.Ptrs = make(map[int32]*Item)
+				d.beginObject()
+				for d.nextMember() {
+					key_0 := int32(d.keyInt(32))
+					var value_0 *Item
+					if d.null() {
+						value_0 = nil
+					} else {
+						value_0 = new(Item)
+						decodeJSONItem(d, value_0)
+					}
+					v.Ptrs[key_0] = value_0
+				} // end of v.Ptrs
+			}
+		case "Shapes":
+			if d.null() {
+				v.Shapes = nil
+			} else {
+				v.Shapes = make(map[uint64]Shape)
+				d.beginObject()
+				for d.nextMember() {
+					key_0 := d.keyUint(64)
+					var value_0 Shape
+					decodeJSONShape(d, &value_0)
+					v.Shapes[key_0] = value_0
+				} // end of v.Shapes
+			}
+		case "Small":
+			if d.null() {
+				v.Small = 0
+			} else {
+				v.Small = int8(d.int(8))
+			}
+		case "Deltas":
+			if d.null() {
+				v.Deltas = nil
+			} else {
+				v.Deltas = nil
+				d.beginArray()
+				for d.nextElem() {
+					var tmp_0 int16
+					if d.null() {
+						tmp_0 = 0
+					} else {
+						tmp_0 = int16(d.int(16))
+					}
+					v.Deltas = append(v.Deltas, tmp_0)
+				}
+			}
+		default:
+			d.skip()
+		} // end switch
+	} // end for
+	// end of v
+} //End of DecodeJSONCatalog
+
+func (dec *Decoder) DecodeEachCatalog(fn func(field string, elem interface{}) error) (v Catalog, err error) {
+	if err = dec.beginStruct(79200916, false, "Catalog"); err != nil {
+		return
+	}
+	d := &codonDecoder{opts: dec.DecodeOptions}
+	if err = d.enter(); err != nil {
+		return
+	}
+	var n, total int
+	counts := make(map[string]int)
+	for {
+		tag, bz, e := dec.nextField()
+		if e == io.EOF {
+			break
+		}
+		if e != nil {
+			err = e
+			return
+		}
+		switch tag >> 3 {
+		case 1: // v.Items
+			if err = d.checkSliceLength(len(v.Items) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var key_0 string
+			var value_0 Item
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // key_0
+						key_0 = string(d.decodeString(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					case 2: // value_0
+						l := codonDecodeUint64(bz, &n, &err)
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						if l > uint64(len(bz)) {
+							err = errors.New("Length Too Large")
+							return
+						}
+						if err = d.enter(); err != nil {
+							return
+						}
+						func(bz []byte) {
+							for len(bz) != 0 {
+								tag := codonDecodeUint64(bz, &n, &err)
+								if err != nil {
+									return
+								}
+								bz = bz[n:]
+								total += n
+								switch tag >> 3 {
+								case 1: // value_0.Name
+									value_0.Name = string(d.decodeString(bz, &n, &err))
+									if err != nil {
+										return
+									}
+									bz = bz[n:]
+									total += n
+								case 2: // value_0.Count
+									value_0.Count = int64(codonDecodeInt64(bz, &n, &err))
+									if err != nil {
+										return
+									}
+									bz = bz[n:]
+									total += n
+								default:
+									n, err = codonSkipField(bz, int(tag&7))
+									if err != nil {
+										return
+									}
+									bz = bz[n:]
+									total += n
+								}
+							} // end for
+						}(bz[:l]) // end func
+						if err != nil {
+							return
+						}
+						d.leave()
+						bz = bz[l:]
+						n += int(l)
+					default:
+						n, err = codonSkipField(bz, int(tag&7))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			bz = bz[l:]
+			n += int(l)
+			if v.Items == nil {
+				v.Items = make(map[string]Item)
+			}
+			v.Items[key_0] = value_0
+		case 2: // v.Ptrs
+			if err = d.checkSliceLength(len(v.Ptrs) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var key_0 int32
+			var value_0 *Item
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // key_0
+						key_0 = int32(codonDecodeInt32(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					case 2: // value_0
+						value_0 = &Item{}
+						l := codonDecodeUint64(bz, &n, &err)
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						if l > uint64(len(bz)) {
+							err = errors.New("Length Too Large")
+							return
+						}
+						if err = d.enter(); err != nil {
+							return
+						}
+						func(bz []byte) {
+							for len(bz) != 0 {
+								tag := codonDecodeUint64(bz, &n, &err)
+								if err != nil {
+									return
+								}
+								bz = bz[n:]
+								total += n
+								switch tag >> 3 {
+								case 1: // value_0.Name
+									value_0.Name = string(d.decodeString(bz, &n, &err))
+									if err != nil {
+										return
+									}
+									bz = bz[n:]
+									total += n
+								case 2: // value_0.Count
+									value_0.Count = int64(codonDecodeInt64(bz, &n, &err))
+									if err != nil {
+										return
+									}
+									bz = bz[n:]
+									total += n
+								default:
+									n, err = codonSkipField(bz, int(tag&7))
+									if err != nil {
+										return
+									}
+									bz = bz[n:]
+									total += n
+								}
+							} // end for
+						}(bz[:l]) // end func
+						if err != nil {
+							return
+						}
+						d.leave()
+						bz = bz[l:]
+						n += int(l)
+					default:
+						n, err = codonSkipField(bz, int(tag&7))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			bz = bz[l:]
+			n += int(l)
+			if v.Ptrs == nil {
+				v.Ptrs = make(map[int32]*Item)
+			}
+			v.Ptrs[key_0] = value_0
+		case 3: // v.Shapes
+			if err = d.checkSliceLength(len(v.Shapes) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var key_0 uint64
+			var value_0 Shape
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // key_0
+						key_0 = uint64(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					case 2: // value_0
+						l := codonDecodeUint64(bz, &n, &err)
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						if l > uint64(len(bz)) {
+							err = errors.New("Length Too Large")
+							return
+						}
+						value_0, n, err = decodeShape(bz[:l], d)
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n // interface_decode
+						if int(l) != n {
+							err = errors.New("Length Mismatch")
+							return
+						}
+					default:
+						n, err = codonSkipField(bz, int(tag&7))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			bz = bz[l:]
+			n += int(l)
+			if v.Shapes == nil {
+				v.Shapes = make(map[uint64]Shape)
+			}
+			v.Shapes[key_0] = value_0
+		case 4: // v.Small
+			v.Small = int8(codonDecodeInt8(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 5: // v.Deltas
+			var elems []int16
+			if tag&7 == 2 { // packed elems
+				l := codonDecodeUint64(bz, &n, &err)
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				if l > uint64(len(bz)) {
+					err = errors.New("Length Too Large")
+					return
+				}
+				func(bz []byte) {
+					for len(bz) != 0 {
+						if err = d.checkSliceLength(len(elems) + 1); err != nil {
+							return
+						}
+						var tmp int16
+						tmp = int16(codonDecodeInt16(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						elems = append(elems, tmp)
+					}
+				}(bz[:l]) // end func
+				if err != nil {
+					return
+				}
+				bz = bz[l:]
+			} else {
+				if err = d.checkSliceLength(len(elems) + 1); err != nil {
+					return
+				}
+				var tmp int16
+				tmp = int16(codonDecodeInt16(bz, &n, &err))
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				elems = append(elems, tmp)
+			} // end of packed elems
+			for _, elem := range elems {
+				counts["Deltas"]++
+				if err = d.checkSliceLength(counts["Deltas"]); err != nil {
+					return
+				}
+				if err = fn("Deltas", elem); err != nil {
+					return
+				}
+			}
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		}
+	} // end for
+	_ = total
+	d.leave()
+	return v, nil
+} //End of DecodeEachCatalog
+
+// Interface
+func DecodeShape(bz []byte) (Shape, int, error) {
+	return decodeShape(bz, nil)
+}
+func DecodeShapeNoCopy(bz []byte) (Shape, int, error) {
+	return decodeShape(bz, &codonDecoder{noCopy: true})
+}
+func DecodeShapeWithOptions(bz []byte, opts DecodeOptions) (v Shape, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeShape(bz, d)
+}
+func decodeShape(bz []byte, d *codonDecoder) (v Shape, total int, err error) {
+
+	var n int
+	tag := codonDecodeUint64(bz, &n, &err)
+	if err != nil {
+		return
+	}
+	bz = bz[n:]
+	total += n
+	magicNum := uint32(tag >> 3)
+	switch magicNum {
+	case 48907161:
+		l := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		if l > uint64(len(bz)) {
+			err = errors.New("Length Too Large")
+			return
+		}
+		var tmp Circle
+		tmp, n, err = decodeCircle(bz[:l], d)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		if int(l) != n {
+			err = errors.New("Length Mismatch")
+			return
+		}
+		v = &tmp
+		return
+	case 124751808:
+		l := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		if l > uint64(len(bz)) {
+			err = errors.New("Length Too Large")
+			return
+		}
+		var tmp Square
+		tmp, n, err = decodeSquare(bz[:l], d)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		if int(l) != n {
+			err = errors.New("Length Mismatch")
+			return
+		}
+		v = tmp
+		return
+	default:
+		err = &ErrUnknownMagicNum{MagicNum: magicNum, Alias: "Shape"}
+	} // end of switch
+	return
+} // end of decodeShape
+func EncodeShape(w *[]byte, x interface{}) {
+	s := &codonSizes{}
+	codonGrow(w, sizeShape(x, s))
+	encodeShape(w, x, s)
+}
+func HashShape(h hash.Hash, v interface{}) {
+	s := &codonSizes{out: h}
+	sizeShape(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeShape(&w, v, s)
+	h.Write(w)
+}
+func encodeShape(w *[]byte, x interface{}, s *codonSizes) {
+	switch v := x.(type) {
+	case Circle:
+		codonEncodeLength(int(getMagicNum("Circle")), w, s.next())
+		encodeCircle(w, v, s)
+	case *Circle:
+		codonEncodeLength(int(getMagicNum("Circle")), w, s.next())
+		encodeCircle(w, *v, s)
+	case Square:
+		codonEncodeLength(int(getMagicNum("Square")), w, s.next())
+		encodeSquare(w, v, s)
+	case *Square:
+		codonEncodeLength(int(getMagicNum("Square")), w, s.next())
+		encodeSquare(w, *v, s)
+	default:
+		panic(fmt.Sprintf("Unknown Type %v %v\n", x, reflect.TypeOf(x)))
+	} // end of switch
+} // end of func
+func SizeShape(x interface{}) int {
+	return sizeShape(x, nil)
+}
+func sizeShape(x interface{}, s *codonSizes) int {
+	switch v := x.(type) {
+	case Circle:
+		idx := s.reserve()
+		return 5 + codonByteSliceSize(s.set(idx, sizeCircle(v, s)))
+	case *Circle:
+		idx := s.reserve()
+		return 5 + codonByteSliceSize(s.set(idx, sizeCircle(*v, s)))
+	case Square:
+		idx := s.reserve()
+		return 5 + codonByteSliceSize(s.set(idx, sizeSquare(v, s)))
+	case *Square:
+		idx := s.reserve()
+		return 5 + codonByteSliceSize(s.set(idx, sizeSquare(*v, s)))
+	default:
+		panic(fmt.Sprintf("Unknown Type %v %v\n", x, reflect.TypeOf(x)))
+	} // end of switch
+} // end of func
+func RandShape(r RandSrc) Shape {
+	switch r.GetUint() % 2 {
+	case 0:
+		tmp := RandCircle(r)
+		return &tmp
+	case 1:
+		return RandSquare(r)
+	default:
+		panic("Unknown Type.")
+	} // end of switch
+} // end of func
+func DeepCopyShape(x Shape) Shape {
+	switch v := x.(type) {
+	case *Circle:
+		res := DeepCopyCircle(*v)
+		return &res
+	case Square:
+		res := DeepCopySquare(v)
+		return res
+	case *Square:
+		res := DeepCopySquare(*v)
+		return &res
+	default:
+		panic(fmt.Sprintf("Unknown Type %v %v\n", x, reflect.TypeOf(x)))
+	} // end of switch
+} // end of func
+func EqualShape(a, b Shape) bool {
+	switch x := a.(type) {
+	case nil:
+		return b == nil
+	case *Circle:
+		switch y := b.(type) {
+		case *Circle:
+			return x == y || (x != nil && y != nil && EqualCircle(*x, *y))
+		}
+	case Square:
+		switch y := b.(type) {
+		case Square:
+			return EqualSquare(x, y)
+		case *Square:
+			return y != nil && EqualSquare(x, *y)
+		}
+	case *Square:
+		switch y := b.(type) {
+		case Square:
+			return x != nil && EqualSquare(*x, y)
+		case *Square:
+			return x == y || (x != nil && y != nil && EqualSquare(*x, *y))
+		}
+	default:
+		_ = x
+	} // end of switch
+	return false
+} // end of func
+func DecodeJSONShape(bz []byte) (v Shape, err error) {
+	d := &codonJSONReader{bz: bz}
+	decodeJSONShape(d, &v)
+	return v, d.finish()
+}
+func decodeJSONShape(d *codonJSONReader, v *Shape) {
+	if d.null() {
+		*v = nil
+		return
+	}
+	name, value := d.typeValue()
+	switch name {
+	case "Circle":
+		var tmp Circle
+		decodeJSONCircle(value, &tmp)
+		*v = &tmp
+	case "Square":
+		var tmp Square
+		decodeJSONSquare(value, &tmp)
+		*v = tmp
+	default:
+		d.failf("Unknown type name %q when decoding Shape", name)
+	} // end of switch
+	d.merge(value)
+} // end of DecodeJSONShape
+func EncodeJSONShape(x interface{}) ([]byte, error) {
+	w := &codonJSONWriter{}
+	encodeJSONShape(w, x)
+	return w.result()
+}
+func encodeJSONShape(w *codonJSONWriter, x interface{}) {
+	switch v := x.(type) {
+	case Circle:
+		w.beginType("Circle")
+		encodeJSONCircle(w, &v)
+		w.raw("}")
+	case *Circle:
+		w.beginType("Circle")
+		encodeJSONCircle(w, v)
+		w.raw("}")
+	case Square:
+		w.beginType("Square")
+		encodeJSONSquare(w, &v)
+		w.raw("}")
+	case *Square:
+		w.beginType("Square")
+		encodeJSONSquare(w, v)
+		w.raw("}")
+	case nil:
+		w.raw("null")
+	default:
+		w.fail(fmt.Errorf("Unknown Type %T", x))
+	} // end of switch
+} // end of func
+func getMagicNum(name string) uint32 {
+	switch name {
+	case "Catalog":
+		return 79200916
+	case "Circle":
+		return 48907161
+	case "Item":
+		return 444530459
+	case "Note":
+		return 459714305
+	case "NoteV1":
+		return 212049696
+	case "Record":
+		return 517113075
+	case "RecordV1":
+		return 405238466
+	case "Square":
+		return 124751808
+	} // end of switch
+	panic("Should not reach here")
+} // end of getMagicNum
+func getAliasOfMagicNum(magicNum uint32) string {
+	switch magicNum {
+	case 79200916:
+		return "Catalog"
+	case 48907161:
+		return "Circle"
+	case 444530459:
+		return "Item"
+	case 459714305:
+		return "Note"
+	case 212049696:
+		return "NoteV1"
+	case 517113075:
+		return "Record"
+	case 405238466:
+		return "RecordV1"
+	case 124751808:
+		return "Square"
+	} // end of switch
+	return ""
+} // end of getAliasOfMagicNum
+func getMagicNumOfVar(x interface{}) (uint32, bool) {
+	switch x.(type) {
+	case *Catalog, Catalog:
+		return 79200916, true
+	case *Circle, Circle:
+		return 48907161, true
+	case *Item, Item:
+		return 444530459, true
+	case *Note, Note:
+		return 459714305, true
+	case *NoteV1, NoteV1:
+		return 212049696, true
+	case *Record, Record:
+		return 517113075, true
+	case *RecordV1, RecordV1:
+		return 405238466, true
+	case *Square, Square:
+		return 124751808, true
+	default:
+		return 0, false
+	} // end of switch
+} // end of func
+func EncodeAny(w *[]byte, x interface{}) {
+	s := &codonSizes{}
+	codonGrow(w, sizeAny(x, s))
+	encodeAny(w, x, s)
+}
+func HashAny(h hash.Hash, v interface{}) {
+	s := &codonSizes{out: h}
+	sizeAny(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeAny(&w, v, s)
+	h.Write(w)
+}
+func encodeAny(w *[]byte, x interface{}, s *codonSizes) {
+	switch v := x.(type) {
+	case Catalog:
+		codonEncodeLength(int(getMagicNum("Catalog")), w, s.next())
+		encodeCatalog(w, v, s)
+	case *Catalog:
+		codonEncodeLength(int(getMagicNum("Catalog")), w, s.next())
+		encodeCatalog(w, *v, s)
+	case Circle:
+		codonEncodeLength(int(getMagicNum("Circle")), w, s.next())
+		encodeCircle(w, v, s)
+	case *Circle:
+		codonEncodeLength(int(getMagicNum("Circle")), w, s.next())
+		encodeCircle(w, *v, s)
+	case Item:
+		codonEncodeLength(int(getMagicNum("Item")), w, s.next())
+		encodeItem(w, v, s)
+	case *Item:
+		codonEncodeLength(int(getMagicNum("Item")), w, s.next())
+		encodeItem(w, *v, s)
+	case Note:
+		codonEncodeLength(int(getMagicNum("Note")), w, s.next())
+		encodeNote(w, v, s)
+	case *Note:
+		codonEncodeLength(int(getMagicNum("Note")), w, s.next())
+		encodeNote(w, *v, s)
+	case NoteV1:
+		codonEncodeLength(int(getMagicNum("NoteV1")), w, s.next())
+		encodeNoteV1(w, v, s)
+	case *NoteV1:
+		codonEncodeLength(int(getMagicNum("NoteV1")), w, s.next())
+		encodeNoteV1(w, *v, s)
+	case Record:
+		codonEncodeLength(int(getMagicNum("Record")), w, s.next())
+		encodeRecord(w, v, s)
+	case *Record:
+		codonEncodeLength(int(getMagicNum("Record")), w, s.next())
+		encodeRecord(w, *v, s)
+	case RecordV1:
+		codonEncodeLength(int(getMagicNum("RecordV1")), w, s.next())
+		encodeRecordV1(w, v, s)
+	case *RecordV1:
+		codonEncodeLength(int(getMagicNum("RecordV1")), w, s.next())
+		encodeRecordV1(w, *v, s)
+	case Square:
+		codonEncodeLength(int(getMagicNum("Square")), w, s.next())
+		encodeSquare(w, v, s)
+	case *Square:
+		codonEncodeLength(int(getMagicNum("Square")), w, s.next())
+		encodeSquare(w, *v, s)
+	default:
+		panic(fmt.Sprintf("Unknown Type %v %v\n", x, reflect.TypeOf(x)))
+	} // end of switch
+} // end of func
+func SizeAny(x interface{}) int {
+	return sizeAny(x, nil)
+}
+func sizeAny(x interface{}, s *codonSizes) int {
+	switch v := x.(type) {
+	case Catalog:
+		idx := s.reserve()
+		return 5 + codonByteSliceSize(s.set(idx, sizeCatalog(v, s)))
+	case *Catalog:
+		idx := s.reserve()
+		return 5 + codonByteSliceSize(s.set(idx, sizeCatalog(*v, s)))
+	case Circle:
+		idx := s.reserve()
+		return 5 + codonByteSliceSize(s.set(idx, sizeCircle(v, s)))
+	case *Circle:
+		idx := s.reserve()
+		return 5 + codonByteSliceSize(s.set(idx, sizeCircle(*v, s)))
+	case Item:
+		idx := s.reserve()
+		return 5 + codonByteSliceSize(s.set(idx, sizeItem(v, s)))
+	case *Item:
+		idx := s.reserve()
+		return 5 + codonByteSliceSize(s.set(idx, sizeItem(*v, s)))
+	case Note:
+		idx := s.reserve()
+		return 5 + codonByteSliceSize(s.set(idx, sizeNote(v, s)))
+	case *Note:
+		idx := s.reserve()
+		return 5 + codonByteSliceSize(s.set(idx, sizeNote(*v, s)))
+	case NoteV1:
+		idx := s.reserve()
+		return 5 + codonByteSliceSize(s.set(idx, sizeNoteV1(v, s)))
+	case *NoteV1:
+		idx := s.reserve()
+		return 5 + codonByteSliceSize(s.set(idx, sizeNoteV1(*v, s)))
+	case Record:
+		idx := s.reserve()
+		return 5 + codonByteSliceSize(s.set(idx, sizeRecord(v, s)))
+	case *Record:
+		idx := s.reserve()
+		return 5 + codonByteSliceSize(s.set(idx, sizeRecord(*v, s)))
+	case RecordV1:
+		idx := s.reserve()
+		return 5 + codonByteSliceSize(s.set(idx, sizeRecordV1(v, s)))
+	case *RecordV1:
+		idx := s.reserve()
+		return 5 + codonByteSliceSize(s.set(idx, sizeRecordV1(*v, s)))
+	case Square:
+		idx := s.reserve()
+		return 5 + codonByteSliceSize(s.set(idx, sizeSquare(v, s)))
+	case *Square:
+		idx := s.reserve()
+		return 5 + codonByteSliceSize(s.set(idx, sizeSquare(*v, s)))
+	default:
+		panic(fmt.Sprintf("Unknown Type %v %v\n", x, reflect.TypeOf(x)))
+	} // end of switch
+} // end of func
+func DecodeAny(bz []byte) (interface{}, int, error) {
+	return decodeAny(bz, nil)
+}
+func DecodeAnyNoCopy(bz []byte) (interface{}, int, error) {
+	return decodeAny(bz, &codonDecoder{noCopy: true})
+}
+func DecodeAnyWithOptions(bz []byte, opts DecodeOptions) (v interface{}, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeAny(bz, d)
+}
+func decodeAny(bz []byte, d *codonDecoder) (v interface{}, total int, err error) {
+
+	var n int
+	tag := codonDecodeUint64(bz, &n, &err)
+	if err != nil {
+		return
+	}
+	bz = bz[n:]
+	total += n
+	magicNum := uint32(tag >> 3)
+	switch magicNum {
+	case 79200916:
+		l := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		if l > uint64(len(bz)) {
+			err = errors.New("Length Too Large")
+			return
+		}
+		var tmp Catalog
+		tmp, n, err = decodeCatalog(bz[:l], d)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		if int(l) != n {
+			err = errors.New("Length Mismatch")
+			return
+		}
+		v = tmp
+		return
+	case 48907161:
+		l := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		if l > uint64(len(bz)) {
+			err = errors.New("Length Too Large")
+			return
+		}
+		var tmp Circle
+		tmp, n, err = decodeCircle(bz[:l], d)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		if int(l) != n {
+			err = errors.New("Length Mismatch")
+			return
+		}
+		v = tmp
+		return
+	case 444530459:
+		l := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		if l > uint64(len(bz)) {
+			err = errors.New("Length Too Large")
+			return
+		}
+		var tmp Item
+		tmp, n, err = decodeItem(bz[:l], d)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		if int(l) != n {
+			err = errors.New("Length Mismatch")
+			return
+		}
+		v = tmp
+		return
+	case 459714305:
+		l := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		if l > uint64(len(bz)) {
+			err = errors.New("Length Too Large")
+			return
+		}
+		var tmp Note
+		tmp, n, err = decodeNote(bz[:l], d)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		if int(l) != n {
+			err = errors.New("Length Mismatch")
+			return
+		}
+		v = tmp
+		return
+	case 212049696:
+		l := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		if l > uint64(len(bz)) {
+			err = errors.New("Length Too Large")
+			return
+		}
+		var tmp NoteV1
+		tmp, n, err = decodeNoteV1(bz[:l], d)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		if int(l) != n {
+			err = errors.New("Length Mismatch")
+			return
+		}
+		v = tmp
+		return
+	case 517113075:
+		l := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		if l > uint64(len(bz)) {
+			err = errors.New("Length Too Large")
+			return
+		}
+		var tmp Record
+		tmp, n, err = decodeRecord(bz[:l], d)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		if int(l) != n {
+			err = errors.New("Length Mismatch")
+			return
+		}
+		v = tmp
+		return
+	case 405238466:
+		l := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		if l > uint64(len(bz)) {
+			err = errors.New("Length Too Large")
+			return
+		}
+		var tmp RecordV1
+		tmp, n, err = decodeRecordV1(bz[:l], d)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		if int(l) != n {
+			err = errors.New("Length Mismatch")
+			return
+		}
+		v = tmp
+		return
+	case 124751808:
+		l := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		if l > uint64(len(bz)) {
+			err = errors.New("Length Too Large")
+			return
+		}
+		var tmp Square
+		tmp, n, err = decodeSquare(bz[:l], d)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		if int(l) != n {
+			err = errors.New("Length Mismatch")
+			return
+		}
+		v = tmp
+		return
+	default:
+		err = &ErrUnknownMagicNum{MagicNum: magicNum, Alias: "interface{}"}
+	} // end of switch
+	return
+} // end of decodeAny
+func EncodeJSONAny(x interface{}) ([]byte, error) {
+	w := &codonJSONWriter{}
+	encodeJSONAny(w, x, true)
+	return w.result()
+}
+func encodeJSONAny(w *codonJSONWriter, x interface{}, wrap bool) {
+	switch v := x.(type) {
+	case Catalog:
+		if wrap {
+			w.beginType("Catalog")
+		}
+		encodeJSONCatalog(w, &v)
+		if wrap {
+			w.raw("}")
+		}
+	case *Catalog:
+		if wrap {
+			w.beginType("Catalog")
+		}
+		encodeJSONCatalog(w, v)
+		if wrap {
+			w.raw("}")
+		}
+	case Circle:
+		if wrap {
+			w.beginType("Circle")
+		}
+		encodeJSONCircle(w, &v)
+		if wrap {
+			w.raw("}")
+		}
+	case *Circle:
+		if wrap {
+			w.beginType("Circle")
+		}
+		encodeJSONCircle(w, v)
+		if wrap {
+			w.raw("}")
+		}
+	case Item:
+		if wrap {
+			w.beginType("Item")
+		}
+		encodeJSONItem(w, &v)
+		if wrap {
+			w.raw("}")
+		}
+	case *Item:
+		if wrap {
+			w.beginType("Item")
+		}
+		encodeJSONItem(w, v)
+		if wrap {
+			w.raw("}")
+		}
+	case Note:
+		if wrap {
+			w.beginType("Note")
+		}
+		encodeJSONNote(w, &v)
+		if wrap {
+			w.raw("}")
+		}
+	case *Note:
+		if wrap {
+			w.beginType("Note")
+		}
+		encodeJSONNote(w, v)
+		if wrap {
+			w.raw("}")
+		}
+	case NoteV1:
+		if wrap {
+			w.beginType("NoteV1")
+		}
+		encodeJSONNoteV1(w, &v)
+		if wrap {
+			w.raw("}")
+		}
+	case *NoteV1:
+		if wrap {
+			w.beginType("NoteV1")
+		}
+		encodeJSONNoteV1(w, v)
+		if wrap {
+			w.raw("}")
+		}
+	case Record:
+		if wrap {
+			w.beginType("Record")
+		}
+		encodeJSONRecord(w, &v)
+		if wrap {
+			w.raw("}")
+		}
+	case *Record:
+		if wrap {
+			w.beginType("Record")
+		}
+		encodeJSONRecord(w, v)
+		if wrap {
+			w.raw("}")
+		}
+	case RecordV1:
+		if wrap {
+			w.beginType("RecordV1")
+		}
+		encodeJSONRecordV1(w, &v)
+		if wrap {
+			w.raw("}")
+		}
+	case *RecordV1:
+		if wrap {
+			w.beginType("RecordV1")
+		}
+		encodeJSONRecordV1(w, v)
+		if wrap {
+			w.raw("}")
+		}
+	case Square:
+		if wrap {
+			w.beginType("Square")
+		}
+		encodeJSONSquare(w, &v)
+		if wrap {
+			w.raw("}")
+		}
+	case *Square:
+		if wrap {
+			w.beginType("Square")
+		}
+		encodeJSONSquare(w, v)
+		if wrap {
+			w.raw("}")
+		}
+	case nil:
+		w.raw("null")
+	default:
+		w.fail(fmt.Errorf("Unknown Type %T", x))
+	} // end of switch
+} // end of func
+func DecodeJSONAny(bz []byte) (v interface{}, err error) {
+	d := &codonJSONReader{bz: bz}
+	decodeJSONAny(d, &v)
+	return v, d.finish()
+}
+func decodeJSONAny(d *codonJSONReader, v *interface{}) {
+	if d.null() {
+		*v = nil
+		return
+	}
+	name, value := d.typeValue()
+	switch name {
+	case "Catalog":
+		var tmp Catalog
+		decodeJSONCatalog(value, &tmp)
+		*v = tmp
+	case "Circle":
+		var tmp Circle
+		decodeJSONCircle(value, &tmp)
+		*v = tmp
+	case "Item":
+		var tmp Item
+		decodeJSONItem(value, &tmp)
+		*v = tmp
+	case "Note":
+		var tmp Note
+		decodeJSONNote(value, &tmp)
+		*v = tmp
+	case "NoteV1":
+		var tmp NoteV1
+		decodeJSONNoteV1(value, &tmp)
+		*v = tmp
+	case "Record":
+		var tmp Record
+		decodeJSONRecord(value, &tmp)
+		*v = tmp
+	case "RecordV1":
+		var tmp RecordV1
+		decodeJSONRecordV1(value, &tmp)
+		*v = tmp
+	case "Square":
+		var tmp Square
+		decodeJSONSquare(value, &tmp)
+		*v = tmp
+	default:
+		d.failf("Unknown type name %q when decoding interface{}", name)
+	} // end of switch
+	d.merge(value)
+} // end of DecodeJSONAny
+func decodeJSONPtr(d *codonJSONReader, ptr interface{}) bool {
+	switch p := ptr.(type) {
+	case *Catalog:
+		decodeJSONCatalog(d, p)
+	case *Circle:
+		decodeJSONCircle(d, p)
+	case *Item:
+		decodeJSONItem(d, p)
+	case *Note:
+		decodeJSONNote(d, p)
+	case *NoteV1:
+		decodeJSONNoteV1(d, p)
+	case *Record:
+		decodeJSONRecord(d, p)
+	case *RecordV1:
+		decodeJSONRecordV1(d, p)
+	case *Square:
+		decodeJSONSquare(d, p)
+	case *Shape:
+		decodeJSONShape(d, p)
+	default:
+		return false
+	} // end of switch
+	return true
+} // end of decodeJSONPtr
+func AssignIfcPtrFromStruct(ifcPtrIn interface{}, structObjIn interface{}) error {
+	switch ifcPtr := ifcPtrIn.(type) {
+	case *Shape:
+		switch structObj := structObjIn.(type) {
+		case Circle:
+			*ifcPtr = &structObj
+		case Square:
+			*ifcPtr = &structObj
+		default:
+			return newErrTypeMismatch(structObjIn, ifcPtrIn)
+		} // end switch of structs
+	default:
+		return newErrTypeMismatch(structObjIn, ifcPtrIn)
+	} // end switch of interfaces
+	return nil
+}
+func RandAny(r RandSrc) interface{} {
+	switch r.GetUint() % 8 {
+	case 0:
+		return RandCatalog(r)
+	case 1:
+		return RandCircle(r)
+	case 2:
+		return RandItem(r)
+	case 3:
+		return RandNote(r)
+	case 4:
+		return RandNoteV1(r)
+	case 5:
+		return RandRecord(r)
+	case 6:
+		return RandRecordV1(r)
+	case 7:
+		return RandSquare(r)
+	default:
+		panic("Unknown Type.")
+	} // end of switch
+} // end of func
+func DeepCopyAny(x interface{}) interface{} {
+	switch v := x.(type) {
+	case Catalog:
+		res := DeepCopyCatalog(v)
+		return res
+	case *Catalog:
+		res := DeepCopyCatalog(*v)
+		return &res
+	case Circle:
+		res := DeepCopyCircle(v)
+		return res
+	case *Circle:
+		res := DeepCopyCircle(*v)
+		return &res
+	case Item:
+		res := DeepCopyItem(v)
+		return res
+	case *Item:
+		res := DeepCopyItem(*v)
+		return &res
+	case Note:
+		res := DeepCopyNote(v)
+		return res
+	case *Note:
+		res := DeepCopyNote(*v)
+		return &res
+	case NoteV1:
+		res := DeepCopyNoteV1(v)
+		return res
+	case *NoteV1:
+		res := DeepCopyNoteV1(*v)
+		return &res
+	case Record:
+		res := DeepCopyRecord(v)
+		return res
+	case *Record:
+		res := DeepCopyRecord(*v)
+		return &res
+	case RecordV1:
+		res := DeepCopyRecordV1(v)
+		return res
+	case *RecordV1:
+		res := DeepCopyRecordV1(*v)
+		return &res
+	case Square:
+		res := DeepCopySquare(v)
+		return res
+	case *Square:
+		res := DeepCopySquare(*v)
+		return &res
+	default:
+		panic(fmt.Sprintf("Unknown Type %v %v\n", x, reflect.TypeOf(x)))
+	} // end of switch
+} // end of func
+func EqualAny(a, b interface{}) bool {
+	switch x := a.(type) {
+	case nil:
+		return b == nil
+	case Catalog:
+		switch y := b.(type) {
+		case Catalog:
+			return EqualCatalog(x, y)
+		case *Catalog:
+			return y != nil && EqualCatalog(x, *y)
+		}
+	case *Catalog:
+		switch y := b.(type) {
+		case Catalog:
+			return x != nil && EqualCatalog(*x, y)
+		case *Catalog:
+			return x == y || (x != nil && y != nil && EqualCatalog(*x, *y))
+		}
+	case Circle:
+		switch y := b.(type) {
+		case Circle:
+			return EqualCircle(x, y)
+		case *Circle:
+			return y != nil && EqualCircle(x, *y)
+		}
+	case *Circle:
+		switch y := b.(type) {
+		case Circle:
+			return x != nil && EqualCircle(*x, y)
+		case *Circle:
+			return x == y || (x != nil && y != nil && EqualCircle(*x, *y))
+		}
+	case Item:
+		switch y := b.(type) {
+		case Item:
+			return EqualItem(x, y)
+		case *Item:
+			return y != nil && EqualItem(x, *y)
+		}
+	case *Item:
+		switch y := b.(type) {
+		case Item:
+			return x != nil && EqualItem(*x, y)
+		case *Item:
+			return x == y || (x != nil && y != nil && EqualItem(*x, *y))
+		}
+	case Note:
+		switch y := b.(type) {
+		case Note:
+			return EqualNote(x, y)
+		case *Note:
+			return y != nil && EqualNote(x, *y)
+		}
+	case *Note:
+		switch y := b.(type) {
+		case Note:
+			return x != nil && EqualNote(*x, y)
+		case *Note:
+			return x == y || (x != nil && y != nil && EqualNote(*x, *y))
+		}
+	case NoteV1:
+		switch y := b.(type) {
+		case NoteV1:
+			return EqualNoteV1(x, y)
+		case *NoteV1:
+			return y != nil && EqualNoteV1(x, *y)
+		}
+	case *NoteV1:
+		switch y := b.(type) {
+		case NoteV1:
+			return x != nil && EqualNoteV1(*x, y)
+		case *NoteV1:
+			return x == y || (x != nil && y != nil && EqualNoteV1(*x, *y))
+		}
+	case Record:
+		switch y := b.(type) {
+		case Record:
+			return EqualRecord(x, y)
+		case *Record:
+			return y != nil && EqualRecord(x, *y)
+		}
+	case *Record:
+		switch y := b.(type) {
+		case Record:
+			return x != nil && EqualRecord(*x, y)
+		case *Record:
+			return x == y || (x != nil && y != nil && EqualRecord(*x, *y))
+		}
+	case RecordV1:
+		switch y := b.(type) {
+		case RecordV1:
+			return EqualRecordV1(x, y)
+		case *RecordV1:
+			return y != nil && EqualRecordV1(x, *y)
+		}
+	case *RecordV1:
+		switch y := b.(type) {
+		case RecordV1:
+			return x != nil && EqualRecordV1(*x, y)
+		case *RecordV1:
+			return x == y || (x != nil && y != nil && EqualRecordV1(*x, *y))
+		}
+	case Square:
+		switch y := b.(type) {
+		case Square:
+			return EqualSquare(x, y)
+		case *Square:
+			return y != nil && EqualSquare(x, *y)
+		}
+	case *Square:
+		switch y := b.(type) {
+		case Square:
+			return x != nil && EqualSquare(*x, y)
+		case *Square:
+			return x == y || (x != nil && y != nil && EqualSquare(*x, *y))
+		}
+	default:
+		_ = x
+	} // end of switch
+	return false
+} // end of func
+func GetSupportList() []string {
+	return []string{
+		"github.com/coinexchain/codon/internal/fixture/plain.Catalog",
+		"github.com/coinexchain/codon/internal/fixture/plain.Circle",
+		"github.com/coinexchain/codon/internal/fixture/plain.Item",
+		"github.com/coinexchain/codon/internal/fixture/plain.Note",
+		"github.com/coinexchain/codon/internal/fixture/plain.NoteV1",
+		"github.com/coinexchain/codon/internal/fixture/plain.Record",
+		"github.com/coinexchain/codon/internal/fixture/plain.RecordV1",
+		"github.com/coinexchain/codon/internal/fixture/plain.Shape",
+		"github.com/coinexchain/codon/internal/fixture/plain.Square",
+	}
+} // end of GetSupportList
+func RoundTripSelfTest(r RandSrc, count int) error {
+
+	for i := 0; i < count; i++ {
+		v := RandShape(r)
+		buf := make([]byte, 0, 64)
+		EncodeShape(&buf, v)
+		if len(buf) != SizeShape(v) {
+			return fmt.Errorf("Shape: size is %d but encoded %d bytes", SizeShape(v), len(buf))
+		}
+		res, n, err := DecodeShape(buf)
+		if err != nil {
+			return fmt.Errorf("Shape: %v", err)
+		}
+		if n != len(buf) {
+			return fmt.Errorf("Shape: decoded %d bytes out of %d", n, len(buf))
+		}
+		buf2 := make([]byte, 0, 64)
+		EncodeShape(&buf2, res)
+		if !bytes.Equal(buf, buf2) {
+			return errors.New("Shape: mismatch after round trip")
+		}
+		if !EqualShape(v, res) {
+			return errors.New("Shape: value changed after round trip")
+		}
+	}
+
+	for i := 0; i < count; i++ {
+		v := RandSquare(r)
+		buf := make([]byte, 0, 64)
+		EncodeSquare(&buf, v)
+		if len(buf) != SizeSquare(v) {
+			return fmt.Errorf("Square: size is %d but encoded %d bytes", SizeSquare(v), len(buf))
+		}
+		res, n, err := DecodeSquare(buf)
+		if err != nil {
+			return fmt.Errorf("Square: %v", err)
+		}
+		if n != len(buf) {
+			return fmt.Errorf("Square: decoded %d bytes out of %d", n, len(buf))
+		}
+		buf2 := make([]byte, 0, 64)
+		EncodeSquare(&buf2, res)
+		if !bytes.Equal(buf, buf2) {
+			return errors.New("Square: mismatch after round trip")
+		}
+		if !EqualSquare(v, res) {
+			return errors.New("Square: value changed after round trip")
+		}
+	}
+
+	for i := 0; i < count; i++ {
+		v := RandCircle(r)
+		buf := make([]byte, 0, 64)
+		EncodeCircle(&buf, v)
+		if len(buf) != SizeCircle(v) {
+			return fmt.Errorf("Circle: size is %d but encoded %d bytes", SizeCircle(v), len(buf))
+		}
+		res, n, err := DecodeCircle(buf)
+		if err != nil {
+			return fmt.Errorf("Circle: %v", err)
+		}
+		if n != len(buf) {
+			return fmt.Errorf("Circle: decoded %d bytes out of %d", n, len(buf))
+		}
+		buf2 := make([]byte, 0, 64)
+		EncodeCircle(&buf2, res)
+		if !bytes.Equal(buf, buf2) {
+			return errors.New("Circle: mismatch after round trip")
+		}
+		if !EqualCircle(v, res) {
+			return errors.New("Circle: value changed after round trip")
+		}
+	}
+
+	for i := 0; i < count; i++ {
+		v := RandItem(r)
+		buf := make([]byte, 0, 64)
+		EncodeItem(&buf, v)
+		if len(buf) != SizeItem(v) {
+			return fmt.Errorf("Item: size is %d but encoded %d bytes", SizeItem(v), len(buf))
+		}
+		res, n, err := DecodeItem(buf)
+		if err != nil {
+			return fmt.Errorf("Item: %v", err)
+		}
+		if n != len(buf) {
+			return fmt.Errorf("Item: decoded %d bytes out of %d", n, len(buf))
+		}
+		buf2 := make([]byte, 0, 64)
+		EncodeItem(&buf2, res)
+		if !bytes.Equal(buf, buf2) {
+			return errors.New("Item: mismatch after round trip")
+		}
+		if !EqualItem(v, res) {
+			return errors.New("Item: value changed after round trip")
+		}
+	}
+
+	for i := 0; i < count; i++ {
+		v := RandRecord(r)
+		buf := make([]byte, 0, 64)
+		EncodeRecord(&buf, v)
+		if len(buf) != SizeRecord(v) {
+			return fmt.Errorf("Record: size is %d but encoded %d bytes", SizeRecord(v), len(buf))
+		}
+		res, n, err := DecodeRecord(buf)
+		if err != nil {
+			return fmt.Errorf("Record: %v", err)
+		}
+		if n != len(buf) {
+			return fmt.Errorf("Record: decoded %d bytes out of %d", n, len(buf))
+		}
+		buf2 := make([]byte, 0, 64)
+		EncodeRecord(&buf2, res)
+		if !bytes.Equal(buf, buf2) {
+			return errors.New("Record: mismatch after round trip")
+		}
+		if !EqualRecord(v, res) {
+			return errors.New("Record: value changed after round trip")
+		}
+	}
+
+	for i := 0; i < count; i++ {
+		v := RandRecordV1(r)
+		buf := make([]byte, 0, 64)
+		EncodeRecordV1(&buf, v)
+		if len(buf) != SizeRecordV1(v) {
+			return fmt.Errorf("RecordV1: size is %d but encoded %d bytes", SizeRecordV1(v), len(buf))
+		}
+		res, n, err := DecodeRecordV1(buf)
+		if err != nil {
+			return fmt.Errorf("RecordV1: %v", err)
+		}
+		if n != len(buf) {
+			return fmt.Errorf("RecordV1: decoded %d bytes out of %d", n, len(buf))
+		}
+		buf2 := make([]byte, 0, 64)
+		EncodeRecordV1(&buf2, res)
+		if !bytes.Equal(buf, buf2) {
+			return errors.New("RecordV1: mismatch after round trip")
+		}
+		if !EqualRecordV1(v, res) {
+			return errors.New("RecordV1: value changed after round trip")
+		}
+	}
+
+	for i := 0; i < count; i++ {
+		v := RandNote(r)
+		buf := make([]byte, 0, 64)
+		EncodeNote(&buf, v)
+		if len(buf) != SizeNote(v) {
+			return fmt.Errorf("Note: size is %d but encoded %d bytes", SizeNote(v), len(buf))
+		}
+		res, n, err := DecodeNote(buf)
+		if err != nil {
+			return fmt.Errorf("Note: %v", err)
+		}
+		if n != len(buf) {
+			return fmt.Errorf("Note: decoded %d bytes out of %d", n, len(buf))
+		}
+		buf2 := make([]byte, 0, 64)
+		EncodeNote(&buf2, res)
+		if !bytes.Equal(buf, buf2) {
+			return errors.New("Note: mismatch after round trip")
+		}
+		if !EqualNote(v, res) {
+			return errors.New("Note: value changed after round trip")
+		}
+	}
+
+	for i := 0; i < count; i++ {
+		v := RandNoteV1(r)
+		buf := make([]byte, 0, 64)
+		EncodeNoteV1(&buf, v)
+		if len(buf) != SizeNoteV1(v) {
+			return fmt.Errorf("NoteV1: size is %d but encoded %d bytes", SizeNoteV1(v), len(buf))
+		}
+		res, n, err := DecodeNoteV1(buf)
+		if err != nil {
+			return fmt.Errorf("NoteV1: %v", err)
+		}
+		if n != len(buf) {
+			return fmt.Errorf("NoteV1: decoded %d bytes out of %d", n, len(buf))
+		}
+		buf2 := make([]byte, 0, 64)
+		EncodeNoteV1(&buf2, res)
+		if !bytes.Equal(buf, buf2) {
+			return errors.New("NoteV1: mismatch after round trip")
+		}
+		if !EqualNoteV1(v, res) {
+			return errors.New("NoteV1: value changed after round trip")
+		}
+	}
+
+	for i := 0; i < count; i++ {
+		v := RandCatalog(r)
+		buf := make([]byte, 0, 64)
+		EncodeCatalog(&buf, v)
+		if len(buf) != SizeCatalog(v) {
+			return fmt.Errorf("Catalog: size is %d but encoded %d bytes", SizeCatalog(v), len(buf))
+		}
+		res, n, err := DecodeCatalog(buf)
+		if err != nil {
+			return fmt.Errorf("Catalog: %v", err)
+		}
+		if n != len(buf) {
+			return fmt.Errorf("Catalog: decoded %d bytes out of %d", n, len(buf))
+		}
+		buf2 := make([]byte, 0, 64)
+		EncodeCatalog(&buf2, res)
+		if !bytes.Equal(buf, buf2) {
+			return errors.New("Catalog: mismatch after round trip")
+		}
+		if !EqualCatalog(v, res) {
+			return errors.New("Catalog: value changed after round trip")
+		}
+	}
+	return nil
+} // end of RoundTripSelfTest
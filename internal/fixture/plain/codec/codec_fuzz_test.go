@@ -0,0 +1,335 @@
+// Code generated by codon. DO NOT EDIT.
+
+//nolint:all
+package codec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// codonFuzzSrc is a RandSrc backed by the fuzzer's input bytes. After the input is used up, it returns zeros.
+type codonFuzzSrc struct {
+	bz []byte
+}
+
+func (s *codonFuzzSrc) next(n int) []byte {
+	res := make([]byte, n)
+	m := copy(res, s.bz)
+	s.bz = s.bz[m:]
+	return res
+}
+
+func (s *codonFuzzSrc) GetBool() bool       { return s.next(1)[0]&1 != 0 }
+func (s *codonFuzzSrc) GetInt() int         { return int(s.GetUint64()) }
+func (s *codonFuzzSrc) GetInt8() int8       { return int8(s.next(1)[0]) }
+func (s *codonFuzzSrc) GetInt16() int16     { return int16(s.GetUint16()) }
+func (s *codonFuzzSrc) GetInt32() int32     { return int32(s.GetUint32()) }
+func (s *codonFuzzSrc) GetInt64() int64     { return int64(s.GetUint64()) }
+func (s *codonFuzzSrc) GetUint() uint       { return uint(s.GetUint64()) }
+func (s *codonFuzzSrc) GetUint8() uint8     { return s.next(1)[0] }
+func (s *codonFuzzSrc) GetUint16() uint16   { return binary.LittleEndian.Uint16(s.next(2)) }
+func (s *codonFuzzSrc) GetUint32() uint32   { return binary.LittleEndian.Uint32(s.next(4)) }
+func (s *codonFuzzSrc) GetUint64() uint64   { return binary.LittleEndian.Uint64(s.next(8)) }
+func (s *codonFuzzSrc) GetFloat32() float32 { return math.Float32frombits(s.GetUint32()) }
+func (s *codonFuzzSrc) GetFloat64() float64 { return math.Float64frombits(s.GetUint64()) }
+func (s *codonFuzzSrc) GetString(n int) string {
+	return string(s.next(n))
+}
+func (s *codonFuzzSrc) GetBytes(n int) []byte {
+	return s.next(n)
+}
+
+// Seeds the corpus with the encoded bytes of random values, which are filled by Rand* functions
+func codonFuzzSeeds(f *testing.F, encodeRand func(r RandSrc) []byte) {
+	f.Add([]byte{})
+	rnd := rand.New(rand.NewSource(0))
+	for i := 0; i < 16; i++ {
+		input := make([]byte, 1024)
+		rnd.Read(input)
+		f.Add(encodeRand(&codonFuzzSrc{bz: input}))
+	}
+}
+
+func FuzzDecodeShape(f *testing.F) {
+	codonFuzzSeeds(f, func(r RandSrc) []byte {
+		var buf []byte
+		EncodeShape(&buf, RandShape(r))
+		return buf
+	})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		v, _, err := DecodeShape(bz)
+		if err != nil {
+			return
+		}
+		var buf []byte
+		EncodeShape(&buf, v)
+		v2, n, err := DecodeShape(buf)
+		if err != nil {
+			t.Fatalf("Shape: cannot decode the re-encoded bytes: %v", err)
+		}
+		if n != len(buf) {
+			t.Fatalf("Shape: decoded %d bytes out of %d", n, len(buf))
+		}
+		var buf2 []byte
+		EncodeShape(&buf2, v2)
+		if !bytes.Equal(buf, buf2) {
+			t.Fatalf("Shape: encoded bytes changed after decode->encode->decode")
+		}
+	})
+}
+
+func FuzzDecodeSquare(f *testing.F) {
+	codonFuzzSeeds(f, func(r RandSrc) []byte {
+		var buf []byte
+		EncodeSquare(&buf, RandSquare(r))
+		return buf
+	})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		v, _, err := DecodeSquare(bz)
+		if err != nil {
+			return
+		}
+		var buf []byte
+		EncodeSquare(&buf, v)
+		v2, n, err := DecodeSquare(buf)
+		if err != nil {
+			t.Fatalf("Square: cannot decode the re-encoded bytes: %v", err)
+		}
+		if n != len(buf) {
+			t.Fatalf("Square: decoded %d bytes out of %d", n, len(buf))
+		}
+		var buf2 []byte
+		EncodeSquare(&buf2, v2)
+		if !bytes.Equal(buf, buf2) {
+			t.Fatalf("Square: encoded bytes changed after decode->encode->decode")
+		}
+	})
+}
+
+func FuzzDecodeCircle(f *testing.F) {
+	codonFuzzSeeds(f, func(r RandSrc) []byte {
+		var buf []byte
+		EncodeCircle(&buf, RandCircle(r))
+		return buf
+	})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		v, _, err := DecodeCircle(bz)
+		if err != nil {
+			return
+		}
+		var buf []byte
+		EncodeCircle(&buf, v)
+		v2, n, err := DecodeCircle(buf)
+		if err != nil {
+			t.Fatalf("Circle: cannot decode the re-encoded bytes: %v", err)
+		}
+		if n != len(buf) {
+			t.Fatalf("Circle: decoded %d bytes out of %d", n, len(buf))
+		}
+		var buf2 []byte
+		EncodeCircle(&buf2, v2)
+		if !bytes.Equal(buf, buf2) {
+			t.Fatalf("Circle: encoded bytes changed after decode->encode->decode")
+		}
+	})
+}
+
+func FuzzDecodeItem(f *testing.F) {
+	codonFuzzSeeds(f, func(r RandSrc) []byte {
+		var buf []byte
+		EncodeItem(&buf, RandItem(r))
+		return buf
+	})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		v, _, err := DecodeItem(bz)
+		if err != nil {
+			return
+		}
+		var buf []byte
+		EncodeItem(&buf, v)
+		v2, n, err := DecodeItem(buf)
+		if err != nil {
+			t.Fatalf("Item: cannot decode the re-encoded bytes: %v", err)
+		}
+		if n != len(buf) {
+			t.Fatalf("Item: decoded %d bytes out of %d", n, len(buf))
+		}
+		var buf2 []byte
+		EncodeItem(&buf2, v2)
+		if !bytes.Equal(buf, buf2) {
+			t.Fatalf("Item: encoded bytes changed after decode->encode->decode")
+		}
+	})
+}
+
+func FuzzDecodeRecord(f *testing.F) {
+	codonFuzzSeeds(f, func(r RandSrc) []byte {
+		var buf []byte
+		EncodeRecord(&buf, RandRecord(r))
+		return buf
+	})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		v, _, err := DecodeRecord(bz)
+		if err != nil {
+			return
+		}
+		var buf []byte
+		EncodeRecord(&buf, v)
+		v2, n, err := DecodeRecord(buf)
+		if err != nil {
+			t.Fatalf("Record: cannot decode the re-encoded bytes: %v", err)
+		}
+		if n != len(buf) {
+			t.Fatalf("Record: decoded %d bytes out of %d", n, len(buf))
+		}
+		var buf2 []byte
+		EncodeRecord(&buf2, v2)
+		if !bytes.Equal(buf, buf2) {
+			t.Fatalf("Record: encoded bytes changed after decode->encode->decode")
+		}
+	})
+}
+
+func FuzzDecodeRecordV1(f *testing.F) {
+	codonFuzzSeeds(f, func(r RandSrc) []byte {
+		var buf []byte
+		EncodeRecordV1(&buf, RandRecordV1(r))
+		return buf
+	})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		v, _, err := DecodeRecordV1(bz)
+		if err != nil {
+			return
+		}
+		var buf []byte
+		EncodeRecordV1(&buf, v)
+		v2, n, err := DecodeRecordV1(buf)
+		if err != nil {
+			t.Fatalf("RecordV1: cannot decode the re-encoded bytes: %v", err)
+		}
+		if n != len(buf) {
+			t.Fatalf("RecordV1: decoded %d bytes out of %d", n, len(buf))
+		}
+		var buf2 []byte
+		EncodeRecordV1(&buf2, v2)
+		if !bytes.Equal(buf, buf2) {
+			t.Fatalf("RecordV1: encoded bytes changed after decode->encode->decode")
+		}
+	})
+}
+
+func FuzzDecodeNote(f *testing.F) {
+	codonFuzzSeeds(f, func(r RandSrc) []byte {
+		var buf []byte
+		EncodeNote(&buf, RandNote(r))
+		return buf
+	})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		v, _, err := DecodeNote(bz)
+		if err != nil {
+			return
+		}
+		var buf []byte
+		EncodeNote(&buf, v)
+		v2, n, err := DecodeNote(buf)
+		if err != nil {
+			t.Fatalf("Note: cannot decode the re-encoded bytes: %v", err)
+		}
+		if n != len(buf) {
+			t.Fatalf("Note: decoded %d bytes out of %d", n, len(buf))
+		}
+		var buf2 []byte
+		EncodeNote(&buf2, v2)
+		if !bytes.Equal(buf, buf2) {
+			t.Fatalf("Note: encoded bytes changed after decode->encode->decode")
+		}
+	})
+}
+
+func FuzzDecodeNoteV1(f *testing.F) {
+	codonFuzzSeeds(f, func(r RandSrc) []byte {
+		var buf []byte
+		EncodeNoteV1(&buf, RandNoteV1(r))
+		return buf
+	})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		v, _, err := DecodeNoteV1(bz)
+		if err != nil {
+			return
+		}
+		var buf []byte
+		EncodeNoteV1(&buf, v)
+		v2, n, err := DecodeNoteV1(buf)
+		if err != nil {
+			t.Fatalf("NoteV1: cannot decode the re-encoded bytes: %v", err)
+		}
+		if n != len(buf) {
+			t.Fatalf("NoteV1: decoded %d bytes out of %d", n, len(buf))
+		}
+		var buf2 []byte
+		EncodeNoteV1(&buf2, v2)
+		if !bytes.Equal(buf, buf2) {
+			t.Fatalf("NoteV1: encoded bytes changed after decode->encode->decode")
+		}
+	})
+}
+
+func FuzzDecodeCatalog(f *testing.F) {
+	codonFuzzSeeds(f, func(r RandSrc) []byte {
+		var buf []byte
+		EncodeCatalog(&buf, RandCatalog(r))
+		return buf
+	})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		v, _, err := DecodeCatalog(bz)
+		if err != nil {
+			return
+		}
+		var buf []byte
+		EncodeCatalog(&buf, v)
+		v2, n, err := DecodeCatalog(buf)
+		if err != nil {
+			t.Fatalf("Catalog: cannot decode the re-encoded bytes: %v", err)
+		}
+		if n != len(buf) {
+			t.Fatalf("Catalog: decoded %d bytes out of %d", n, len(buf))
+		}
+		var buf2 []byte
+		EncodeCatalog(&buf2, v2)
+		if !bytes.Equal(buf, buf2) {
+			t.Fatalf("Catalog: encoded bytes changed after decode->encode->decode")
+		}
+	})
+}
+
+func FuzzDecodeAny(f *testing.F) {
+	codonFuzzSeeds(f, func(r RandSrc) []byte {
+		var buf []byte
+		EncodeAny(&buf, RandAny(r))
+		return buf
+	})
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		v, _, err := DecodeAny(bz)
+		if err != nil {
+			return
+		}
+		var buf []byte
+		EncodeAny(&buf, v)
+		v2, n, err := DecodeAny(buf)
+		if err != nil {
+			t.Fatalf("Any: cannot decode the re-encoded bytes: %v", err)
+		}
+		if n != len(buf) {
+			t.Fatalf("Any: decoded %d bytes out of %d", n, len(buf))
+		}
+		var buf2 []byte
+		EncodeAny(&buf2, v2)
+		if !bytes.Equal(buf, buf2) {
+			t.Fatalf("Any: encoded bytes changed after decode->encode->decode")
+		}
+	})
+}
@@ -0,0 +1,188 @@
+package codec
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func newRandSrc(seed int64) RandSrc {
+	input := make([]byte, 1<<20)
+	rand.New(rand.NewSource(seed)).Read(input)
+	return &codonFuzzSrc{bz: input}
+}
+
+func TestRoundTripSelfTest(t *testing.T) {
+	if err := RoundTripSelfTest(newRandSrc(1), 200); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// the same as the generator's calculation from the alias and the name
+func magicNum(alias, name string) uint64 {
+	sum := sha256.Sum256([]byte(alias + name))
+	return binary.LittleEndian.Uint64(sum[:8])%(536_870_911-20000) + 20000
+}
+
+func TestZigzagAndMagicNumbers(t *testing.T) {
+	var buf []byte
+	EncodeItem(&buf, Item{Name: "a", Count: -1})
+	if want := []byte{0x0a, 1, 'a', 0x10, 1}; !bytes.Equal(buf, want) {
+		t.Fatalf("EncodeItem writes %x, want %x", buf, want)
+	}
+
+	buf = buf[:0]
+	EncodeAny(&buf, Square{Side: -2})
+	tag, n := binary.Uvarint(buf)
+	if tag != magicNum("Square", "Square")<<3|2 {
+		t.Fatalf("EncodeAny writes the tag %d for Square, want the magic number %d", tag>>3, magicNum("Square", "Square"))
+	}
+	if want := []byte{1 << 3, 3}; !bytes.Equal(buf[n+1:], want) {
+		t.Fatalf("EncodeAny writes %x for Square{Side: -2}, want %x", buf[n+1:], want)
+	}
+	v, _, err := DecodeAny(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != (Square{Side: -2}) {
+		t.Fatalf("DecodeAny returns %+v", v)
+	}
+}
+
+func sampleRecord() Record {
+	return Record{
+		ID:     300,
+		Title:  "title",
+		Data:   []byte("data"),
+		Shapes: []Shape{Square{Side: -2}, &Circle{Radius: 3}},
+	}
+}
+
+// the members are written in their order in the struct, but with the pinned field numbers
+func TestPinnedFieldNumbers(t *testing.T) {
+	var buf []byte
+	EncodeRecord(&buf, sampleRecord())
+	if want := []byte{4 << 3, 0xac, 0x02, 1<<3 | 2, 5}; !bytes.HasPrefix(buf, want) {
+		t.Fatalf("EncodeRecord writes %x, want the prefix %x", buf, want)
+	}
+	id, err := PeekRecord_ID(buf)
+	if err != nil || id != 300 {
+		t.Fatalf("PeekRecord_ID returns %d, %v", id, err)
+	}
+	title, err := PeekRecord_Title(buf)
+	if err != nil || title != "title" {
+		t.Fatalf("PeekRecord_Title returns %q, %v", title, err)
+	}
+}
+
+func TestUnknownFieldsAreKept(t *testing.T) {
+	record := sampleRecord()
+	var buf []byte
+	EncodeRecord(&buf, record)
+	old, _, err := DecodeRecordV1(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if old.Title != record.Title || len(old.XXX_unrecognized) == 0 {
+		t.Fatalf("DecodeRecordV1 returns %+v", old)
+	}
+	buf = buf[:0]
+	EncodeRecordV1(&buf, old)
+	v, _, err := DecodeRecord(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !EqualRecord(v, record) {
+		t.Fatalf("%+v changes to %+v after being decoded and encoded as RecordV1", record, v)
+	}
+
+	// XXX_unrecognized does not shift the number of Note.Body
+	note := Note{Title: "title", Body: "body"}
+	buf = buf[:0]
+	EncodeNote(&buf, note)
+	oldNote, _, err := DecodeNoteV1(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf = buf[:0]
+	EncodeNoteV1(&buf, oldNote)
+	if v, _, err := DecodeNote(buf); err != nil || !EqualNote(v, note) {
+		t.Fatalf("%+v changes to %+v (%v) after being decoded and encoded as NoteV1", note, v, err)
+	}
+}
+
+func TestDecodeNoCopy(t *testing.T) {
+	var buf []byte
+	EncodeRecord(&buf, sampleRecord())
+	v, _, err := DecodeRecordNoCopy(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !EqualRecord(v, sampleRecord()) {
+		t.Fatalf("DecodeRecordNoCopy returns %+v", v)
+	}
+	if cap(v.Data) != len(v.Data) {
+		t.Fatalf("the capacity of the decoded bytes is %d, larger than their length %d", cap(v.Data), len(v.Data))
+	}
+	// the decoded bytes refer to buf
+	pos := bytes.Index(buf, []byte("data"))
+	buf[pos] = 'D'
+	if string(v.Data) != "Data" {
+		t.Fatalf("DecodeRecordNoCopy copies the bytes")
+	}
+	if v, _, _ = DecodeRecord(buf); string(v.Data) != "Data" {
+		t.Fatalf("DecodeRecord returns %q", v.Data)
+	}
+	v.Data[0] = 'd'
+	if buf[pos] != 'D' {
+		t.Fatalf("DecodeRecord does not copy the bytes")
+	}
+}
+
+func sampleCatalog() Catalog {
+	return Catalog{
+		Items:  map[string]Item{"b": {Name: "bar", Count: -2}, "a": {Name: "foo", Count: 1}},
+		Ptrs:   map[int32]*Item{-1: {Name: "neg", Count: -1}, 7: {Name: "pos", Count: 7}},
+		Shapes: map[uint64]Shape{1: Square{Side: 1}, 2: &Circle{Radius: -1}},
+		Small:  -128,
+		Deltas: []int16{-1, 0, 1, -32768},
+	}
+}
+
+func TestMapsOfStructsAndPointers(t *testing.T) {
+	catalog := sampleCatalog()
+	var buf, again []byte
+	EncodeCatalog(&buf, catalog)
+	// the keys are sorted, so the bytes do not depend on the order of iteration
+	for i := 0; i < 10; i++ {
+		again = again[:0]
+		EncodeCatalog(&again, sampleCatalog())
+		if !bytes.Equal(buf, again) {
+			t.Fatalf("EncodeCatalog writes different bytes for the same value")
+		}
+	}
+	v, _, err := DecodeCatalog(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !EqualCatalog(v, catalog) || !reflect.DeepEqual(v, catalog) {
+		t.Fatalf("%+v changes to %+v after a round trip", catalog, v)
+	}
+	ptrs, err := PeekCatalog_Ptrs(buf)
+	if err != nil || !reflect.DeepEqual(ptrs, catalog.Ptrs) {
+		t.Fatalf("PeekCatalog_Ptrs returns %+v, %v", ptrs, err)
+	}
+
+	// DeepCopy does not share the pointers
+	c := DeepCopyCatalog(catalog)
+	c.Ptrs[7].Count = 8
+	if catalog.Ptrs[7].Count != 7 {
+		t.Fatalf("DeepCopyCatalog shares the pointers in the map")
+	}
+	if EqualCatalog(c, catalog) {
+		t.Fatalf("EqualCatalog does not compare the values of the pointers")
+	}
+}
@@ -0,0 +1,21 @@
+package codec
+
+import "github.com/coinexchain/codon/internal/fixture/plain"
+
+type (
+	Shape    = plain.Shape
+	Square   = plain.Square
+	Circle   = plain.Circle
+	Item     = plain.Item
+	Record   = plain.Record
+	RecordV1 = plain.RecordV1
+	Note     = plain.Note
+	NoteV1   = plain.NoteV1
+	Catalog  = plain.Catalog
+)
+
+// The limits used by the Rand functions
+const (
+	MaxStringLength = 10
+	MaxSliceLength  = 5
+)
@@ -0,0 +1,63 @@
+// Package plain contains the types of the second fixture, whose codec is generated with the default
+// encoding options: zigzag signed integers and magic numbers calculated with sha256. The unknown
+// fields are skipped and kept in XXX_unrecognized.
+package plain
+
+// Shape is implemented by Square and Circle
+type Shape interface {
+	Area() int64
+}
+
+type Square struct {
+	Side int32
+}
+
+func (s Square) Area() int64 { return int64(s.Side) * int64(s.Side) }
+
+type Circle struct {
+	Radius int64
+}
+
+func (c *Circle) Area() int64 { return 3 * c.Radius * c.Radius }
+
+type Item struct {
+	Name  string
+	Count int64
+}
+
+// Record has pinned field numbers, which differ from the positions of its members
+type Record struct {
+	ID               uint64 `codon:"4"`
+	XXX_unrecognized []byte
+	Title            string  `codon:"1"`
+	Data             []byte  `codon:"2"`
+	Shapes           []Shape `codon:"3"`
+}
+
+// RecordV1 is an old version of Record, which does not know the fields 2, 3 and 4
+type RecordV1 struct {
+	Title            string `codon:"1"`
+	XXX_unrecognized []byte
+}
+
+// Note has no tags, and XXX_unrecognized does not shift the number of Body
+type Note struct {
+	Title            string
+	XXX_unrecognized []byte
+	Body             string
+}
+
+// NoteV1 is an old version of Note, which does not know Body
+type NoteV1 struct {
+	Title            string
+	XXX_unrecognized []byte
+}
+
+// Catalog has maps whose values are structs and pointers
+type Catalog struct {
+	Items  map[string]Item
+	Ptrs   map[int32]*Item
+	Shapes map[uint64]Shape
+	Small  int8
+	Deltas []int16
+}
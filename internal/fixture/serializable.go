@@ -0,0 +1,2610 @@
+// Code generated by codon. DO NOT EDIT.
+
+//nolint:all
+package fixture
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"math"
+	"sort"
+	"unsafe"
+)
+
+type RandSrc interface {
+	GetBool() bool
+	GetInt() int
+	GetInt8() int8
+	GetInt16() int16
+	GetInt32() int32
+	GetInt64() int64
+	GetUint() uint
+	GetUint8() uint8
+	GetUint16() uint16
+	GetUint32() uint32
+	GetUint64() uint64
+	GetFloat32() float32
+	GetFloat64() float64
+	GetString(n int) string
+	GetBytes(n int) []byte
+}
+
+func codonWriteVarint(w *[]byte, v int64) {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(buf[:], v)
+	*w = append(*w, buf[0:n]...)
+}
+func codonWriteUvarint(w *[]byte, v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	*w = append(*w, buf[0:n]...)
+}
+
+func codonWriteBool(w *[]byte, v bool) {
+	if v {
+		codonWriteUvarint(w, uint64(1))
+	} else {
+		codonWriteUvarint(w, uint64(0))
+	}
+}
+func codonWriteFloat32(w *[]byte, v float32) {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], math.Float32bits(v))
+	*w = append(*w, buf[:]...)
+}
+func codonWriteFloat64(w *[]byte, v float64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], math.Float64bits(v))
+	*w = append(*w, buf[:]...)
+}
+
+func codonEncodeBool(n int, w *[]byte, v bool) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteBool(w, v)
+}
+func codonEncodeVarint(n int, w *[]byte, v int64) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteVarint(w, int64(v))
+}
+func codonEncodeInt8(n int, w *[]byte, v int8) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteVarint(w, int64(v))
+}
+func codonEncodeInt16(n int, w *[]byte, v int16) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteVarint(w, int64(v))
+}
+func codonEncodeUvarint(n int, w *[]byte, v uint64) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteUvarint(w, v)
+}
+func codonEncodeUint8(n int, w *[]byte, v uint8) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteUvarint(w, uint64(v))
+}
+func codonEncodeUint16(n int, w *[]byte, v uint16) {
+	codonWriteUvarint(w, uint64(n)<<3)
+	codonWriteUvarint(w, uint64(v))
+}
+
+func codonEncodeByteSlice(n int, w *[]byte, v []byte) {
+	codonWriteUvarint(w, (uint64(n)<<3)|2)
+	codonWriteUvarint(w, uint64(len(v)))
+	*w = append(*w, v...)
+}
+func codonEncodeString(n int, w *[]byte, v string) {
+	codonEncodeByteSlice(n, w, []byte(v))
+}
+func codonEncodeFloat32(n int, w *[]byte, v float32) {
+	codonWriteUvarint(w, (uint64(n)<<3)|5)
+	codonWriteFloat32(w, v)
+}
+func codonEncodeFloat64(n int, w *[]byte, v float64) {
+	codonWriteUvarint(w, (uint64(n)<<3)|1)
+	codonWriteFloat64(w, v)
+}
+
+// writes the tag and the length prefix of a length-delimited field, whose content must follow
+func codonEncodeLength(n int, w *[]byte, length int) {
+	codonWriteUvarint(w, (uint64(n)<<3)|2)
+	codonWriteUvarint(w, uint64(length))
+}
+func codonUvarintSize(v uint64) int {
+	n := 1
+	for v >= 0x80 {
+		v >>= 7
+		n++
+	}
+	return n
+}
+func codonVarintSize(v int64) int {
+	uv := uint64(v) << 1
+	if v < 0 {
+		uv = ^uv
+	}
+	return codonUvarintSize(uv)
+}
+
+// the size of a length-delimited field's content plus its length prefix, excluding the tag
+func codonByteSliceSize(length int) int {
+	return codonUvarintSize(uint64(length)) + length
+}
+
+// codonSizes records the sizes of nested messages in the order they are encoded, such that
+// the length prefixes can be written from the precomputed sizes. A nil *codonSizes records nothing.
+// When out is not nil, the encoded bytes are streamed into it in chunks by flush, and the first
+// error returned by out is kept in err.
+type codonSizes struct {
+	list []int
+	pos  int
+	out  io.Writer
+	err  error
+}
+
+func (s *codonSizes) reserve() int {
+	if s == nil {
+		return 0
+	}
+	s.list = append(s.list, 0)
+	return len(s.list) - 1
+}
+func (s *codonSizes) set(idx, size int) int {
+	if s != nil {
+		s.list[idx] = size
+	}
+	return size
+}
+func (s *codonSizes) next() int {
+	size := s.list[s.pos]
+	s.pos++
+	return size
+}
+
+const codonChunkSize = 4096
+
+func (s *codonSizes) flush(w *[]byte) {
+	if s.out != nil && len(*w) >= codonChunkSize {
+		if s.err == nil {
+			_, s.err = s.out.Write(*w)
+		}
+		*w = (*w)[:0]
+	}
+}
+
+// makes sure n more bytes can be appended to w without reallocation
+func codonGrow(w *[]byte, n int) {
+	if cap(*w)-len(*w) < n {
+		buf := make([]byte, len(*w), len(*w)+n)
+		copy(buf, *w)
+		*w = buf
+	}
+}
+
+// keys must be a slice of map keys, which are sorted before encoding the map
+func codonSortKeys(keys interface{}, less func(i, j int) bool) {
+	sort.Slice(keys, less)
+}
+func codonDecodeBool(bz []byte, n *int, err *error) bool {
+	return codonDecodeInt64(bz, n, err) != 0
+}
+func codonDecodeInt(bz []byte, n *int, err *error) int {
+	return int(codonDecodeInt64(bz, n, err))
+}
+func codonDecodeInt8(bz []byte, n *int, err *error) int8 {
+	return int8(codonDecodeInt64(bz, n, err))
+}
+func codonDecodeInt16(bz []byte, n *int, err *error) int16 {
+	return int16(codonDecodeInt64(bz, n, err))
+}
+func codonDecodeInt32(bz []byte, n *int, err *error) int32 {
+	return int32(codonDecodeInt64(bz, n, err))
+}
+func codonDecodeInt64(bz []byte, m *int, err *error) int64 {
+	i, n := binary.Varint(bz)
+	if n == 0 {
+		// buf too small
+		*err = errors.New("buffer too small")
+	} else if n < 0 {
+		// value larger than 64 bits (overflow)
+		// and -n is the number of bytes read
+		n = -n
+		*err = errors.New("EOF decoding varint")
+	}
+	*m = n
+	return int64(i)
+}
+func codonDecodeUint(bz []byte, n *int, err *error) uint {
+	return uint(codonDecodeUint64(bz, n, err))
+}
+func codonDecodeUint8(bz []byte, n *int, err *error) uint8 {
+	return uint8(codonDecodeUint64(bz, n, err))
+}
+func codonDecodeUint16(bz []byte, n *int, err *error) uint16 {
+	return uint16(codonDecodeUint64(bz, n, err))
+}
+func codonDecodeUint32(bz []byte, n *int, err *error) uint32 {
+	return uint32(codonDecodeUint64(bz, n, err))
+}
+func codonDecodeUint64(bz []byte, m *int, err *error) uint64 {
+	i, n := binary.Uvarint(bz)
+	if n == 0 {
+		// buf too small
+		*err = errors.New("buffer too small")
+	} else if n < 0 {
+		// value larger than 64 bits (overflow)
+		// and -n is the number of bytes read
+		n = -n
+		*err = errors.New("EOF decoding varint")
+	}
+	*m = n
+	return uint64(i)
+}
+func codonDecodeFloat32(bz []byte, n *int, err *error) float32 {
+	if len(bz) < 4 {
+		*err = errors.New("Not enough bytes to read")
+		return 0
+	}
+	*n = 4
+	return math.Float32frombits(binary.LittleEndian.Uint32(bz[:4]))
+}
+func codonDecodeFloat64(bz []byte, n *int, err *error) float64 {
+	if len(bz) < 8 {
+		*err = errors.New("Not enough bytes to read")
+		return 0
+	}
+	*n = 8
+	return math.Float64frombits(binary.LittleEndian.Uint64(bz[:8]))
+}
+
+// Returns how many bytes a field's value occupies, according to its wire type
+func codonSkipField(bz []byte, wireType int) (int, error) {
+	switch wireType {
+	case 0: // varint
+		_, n := binary.Uvarint(bz)
+		if n <= 0 {
+			return 0, errors.New("EOF decoding varint")
+		}
+		return n, nil
+	case 1: // fixed64
+		if len(bz) < 8 {
+			return 0, errors.New("Not enough bytes to read")
+		}
+		return 8, nil
+	case 2: // length-delimited
+		length, n := binary.Uvarint(bz)
+		if n <= 0 {
+			return 0, errors.New("EOF decoding varint")
+		}
+		if uint64(len(bz)-n) < length {
+			return 0, errors.New("Not enough bytes to read")
+		}
+		return n + int(length), nil
+	case 5: // fixed32
+		if len(bz) < 4 {
+			return 0, errors.New("Not enough bytes to read")
+		}
+		return 4, nil
+	default:
+		return 0, errors.New("Unknown wire type")
+	}
+}
+func codonGetByteSlice(res *[]byte, bz []byte) (int, error) {
+	length, n := binary.Uvarint(bz)
+	if n == 0 {
+		// buf too small
+		return n, errors.New("buffer too small")
+	} else if n < 0 {
+		// value larger than 64 bits (overflow)
+		// and -n is the number of bytes read
+		n = -n
+		return n, errors.New("EOF decoding varint")
+	}
+	if length == 0 {
+		*res = nil
+		return n, nil
+	}
+	bz = bz[n:]
+	if uint64(len(bz)) < length {
+		*res = nil
+		return 0, errors.New("Not enough bytes to read")
+	}
+	if *res == nil {
+		*res = append(*res, bz[:length]...)
+	} else {
+		*res = append((*res)[:0], bz[:length]...)
+	}
+	return n + int(length), nil
+}
+
+// the same as codonGetByteSlice, except that res refers to bz instead of a copy. Its capacity is
+// limited, such that appending to it does not overwrite bz.
+func codonGetByteSliceNoCopy(res *[]byte, bz []byte) (int, error) {
+	length, n := binary.Uvarint(bz)
+	if n == 0 {
+		return n, errors.New("buffer too small")
+	} else if n < 0 {
+		n = -n
+		return n, errors.New("EOF decoding varint")
+	}
+	if length == 0 {
+		*res = nil
+		return n, nil
+	}
+	bz = bz[n:]
+	if uint64(len(bz)) < length {
+		*res = nil
+		return 0, errors.New("Not enough bytes to read")
+	}
+	*res = bz[:length:length]
+	return n + int(length), nil
+}
+func codonDecodeString(bz []byte, n *int, err *error) string {
+	var res []byte
+	*n, *err = codonGetByteSlice(&res, bz)
+	return string(res)
+}
+
+// DecodeOptions limits the resources used by the decoders. A zero member means no limit.
+type DecodeOptions struct {
+	// The maximum length of the decoded bytes
+	MaxTotalBytes int
+	// The maximum nesting depth of the structs
+	MaxDepth int
+	// The maximum number of elements in a repeated field or a map
+	MaxSliceLength int
+	// The maximum length of a string or a byte slice
+	MaxBytesLength int
+}
+
+// ErrLimitExceeded is returned when the decoded bytes break a limit in DecodeOptions
+type ErrLimitExceeded struct {
+	// The name of the member in DecodeOptions, such as "MaxDepth"
+	Limit  string
+	Max    int
+	Actual int
+}
+
+func (e *ErrLimitExceeded) Error() string {
+	return fmt.Sprintf("%s is exceeded: %d > %d", e.Limit, e.Actual, e.Max)
+}
+
+// codonDecoder checks DecodeOptions during decoding. A nil *codonDecoder checks nothing.
+// In the noCopy mode, the decoded byte slices and strings share memory with the input.
+type codonDecoder struct {
+	opts   DecodeOptions
+	depth  int
+	noCopy bool
+}
+
+func newCodonDecoder(bz []byte, opts DecodeOptions) (*codonDecoder, error) {
+	if opts.MaxTotalBytes > 0 && len(bz) > opts.MaxTotalBytes {
+		return nil, &ErrLimitExceeded{Limit: "MaxTotalBytes", Max: opts.MaxTotalBytes, Actual: len(bz)}
+	}
+	return &codonDecoder{opts: opts}, nil
+}
+func (d *codonDecoder) enter() error {
+	if d == nil {
+		return nil
+	}
+	d.depth++
+	if d.opts.MaxDepth > 0 && d.depth > d.opts.MaxDepth {
+		return &ErrLimitExceeded{Limit: "MaxDepth", Max: d.opts.MaxDepth, Actual: d.depth}
+	}
+	return nil
+}
+func (d *codonDecoder) leave() {
+	if d != nil {
+		d.depth--
+	}
+}
+func (d *codonDecoder) checkSliceLength(length int) error {
+	if d != nil && d.opts.MaxSliceLength > 0 && length > d.opts.MaxSliceLength {
+		return &ErrLimitExceeded{Limit: "MaxSliceLength", Max: d.opts.MaxSliceLength, Actual: length}
+	}
+	return nil
+}
+
+// the length is checked before the bytes are copied
+func (d *codonDecoder) getByteSlice(res *[]byte, bz []byte) (int, error) {
+	if d != nil && d.opts.MaxBytesLength > 0 {
+		length, n := binary.Uvarint(bz)
+		if n > 0 && length > uint64(d.opts.MaxBytesLength) {
+			return n, &ErrLimitExceeded{Limit: "MaxBytesLength", Max: d.opts.MaxBytesLength, Actual: int(length)}
+		}
+	}
+	if d != nil && d.noCopy {
+		return codonGetByteSliceNoCopy(res, bz)
+	}
+	return codonGetByteSlice(res, bz)
+}
+func (d *codonDecoder) decodeString(bz []byte, n *int, err *error) string {
+	var res []byte
+	*n, *err = d.getByteSlice(&res, bz)
+	if d != nil && d.noCopy {
+		return unsafe.String(unsafe.SliceData(res), len(res))
+	}
+	return string(res)
+}
+
+// Non-Interface
+func EncodeCoin(w *[]byte, v Coin) {
+	s := &codonSizes{}
+	codonGrow(w, sizeCoin(v, s))
+	encodeCoin(w, v, s)
+}
+func HashCoin(h hash.Hash, v Coin) {
+	s := &codonSizes{out: h}
+	sizeCoin(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeCoin(&w, v, s)
+	h.Write(w)
+}
+func encodeCoin(w *[]byte, v Coin, s *codonSizes) {
+	codonEncodeString(1, w, v.Denom)
+	s.flush(w)
+	codonEncodeUvarint(2, w, uint64(v.Amount))
+} //End of EncodeCoin
+
+func SizeCoin(v Coin) int {
+	return sizeCoin(v, nil)
+}
+func sizeCoin(v Coin, s *codonSizes) (total int) {
+	total += 1 + codonByteSliceSize(len(v.Denom))
+	total += 1 + codonUvarintSize(uint64(v.Amount))
+	return
+} //End of SizeCoin
+
+func DecodeCoin(bz []byte) (Coin, int, error) {
+	return decodeCoin(bz, nil)
+}
+func DecodeCoinNoCopy(bz []byte) (Coin, int, error) {
+	return decodeCoin(bz, &codonDecoder{noCopy: true})
+}
+func DecodeCoinWithOptions(bz []byte, opts DecodeOptions) (v Coin, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeCoin(bz, d)
+}
+func decodeCoin(bz []byte, d *codonDecoder) (v Coin, total int, err error) {
+	var n int
+	if err = d.enter(); err != nil {
+		return
+	}
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Denom
+			v.Denom = string(d.decodeString(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 2: // v.Amount
+			v.Amount = int64(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			err = errors.New("Unknown Field")
+			return
+		}
+	} // end for
+	d.leave()
+	return v, total, nil
+} //End of DecodeCoin
+
+func PeekCoin_Denom(bz []byte) (res string, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Denom
+			res = string(d.decodeString(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekCoin_Denom
+
+func PeekCoin_Amount(bz []byte) (res int64, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 2: // v.Amount
+			res = int64(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekCoin_Amount
+
+func RandCoin(r RandSrc) Coin {
+	var v Coin
+	v.Denom = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	v.Amount = r.GetInt64()
+	return v
+} //End of RandCoin
+
+func DeepCopyCoin(in Coin) (out Coin) {
+	out.Denom = in.Denom
+	out.Amount = in.Amount
+	return
+} //End of DeepCopyCoin
+
+func EqualCoin(a, b Coin) bool {
+	if a.Denom != b.Denom {
+		return false
+	}
+	if a.Amount != b.Amount {
+		return false
+	}
+	return true
+} //End of EqualCoin
+
+func (ptr *Coin) ToBytes() []byte {
+	var wBuf []byte
+	EncodeCoin(&wBuf, *ptr)
+	return wBuf
+}
+
+func (ptr *Coin) FromBytes(bz []byte) {
+	var total int
+	var err error
+	*ptr, total, err = DecodeCoin(bz)
+	if total != len(bz) {
+		panic("Length Mismatch During Decoding")
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (ptr *Coin) DeepCopy() interface{} {
+	return DeepCopyCoin(*ptr)
+}
+
+// Non-Interface
+func EncodeFee(w *[]byte, v Fee) {
+	s := &codonSizes{}
+	codonGrow(w, sizeFee(v, s))
+	encodeFee(w, v, s)
+}
+func HashFee(h hash.Hash, v Fee) {
+	s := &codonSizes{out: h}
+	sizeFee(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeFee(&w, v, s)
+	h.Write(w)
+}
+func encodeFee(w *[]byte, v Fee, s *codonSizes) {
+	for _0 := 0; _0 < len(v.Amount); _0++ {
+		codonEncodeLength(1, w, s.next())
+		codonEncodeString(1, w, v.Amount[_0].Denom)
+		s.flush(w)
+		codonEncodeUvarint(2, w, uint64(v.Amount[_0].Amount))
+		// end of v.Amount[_0]
+	}
+	s.flush(w)
+	codonEncodeUvarint(2, w, uint64(v.Gas))
+} //End of EncodeFee
+
+func SizeFee(v Fee) int {
+	return sizeFee(v, nil)
+}
+func sizeFee(v Fee, s *codonSizes) (total int) {
+	for _0 := 0; _0 < len(v.Amount); _0++ {
+		{
+			idx := s.reserve()
+			total += 1 + codonByteSliceSize(s.set(idx, sizeCoin(v.Amount[_0], s)))
+		}
+	}
+	total += 1 + codonUvarintSize(uint64(v.Gas))
+	return
+} //End of SizeFee
+
+func DecodeFee(bz []byte) (Fee, int, error) {
+	return decodeFee(bz, nil)
+}
+func DecodeFeeNoCopy(bz []byte) (Fee, int, error) {
+	return decodeFee(bz, &codonDecoder{noCopy: true})
+}
+func DecodeFeeWithOptions(bz []byte, opts DecodeOptions) (v Fee, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeFee(bz, d)
+}
+func decodeFee(bz []byte, d *codonDecoder) (v Fee, total int, err error) {
+	var n int
+	if err = d.enter(); err != nil {
+		return
+	}
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Amount
+			if err = d.checkSliceLength(len(v.Amount) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var tmp Coin
+			tmp, n, err = decodeCoin(bz[:l], d)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if int(l) != n {
+				err = errors.New("Length Mismatch")
+				return
+			}
+			v.Amount = append(v.Amount, tmp)
+		case 2: // v.Gas
+			v.Gas = uint64(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			err = errors.New("Unknown Field")
+			return
+		}
+	} // end for
+	d.leave()
+	return v, total, nil
+} //End of DecodeFee
+
+func PeekFee_Amount(bz []byte) (res []Coin, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Amount
+			if err = d.checkSliceLength(len(res) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var tmp Coin
+			tmp, n, err = decodeCoin(bz[:l], d)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if int(l) != n {
+				err = errors.New("Length Mismatch")
+				return
+			}
+			res = append(res, tmp)
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekFee_Amount
+
+func PeekFee_Gas(bz []byte) (res uint64, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 2: // v.Gas
+			res = uint64(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekFee_Gas
+
+func RandFee(r RandSrc) Fee {
+	var length int
+	var v Fee
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	if length == 0 {
+		v.Amount = nil
+	} else {
+		v.Amount = make([]Coin, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.Amount[_0] = RandCoin(r)
+	}
+	v.Gas = r.GetUint64()
+	return v
+} //End of RandFee
+
+func DeepCopyFee(in Fee) (out Fee) {
+	var length int
+	length = len(in.Amount)
+	if length == 0 {
+		out.Amount = nil
+	} else {
+		out.Amount = make([]Coin, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		out.Amount[_0] = DeepCopyCoin(in.Amount[_0])
+	}
+	out.Gas = in.Gas
+	return
+} //End of DeepCopyFee
+
+func EqualFee(a, b Fee) bool {
+	if len(a.Amount) != len(b.Amount) {
+		return false
+	}
+	for _0 := range a.Amount { //slice of struct
+		if a.Amount[_0].Denom != b.Amount[_0].Denom {
+			return false
+		}
+		if a.Amount[_0].Amount != b.Amount[_0].Amount {
+			return false
+		}
+		// end of .Amount[_0]
+	}
+	if a.Gas != b.Gas {
+		return false
+	}
+	return true
+} //End of EqualFee
+
+func (ptr *Fee) ToBytes() []byte {
+	var wBuf []byte
+	EncodeFee(&wBuf, *ptr)
+	return wBuf
+}
+
+func (ptr *Fee) FromBytes(bz []byte) {
+	var total int
+	var err error
+	*ptr, total, err = DecodeFee(bz)
+	if total != len(bz) {
+		panic("Length Mismatch During Decoding")
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (ptr *Fee) DeepCopy() interface{} {
+	return DeepCopyFee(*ptr)
+}
+
+// Non-Interface
+func EncodeMsgSend(w *[]byte, v MsgSend) {
+	s := &codonSizes{}
+	codonGrow(w, sizeMsgSend(v, s))
+	encodeMsgSend(w, v, s)
+}
+func HashMsgSend(h hash.Hash, v MsgSend) {
+	s := &codonSizes{out: h}
+	sizeMsgSend(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeMsgSend(&w, v, s)
+	h.Write(w)
+}
+func encodeMsgSend(w *[]byte, v MsgSend, s *codonSizes) {
+	codonEncodeByteSlice(1, w, v.From[:])
+	s.flush(w)
+	codonEncodeByteSlice(2, w, v.To[:])
+	s.flush(w)
+	for _0 := 0; _0 < len(v.Amount); _0++ {
+		codonEncodeLength(3, w, s.next())
+		codonEncodeString(1, w, v.Amount[_0].Denom)
+		s.flush(w)
+		codonEncodeUvarint(2, w, uint64(v.Amount[_0].Amount))
+		// end of v.Amount[_0]
+	}
+	s.flush(w)
+} //End of EncodeMsgSend
+
+func SizeMsgSend(v MsgSend) int {
+	return sizeMsgSend(v, nil)
+}
+func sizeMsgSend(v MsgSend, s *codonSizes) (total int) {
+	total += 1 + codonByteSliceSize(len(v.From))
+	total += 1 + codonByteSliceSize(len(v.To))
+	for _0 := 0; _0 < len(v.Amount); _0++ {
+		{
+			idx := s.reserve()
+			total += 1 + codonByteSliceSize(s.set(idx, sizeCoin(v.Amount[_0], s)))
+		}
+	}
+	return
+} //End of SizeMsgSend
+
+func DecodeMsgSend(bz []byte) (MsgSend, int, error) {
+	return decodeMsgSend(bz, nil)
+}
+func DecodeMsgSendNoCopy(bz []byte) (MsgSend, int, error) {
+	return decodeMsgSend(bz, &codonDecoder{noCopy: true})
+}
+func DecodeMsgSendWithOptions(bz []byte, opts DecodeOptions) (v MsgSend, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeMsgSend(bz, d)
+}
+func decodeMsgSend(bz []byte, d *codonDecoder) (v MsgSend, total int, err error) {
+	var n int
+	if err = d.enter(); err != nil {
+		return
+	}
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.From
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			v.From = tmpBz
+		case 2: // v.To
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			v.To = tmpBz
+		case 3: // v.Amount
+			if err = d.checkSliceLength(len(v.Amount) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var tmp Coin
+			tmp, n, err = decodeCoin(bz[:l], d)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if int(l) != n {
+				err = errors.New("Length Mismatch")
+				return
+			}
+			v.Amount = append(v.Amount, tmp)
+		default:
+			err = errors.New("Unknown Field")
+			return
+		}
+	} // end for
+	d.leave()
+	return v, total, nil
+} //End of DecodeMsgSend
+
+func PeekMsgSend_From(bz []byte) (res []uint8, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.From
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			res = tmpBz
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekMsgSend_From
+
+func PeekMsgSend_To(bz []byte) (res []uint8, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 2: // v.To
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			res = tmpBz
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekMsgSend_To
+
+func PeekMsgSend_Amount(bz []byte) (res []Coin, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 3: // v.Amount
+			if err = d.checkSliceLength(len(res) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var tmp Coin
+			tmp, n, err = decodeCoin(bz[:l], d)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if int(l) != n {
+				err = errors.New("Length Mismatch")
+				return
+			}
+			res = append(res, tmp)
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekMsgSend_Amount
+
+func RandMsgSend(r RandSrc) MsgSend {
+	var length int
+	var v MsgSend
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.From = r.GetBytes(length)
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.To = r.GetBytes(length)
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	if length == 0 {
+		v.Amount = nil
+	} else {
+		v.Amount = make([]Coin, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.Amount[_0] = RandCoin(r)
+	}
+	return v
+} //End of RandMsgSend
+
+func DeepCopyMsgSend(in MsgSend) (out MsgSend) {
+	var length int
+	length = len(in.From)
+	if length == 0 {
+		out.From = nil
+	} else {
+		out.From = make([]uint8, length)
+	}
+	copy(out.From[:], in.From[:])
+	length = len(in.To)
+	if length == 0 {
+		out.To = nil
+	} else {
+		out.To = make([]uint8, length)
+	}
+	copy(out.To[:], in.To[:])
+	length = len(in.Amount)
+	if length == 0 {
+		out.Amount = nil
+	} else {
+		out.Amount = make([]Coin, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		out.Amount[_0] = DeepCopyCoin(in.Amount[_0])
+	}
+	return
+} //End of DeepCopyMsgSend
+
+func EqualMsgSend(a, b MsgSend) bool {
+	if !bytes.Equal(a.From, b.From) {
+		return false
+	}
+	if !bytes.Equal(a.To, b.To) {
+		return false
+	}
+	if len(a.Amount) != len(b.Amount) {
+		return false
+	}
+	for _0 := range a.Amount { //slice of struct
+		if a.Amount[_0].Denom != b.Amount[_0].Denom {
+			return false
+		}
+		if a.Amount[_0].Amount != b.Amount[_0].Amount {
+			return false
+		}
+		// end of .Amount[_0]
+	}
+	return true
+} //End of EqualMsgSend
+
+func (ptr *MsgSend) ToBytes() []byte {
+	var wBuf []byte
+	EncodeMsgSend(&wBuf, *ptr)
+	return wBuf
+}
+
+func (ptr *MsgSend) FromBytes(bz []byte) {
+	var total int
+	var err error
+	*ptr, total, err = DecodeMsgSend(bz)
+	if total != len(bz) {
+		panic("Length Mismatch During Decoding")
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (ptr *MsgSend) DeepCopy() interface{} {
+	return DeepCopyMsgSend(*ptr)
+}
+
+// Non-Interface
+func EncodeMsgVote(w *[]byte, v MsgVote) {
+	s := &codonSizes{}
+	codonGrow(w, sizeMsgVote(v, s))
+	encodeMsgVote(w, v, s)
+}
+func HashMsgVote(h hash.Hash, v MsgVote) {
+	s := &codonSizes{out: h}
+	sizeMsgVote(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeMsgVote(&w, v, s)
+	h.Write(w)
+}
+func encodeMsgVote(w *[]byte, v MsgVote, s *codonSizes) {
+	codonEncodeByteSlice(1, w, v.Voter[:])
+	s.flush(w)
+	codonEncodeUvarint(2, w, uint64(v.Proposal))
+	if len(v.Options) != 0 {
+		codonEncodeLength(3, w, s.next())
+		for _0 := 0; _0 < len(v.Options); _0++ {
+			codonWriteUvarint(w, uint64(v.Options[_0]))
+		}
+	} // end of packed v.Options
+	s.flush(w)
+	codonEncodeBool(4, w, v.Yes)
+	codonEncodeUvarint(5, w, uint64(v.Weight))
+} //End of EncodeMsgVote
+
+func SizeMsgVote(v MsgVote) int {
+	return sizeMsgVote(v, nil)
+}
+func sizeMsgVote(v MsgVote, s *codonSizes) (total int) {
+	total += 1 + codonByteSliceSize(len(v.Voter))
+	total += 1 + codonUvarintSize(uint64(v.Proposal))
+	if len(v.Options) != 0 {
+		{
+			idx := s.reserve()
+			total += 1 + codonByteSliceSize(s.set(idx, func() (total int) {
+				for _0 := 0; _0 < len(v.Options); _0++ {
+					total += codonUvarintSize(uint64(v.Options[_0]))
+				}
+				return
+			}()))
+		}
+	}
+	total += 2
+	total += 1 + codonUvarintSize(uint64(v.Weight))
+	return
+} //End of SizeMsgVote
+
+func DecodeMsgVote(bz []byte) (MsgVote, int, error) {
+	return decodeMsgVote(bz, nil)
+}
+func DecodeMsgVoteNoCopy(bz []byte) (MsgVote, int, error) {
+	return decodeMsgVote(bz, &codonDecoder{noCopy: true})
+}
+func DecodeMsgVoteWithOptions(bz []byte, opts DecodeOptions) (v MsgVote, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeMsgVote(bz, d)
+}
+func decodeMsgVote(bz []byte, d *codonDecoder) (v MsgVote, total int, err error) {
+	var n int
+	if err = d.enter(); err != nil {
+		return
+	}
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Voter
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			v.Voter = tmpBz
+		case 2: // v.Proposal
+			v.Proposal = uint64(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 3: // v.Options
+			if tag&7 == 2 { // packed v.Options
+				l := codonDecodeUint64(bz, &n, &err)
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				if l > uint64(len(bz)) {
+					err = errors.New("Length Too Large")
+					return
+				}
+				func(bz []byte) {
+					for len(bz) != 0 {
+						if err = d.checkSliceLength(len(v.Options) + 1); err != nil {
+							return
+						}
+						var tmp uint32
+						tmp = uint32(codonDecodeUint32(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						v.Options = append(v.Options, tmp)
+					}
+				}(bz[:l]) // end func
+				if err != nil {
+					return
+				}
+				bz = bz[l:]
+			} else {
+				if err = d.checkSliceLength(len(v.Options) + 1); err != nil {
+					return
+				}
+				var tmp uint32
+				tmp = uint32(codonDecodeUint32(bz, &n, &err))
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				v.Options = append(v.Options, tmp)
+			} // end of packed v.Options
+		case 4: // v.Yes
+			v.Yes = bool(codonDecodeBool(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 5: // v.Weight
+			v.Weight = int32(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			err = errors.New("Unknown Field")
+			return
+		}
+	} // end for
+	d.leave()
+	return v, total, nil
+} //End of DecodeMsgVote
+
+func PeekMsgVote_Voter(bz []byte) (res []uint8, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Voter
+			var tmpBz []byte
+			n, err = d.getByteSlice(&tmpBz, bz)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			res = tmpBz
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekMsgVote_Voter
+
+func PeekMsgVote_Proposal(bz []byte) (res uint64, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 2: // v.Proposal
+			res = uint64(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekMsgVote_Proposal
+
+func PeekMsgVote_Options(bz []byte) (res []uint32, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 3: // v.Options
+			if tag&7 == 2 { // packed res
+				l := codonDecodeUint64(bz, &n, &err)
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				if l > uint64(len(bz)) {
+					err = errors.New("Length Too Large")
+					return
+				}
+				func(bz []byte) {
+					for len(bz) != 0 {
+						if err = d.checkSliceLength(len(res) + 1); err != nil {
+							return
+						}
+						var tmp uint32
+						tmp = uint32(codonDecodeUint32(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						res = append(res, tmp)
+					}
+				}(bz[:l]) // end func
+				if err != nil {
+					return
+				}
+				bz = bz[l:]
+			} else {
+				if err = d.checkSliceLength(len(res) + 1); err != nil {
+					return
+				}
+				var tmp uint32
+				tmp = uint32(codonDecodeUint32(bz, &n, &err))
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				res = append(res, tmp)
+			} // end of packed res
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekMsgVote_Options
+
+func PeekMsgVote_Yes(bz []byte) (res bool, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 4: // v.Yes
+			res = bool(codonDecodeBool(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekMsgVote_Yes
+
+func PeekMsgVote_Weight(bz []byte) (res int32, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 5: // v.Weight
+			res = int32(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekMsgVote_Weight
+
+func RandMsgVote(r RandSrc) MsgVote {
+	var length int
+	var v MsgVote
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.Voter = r.GetBytes(length)
+	v.Proposal = r.GetUint64()
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	if length == 0 {
+		v.Options = nil
+	} else {
+		v.Options = make([]uint32, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of uint32
+		v.Options[_0] = r.GetUint32()
+	}
+	v.Yes = r.GetBool()
+	v.Weight = r.GetInt32()
+	return v
+} //End of RandMsgVote
+
+func DeepCopyMsgVote(in MsgVote) (out MsgVote) {
+	var length int
+	length = len(in.Voter)
+	if length == 0 {
+		out.Voter = nil
+	} else {
+		out.Voter = make([]uint8, length)
+	}
+	copy(out.Voter[:], in.Voter[:])
+	out.Proposal = in.Proposal
+	length = len(in.Options)
+	if length == 0 {
+		out.Options = nil
+	} else {
+		out.Options = make([]uint32, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of uint32
+		out.Options[_0] = in.Options[_0]
+	}
+	out.Yes = in.Yes
+	out.Weight = in.Weight
+	return
+} //End of DeepCopyMsgVote
+
+func EqualMsgVote(a, b MsgVote) bool {
+	if !bytes.Equal(a.Voter, b.Voter) {
+		return false
+	}
+	if a.Proposal != b.Proposal {
+		return false
+	}
+	if len(a.Options) != len(b.Options) {
+		return false
+	}
+	for _0 := range a.Options { //slice of uint32
+		if a.Options[_0] != b.Options[_0] {
+			return false
+		}
+	}
+	if a.Yes != b.Yes {
+		return false
+	}
+	if a.Weight != b.Weight {
+		return false
+	}
+	return true
+} //End of EqualMsgVote
+
+func (ptr *MsgVote) ToBytes() []byte {
+	var wBuf []byte
+	EncodeMsgVote(&wBuf, *ptr)
+	return wBuf
+}
+
+func (ptr *MsgVote) FromBytes(bz []byte) {
+	var total int
+	var err error
+	*ptr, total, err = DecodeMsgVote(bz)
+	if total != len(bz) {
+		panic("Length Mismatch During Decoding")
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (ptr *MsgVote) DeepCopy() interface{} {
+	return DeepCopyMsgVote(*ptr)
+}
+
+// Non-Interface
+func EncodeExtra(w *[]byte, v Extra) {
+	s := &codonSizes{}
+	codonGrow(w, sizeExtra(v, s))
+	encodeExtra(w, v, s)
+}
+func HashExtra(h hash.Hash, v Extra) {
+	s := &codonSizes{out: h}
+	sizeExtra(v, s)
+	w := make([]byte, 0, 2*codonChunkSize)
+	encodeExtra(&w, v, s)
+	h.Write(w)
+}
+func encodeExtra(w *[]byte, v Extra, s *codonSizes) {
+	codonEncodeUvarint(1, w, uint64(v.Small))
+	if len(v.Smalls) != 0 {
+		codonEncodeLength(2, w, s.next())
+		for _0 := 0; _0 < len(v.Smalls); _0++ {
+			codonWriteUvarint(w, uint64(v.Smalls[_0]))
+		}
+	} // end of packed v.Smalls
+	s.flush(w)
+	codonEncodeFloat64(3, w, v.Ratio)
+	if len(v.Ratios) != 0 {
+		codonEncodeLength(4, w, s.next())
+		for _0 := 0; _0 < len(v.Ratios); _0++ {
+			codonWriteFloat32(w, float32(v.Ratios[_0]))
+		}
+	} // end of packed v.Ratios
+	s.flush(w)
+	{ // map v.Labels
+		keys_0 := make([]string, 0, len(v.Labels))
+		for key_0 := range v.Labels {
+			keys_0 = append(keys_0, key_0)
+		}
+		codonSortKeys(keys_0, func(i, j int) bool { return keys_0[i] < keys_0[j] })
+		for _, key_0 := range keys_0 {
+			codonEncodeLength(5, w, s.next())
+			codonEncodeString(1, w, key_0)
+			codonEncodeUvarint(2, w, uint64(v.Labels[key_0]))
+		}
+	} // end of v.Labels
+	s.flush(w)
+	codonEncodeLength(6, w, s.next())
+	codonEncodeString(1, w, v.Inner.Denom)
+	s.flush(w)
+	codonEncodeUvarint(2, w, uint64(v.Inner.Amount))
+	// end of v.Inner
+	s.flush(w)
+} //End of EncodeExtra
+
+func SizeExtra(v Extra) int {
+	return sizeExtra(v, nil)
+}
+func sizeExtra(v Extra, s *codonSizes) (total int) {
+	total += 1 + codonUvarintSize(uint64(v.Small))
+	if len(v.Smalls) != 0 {
+		{
+			idx := s.reserve()
+			total += 1 + codonByteSliceSize(s.set(idx, func() (total int) {
+				for _0 := 0; _0 < len(v.Smalls); _0++ {
+					total += codonUvarintSize(uint64(v.Smalls[_0]))
+				}
+				return
+			}()))
+		}
+	}
+	total += 9
+	if len(v.Ratios) != 0 {
+		{
+			idx := s.reserve()
+			total += 1 + codonByteSliceSize(s.set(idx, len(v.Ratios)*4))
+		}
+	}
+	{ // map v.Labels
+		keys_0 := make([]string, 0, len(v.Labels))
+		for key_0 := range v.Labels {
+			keys_0 = append(keys_0, key_0)
+		}
+		codonSortKeys(keys_0, func(i, j int) bool { return keys_0[i] < keys_0[j] })
+		for _, key_0 := range keys_0 {
+			{
+				idx := s.reserve()
+				total += 1 + codonByteSliceSize(s.set(idx, func() (total int) {
+					total += 1 + codonByteSliceSize(len(key_0))
+					total += 1 + codonUvarintSize(uint64(v.Labels[key_0]))
+					return
+				}()))
+			}
+		}
+	} // end of v.Labels
+	{
+		idx := s.reserve()
+		total += 1 + codonByteSliceSize(s.set(idx, sizeCoin(v.Inner, s)))
+	}
+	return
+} //End of SizeExtra
+
+func DecodeExtra(bz []byte) (Extra, int, error) {
+	return decodeExtra(bz, nil)
+}
+func DecodeExtraNoCopy(bz []byte) (Extra, int, error) {
+	return decodeExtra(bz, &codonDecoder{noCopy: true})
+}
+func DecodeExtraWithOptions(bz []byte, opts DecodeOptions) (v Extra, total int, err error) {
+	d, err := newCodonDecoder(bz, opts)
+	if err != nil {
+		return
+	}
+	return decodeExtra(bz, d)
+}
+func decodeExtra(bz []byte, d *codonDecoder) (v Extra, total int, err error) {
+	var n int
+	if err = d.enter(); err != nil {
+		return
+	}
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Small
+			v.Small = int8(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 2: // v.Smalls
+			if tag&7 == 2 { // packed v.Smalls
+				l := codonDecodeUint64(bz, &n, &err)
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				if l > uint64(len(bz)) {
+					err = errors.New("Length Too Large")
+					return
+				}
+				func(bz []byte) {
+					for len(bz) != 0 {
+						if err = d.checkSliceLength(len(v.Smalls) + 1); err != nil {
+							return
+						}
+						var tmp int16
+						tmp = int16(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						v.Smalls = append(v.Smalls, tmp)
+					}
+				}(bz[:l]) // end func
+				if err != nil {
+					return
+				}
+				bz = bz[l:]
+			} else {
+				if err = d.checkSliceLength(len(v.Smalls) + 1); err != nil {
+					return
+				}
+				var tmp int16
+				tmp = int16(codonDecodeUint64(bz, &n, &err))
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				v.Smalls = append(v.Smalls, tmp)
+			} // end of packed v.Smalls
+		case 3: // v.Ratio
+			v.Ratio = float64(codonDecodeFloat64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		case 4: // v.Ratios
+			if tag&7 == 2 { // packed v.Ratios
+				l := codonDecodeUint64(bz, &n, &err)
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				if l > uint64(len(bz)) {
+					err = errors.New("Length Too Large")
+					return
+				}
+				func(bz []byte) {
+					for len(bz) != 0 {
+						if err = d.checkSliceLength(len(v.Ratios) + 1); err != nil {
+							return
+						}
+						var tmp float32
+						tmp = float32(codonDecodeFloat32(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						v.Ratios = append(v.Ratios, tmp)
+					}
+				}(bz[:l]) // end func
+				if err != nil {
+					return
+				}
+				bz = bz[l:]
+			} else {
+				if err = d.checkSliceLength(len(v.Ratios) + 1); err != nil {
+					return
+				}
+				var tmp float32
+				tmp = float32(codonDecodeFloat32(bz, &n, &err))
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				v.Ratios = append(v.Ratios, tmp)
+			} // end of packed v.Ratios
+		case 5: // v.Labels
+			if err = d.checkSliceLength(len(v.Labels) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var key_0 string
+			var value_0 int64
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // key_0
+						key_0 = string(d.decodeString(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					case 2: // value_0
+						value_0 = int64(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					default:
+						err = errors.New("Unknown Field")
+						return
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			bz = bz[l:]
+			n += int(l)
+			if v.Labels == nil {
+				v.Labels = make(map[string]int64)
+			}
+			v.Labels[key_0] = value_0
+		case 6: // v.Inner
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			if err = d.enter(); err != nil {
+				return
+			}
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // v.Inner.Denom
+						v.Inner.Denom = string(d.decodeString(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					case 2: // v.Inner.Amount
+						v.Inner.Amount = int64(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					default:
+						err = errors.New("Unknown Field")
+						return
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			d.leave()
+			bz = bz[l:]
+			n += int(l)
+		default:
+			err = errors.New("Unknown Field")
+			return
+		}
+	} // end for
+	d.leave()
+	return v, total, nil
+} //End of DecodeExtra
+
+func PeekExtra_Small(bz []byte) (res int8, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 1: // v.Small
+			res = int8(codonDecodeUint64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekExtra_Small
+
+func PeekExtra_Smalls(bz []byte) (res []int16, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 2: // v.Smalls
+			if tag&7 == 2 { // packed res
+				l := codonDecodeUint64(bz, &n, &err)
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				if l > uint64(len(bz)) {
+					err = errors.New("Length Too Large")
+					return
+				}
+				func(bz []byte) {
+					for len(bz) != 0 {
+						if err = d.checkSliceLength(len(res) + 1); err != nil {
+							return
+						}
+						var tmp int16
+						tmp = int16(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						res = append(res, tmp)
+					}
+				}(bz[:l]) // end func
+				if err != nil {
+					return
+				}
+				bz = bz[l:]
+			} else {
+				if err = d.checkSliceLength(len(res) + 1); err != nil {
+					return
+				}
+				var tmp int16
+				tmp = int16(codonDecodeUint64(bz, &n, &err))
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				res = append(res, tmp)
+			} // end of packed res
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekExtra_Smalls
+
+func PeekExtra_Ratio(bz []byte) (res float64, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 3: // v.Ratio
+			res = float64(codonDecodeFloat64(bz, &n, &err))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekExtra_Ratio
+
+func PeekExtra_Ratios(bz []byte) (res []float32, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 4: // v.Ratios
+			if tag&7 == 2 { // packed res
+				l := codonDecodeUint64(bz, &n, &err)
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				if l > uint64(len(bz)) {
+					err = errors.New("Length Too Large")
+					return
+				}
+				func(bz []byte) {
+					for len(bz) != 0 {
+						if err = d.checkSliceLength(len(res) + 1); err != nil {
+							return
+						}
+						var tmp float32
+						tmp = float32(codonDecodeFloat32(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+						res = append(res, tmp)
+					}
+				}(bz[:l]) // end func
+				if err != nil {
+					return
+				}
+				bz = bz[l:]
+			} else {
+				if err = d.checkSliceLength(len(res) + 1); err != nil {
+					return
+				}
+				var tmp float32
+				tmp = float32(codonDecodeFloat32(bz, &n, &err))
+				if err != nil {
+					return
+				}
+				bz = bz[n:]
+				total += n
+				res = append(res, tmp)
+			} // end of packed res
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekExtra_Ratios
+
+func PeekExtra_Labels(bz []byte) (res map[string]int64, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 5: // v.Labels
+			if err = d.checkSliceLength(len(res) + 1); err != nil {
+				return
+			}
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			var key_0 string
+			var value_0 int64
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // key_0
+						key_0 = string(d.decodeString(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					case 2: // value_0
+						value_0 = int64(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					default:
+						err = errors.New("Unknown Field")
+						return
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			bz = bz[l:]
+			n += int(l)
+			if res == nil {
+				res = make(map[string]int64)
+			}
+			res[key_0] = value_0
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekExtra_Labels
+
+func PeekExtra_Inner(bz []byte) (res Coin, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 6: // v.Inner
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			if err = d.enter(); err != nil {
+				return
+			}
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // res.Denom
+						res.Denom = string(d.decodeString(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					case 2: // res.Amount
+						res.Amount = int64(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					default:
+						err = errors.New("Unknown Field")
+						return
+					}
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			d.leave()
+			bz = bz[l:]
+			n += int(l)
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekExtra_Inner
+
+func PeekExtra_Inner_Denom(bz []byte) (res string, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 6: // v.Inner
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 1: // v.Inner.Denom
+						res = string(d.decodeString(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					default:
+						n, err = codonSkipField(bz, int(tag&7))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					} // end switch
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			bz = bz[l:]
+			total += int(l)
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekExtra_Inner_Denom
+
+func PeekExtra_Inner_Amount(bz []byte) (res int64, err error) {
+	var d *codonDecoder
+	var n, total int
+	for len(bz) != 0 {
+		tag := codonDecodeUint64(bz, &n, &err)
+		if err != nil {
+			return
+		}
+		bz = bz[n:]
+		total += n
+		switch tag >> 3 {
+		case 6: // v.Inner
+			l := codonDecodeUint64(bz, &n, &err)
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+			if l > uint64(len(bz)) {
+				err = errors.New("Length Too Large")
+				return
+			}
+			func(bz []byte) {
+				for len(bz) != 0 {
+					tag := codonDecodeUint64(bz, &n, &err)
+					if err != nil {
+						return
+					}
+					bz = bz[n:]
+					total += n
+					switch tag >> 3 {
+					case 2: // v.Inner.Amount
+						res = int64(codonDecodeUint64(bz, &n, &err))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					default:
+						n, err = codonSkipField(bz, int(tag&7))
+						if err != nil {
+							return
+						}
+						bz = bz[n:]
+						total += n
+					} // end switch
+				} // end for
+			}(bz[:l]) // end func
+			if err != nil {
+				return
+			}
+			bz = bz[l:]
+			total += int(l)
+		default:
+			n, err = codonSkipField(bz, int(tag&7))
+			if err != nil {
+				return
+			}
+			bz = bz[n:]
+			total += n
+		} // end switch
+	} // end for
+	_ = d
+	return res, nil
+} //End of PeekExtra_Inner_Amount
+
+func RandExtra(r RandSrc) Extra {
+	var length int
+	var v Extra
+	v.Small = r.GetInt8()
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	if length == 0 {
+		v.Smalls = nil
+	} else {
+		v.Smalls = make([]int16, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of int16
+		v.Smalls[_0] = r.GetInt16()
+	}
+	v.Ratio = r.GetFloat64()
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	if length == 0 {
+		v.Ratios = nil
+	} else {
+		v.Ratios = make([]float32, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of float32
+		v.Ratios[_0] = r.GetFloat32()
+	}
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.Labels = make(map[string]int64, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //map of int64
+		var key_0 string
+		var value_0 int64
+		key_0 = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+		value_0 = r.GetInt64()
+		v.Labels[key_0] = value_0
+	}
+	v.Inner.Denom = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	v.Inner.Amount = r.GetInt64()
+	// end of v.Inner
+	return v
+} //End of RandExtra
+
+func DeepCopyExtra(in Extra) (out Extra) {
+	var length int
+	out.Small = in.Small
+	length = len(in.Smalls)
+	if length == 0 {
+		out.Smalls = nil
+	} else {
+		out.Smalls = make([]int16, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of int16
+		out.Smalls[_0] = in.Smalls[_0]
+	}
+	out.Ratio = in.Ratio
+	length = len(in.Ratios)
+	if length == 0 {
+		out.Ratios = nil
+	} else {
+		out.Ratios = make([]float32, length)
+	}
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of float32
+		out.Ratios[_0] = in.Ratios[_0]
+	}
+	length = len(in.Labels)
+	if length == 0 {
+		out.Labels = nil
+	} else {
+		out.Labels = make(map[string]int64, length)
+	}
+	for key_0, inValue_0 := range in.Labels {
+		var outValue_0 int64
+		outValue_0 = inValue_0
+		out.Labels[key_0] = outValue_0
+	}
+	out.Inner.Denom = in.Inner.Denom
+	out.Inner.Amount = in.Inner.Amount
+	// end of .Inner
+	return
+} //End of DeepCopyExtra
+
+func EqualExtra(a, b Extra) bool {
+	if a.Small != b.Small {
+		return false
+	}
+	if len(a.Smalls) != len(b.Smalls) {
+		return false
+	}
+	for _0 := range a.Smalls { //slice of int16
+		if a.Smalls[_0] != b.Smalls[_0] {
+			return false
+		}
+	}
+	if math.Float64bits(float64(a.Ratio)) != math.Float64bits(float64(b.Ratio)) {
+		return false
+	}
+	if len(a.Ratios) != len(b.Ratios) {
+		return false
+	}
+	for _0 := range a.Ratios { //slice of float32
+		if math.Float32bits(float32(a.Ratios[_0])) != math.Float32bits(float32(b.Ratios[_0])) {
+			return false
+		}
+	}
+	if len(a.Labels) != len(b.Labels) {
+		return false
+	}
+	for key_0, aValue_0 := range a.Labels {
+		bValue_0, ok := b.Labels[key_0]
+		if !ok {
+			return false
+		}
+		if aValue_0 != bValue_0 {
+			return false
+		}
+	}
+	if a.Inner.Denom != b.Inner.Denom {
+		return false
+	}
+	if a.Inner.Amount != b.Inner.Amount {
+		return false
+	}
+	// end of .Inner
+	return true
+} //End of EqualExtra
+
+func (ptr *Extra) ToBytes() []byte {
+	var wBuf []byte
+	EncodeExtra(&wBuf, *ptr)
+	return wBuf
+}
+
+func (ptr *Extra) FromBytes(bz []byte) {
+	var total int
+	var err error
+	*ptr, total, err = DecodeExtra(bz)
+	if total != len(bz) {
+		panic("Length Mismatch During Decoding")
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (ptr *Extra) DeepCopy() interface{} {
+	return DeepCopyExtra(*ptr)
+}
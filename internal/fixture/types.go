@@ -0,0 +1,59 @@
+// Package fixture contains the types which the generator's tests register. The code generated for them
+// is committed in this directory and in the codec directory, and the tests check that it is up to date.
+package fixture
+
+// The limits used by the Rand functions in serializable.go
+const (
+	MaxStringLength = 10
+	MaxSliceLength  = 5
+)
+
+// Msg is implemented by the messages carried by Tx
+type Msg interface {
+	Route() string
+}
+
+type Coin struct {
+	Denom  string
+	Amount int64
+}
+
+type Fee struct {
+	Amount []Coin
+	Gas    uint64
+}
+
+type MsgSend struct {
+	From   []byte
+	To     []byte
+	Amount []Coin
+}
+
+func (msg MsgSend) Route() string { return "bank" }
+
+type MsgVote struct {
+	Voter    []byte
+	Proposal uint64
+	Options  []uint32
+	Yes      bool
+	Weight   int32
+}
+
+func (msg MsgVote) Route() string { return "gov" }
+
+type Tx struct {
+	Msgs []Msg
+	Fee  *Fee
+	Memo string
+	Sigs [][]byte
+}
+
+// Extra has the members which go-amino does not support without special options, such as maps and floats
+type Extra struct {
+	Small  int8
+	Smalls []int16
+	Ratio  float64
+	Ratios []float32
+	Labels map[string]int64
+	Inner  Coin
+}
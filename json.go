@@ -0,0 +1,1107 @@
+package codon
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The runtime of the generated JSON functions, which read and write the JSON format of go-amino
+var jsonLogics = `
+// codonJSONWriter writes amino-compatible JSON. Only the first error is kept.
+type codonJSONWriter struct {
+	buf []byte
+	err error
+}
+
+func (w *codonJSONWriter) fail(err error) {
+	if w.err == nil {
+		w.err = err
+	}
+}
+
+func (w *codonJSONWriter) result() ([]byte, error) {
+	if w.err != nil {
+		return nil, w.err
+	}
+	return w.buf, nil
+}
+
+func (w *codonJSONWriter) raw(s string) {
+	w.buf = append(w.buf, s...)
+}
+
+// writes a comma unless it is the first member of an object or the first element of an array
+func (w *codonJSONWriter) comma(open byte) {
+	if len(w.buf) != 0 && w.buf[len(w.buf)-1] != open {
+		w.buf = append(w.buf, ',')
+	}
+}
+
+// writes a struct member's key, which is already escaped and followed by a colon
+func (w *codonJSONWriter) key(k string) {
+	w.comma('{')
+	w.buf = append(w.buf, k...)
+}
+
+func (w *codonJSONWriter) mapKey(k string) {
+	w.comma('{')
+	w.string(k)
+	w.buf = append(w.buf, ':')
+}
+
+// begins the wrapper of a registered type, which must be closed with a "}"
+func (w *codonJSONWriter) beginType(name string) {
+	w.buf = append(w.buf, "{\"type\":\""...)
+	w.buf = append(w.buf, name...)
+	w.buf = append(w.buf, "\",\"value\":"...)
+}
+
+func (w *codonJSONWriter) bool(b bool) {
+	if b {
+		w.raw("true")
+	} else {
+		w.raw("false")
+	}
+}
+
+func (w *codonJSONWriter) int(i int64) {
+	w.buf = strconv.AppendInt(w.buf, i, 10)
+}
+
+func (w *codonJSONWriter) uint(u uint64) {
+	w.buf = strconv.AppendUint(w.buf, u, 10)
+}
+
+// 64-bit integers are quoted, because javascript cannot handle them
+func (w *codonJSONWriter) quotedInt(i int64) {
+	w.buf = append(w.buf, '"')
+	w.buf = strconv.AppendInt(w.buf, i, 10)
+	w.buf = append(w.buf, '"')
+}
+
+func (w *codonJSONWriter) quotedUint(u uint64) {
+	w.buf = append(w.buf, '"')
+	w.buf = strconv.AppendUint(w.buf, u, 10)
+	w.buf = append(w.buf, '"')
+}
+
+// escapes s just like encoding/json
+func (w *codonJSONWriter) string(s string) {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c < 0x20 || c >= 0x7f || c == '"' || c == '\\' || c == '<' || c == '>' || c == '&' {
+			w.marshal(s)
+			return
+		}
+	}
+	w.buf = append(w.buf, '"')
+	w.buf = append(w.buf, s...)
+	w.buf = append(w.buf, '"')
+}
+
+// writes bz in base64, or null if it is nil
+func (w *codonJSONWriter) bytes(bz []byte) {
+	if bz == nil {
+		w.raw("null")
+		return
+	}
+	w.buf = append(w.buf, '"')
+	w.buf = base64.StdEncoding.AppendEncode(w.buf, bz)
+	w.buf = append(w.buf, '"')
+}
+
+// uses v's MarshalJSON if it has one, otherwise encoding/json
+func (w *codonJSONWriter) marshal(v interface{}) {
+	if m, ok := v.(json.Marshaler); ok {
+		w.marshaler(m)
+		return
+	}
+	bz, err := json.Marshal(v)
+	if err != nil {
+		w.fail(err)
+		return
+	}
+	w.buf = append(w.buf, bz...)
+}
+
+// the output of MarshalJSON is written unchanged, as amino does
+func (w *codonJSONWriter) marshaler(m json.Marshaler) {
+	bz, err := m.MarshalJSON()
+	if err != nil {
+		w.fail(err)
+		return
+	}
+	w.buf = append(w.buf, bz...)
+}
+
+// codonJSONReader reads the JSON written by codonJSONWriter or amino. Only the first error is kept,
+// and after it every value is read as null.
+type codonJSONReader struct {
+	bz  []byte
+	pos int
+	err error
+	// the key of the object member being read
+	key []byte
+	// whether no member or element of the current object or array has been read
+	first bool
+}
+
+func (d *codonJSONReader) fail(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+}
+
+func (d *codonJSONReader) failf(format string, args ...interface{}) {
+	d.fail(fmt.Errorf(format, args...))
+}
+
+// skips the spaces and returns the next byte, or 0 at the end
+func (d *codonJSONReader) peek() byte {
+	for ; d.pos < len(d.bz); d.pos++ {
+		switch c := d.bz[d.pos]; c {
+		case ' ', '\t', '\n', '\r':
+		default:
+			return c
+		}
+	}
+	return 0
+}
+
+func (d *codonJSONReader) unexpected(what string) {
+	if d.pos >= len(d.bz) {
+		d.failf("unexpected end of JSON input, expecting %s", what)
+	} else {
+		d.failf("invalid character %q at offset %d, expecting %s", d.bz[d.pos], d.pos, what)
+	}
+}
+
+func (d *codonJSONReader) expect(c byte) bool {
+	if d.err != nil {
+		return false
+	}
+	if d.peek() != c {
+		d.unexpected(strconv.QuoteRune(rune(c)))
+		return false
+	}
+	d.pos++
+	return true
+}
+
+func (d *codonJSONReader) literal(s string) {
+	if !bytes.HasPrefix(d.bz[d.pos:], []byte(s)) {
+		d.unexpected(s)
+		return
+	}
+	d.pos += len(s)
+}
+
+// consumes a null and returns true. After an error it returns true too, such that nothing more is read.
+func (d *codonJSONReader) null() bool {
+	if d.err != nil {
+		return true
+	}
+	if d.peek() == 'n' {
+		d.literal("null")
+		return true
+	}
+	return false
+}
+
+func (d *codonJSONReader) beginObject() {
+	if d.expect('{') {
+		d.first = true
+	}
+}
+
+// reads the key of the next member into d.key, and returns false at the end of the object
+func (d *codonJSONReader) nextMember() bool {
+	if d.err != nil {
+		return false
+	}
+	if d.peek() == '}' {
+		d.pos++
+		d.first = false
+		return false
+	}
+	if !d.first && !d.expect(',') {
+		return false
+	}
+	d.first = false
+	d.key = d.stringBytes()
+	return d.expect(':')
+}
+
+func (d *codonJSONReader) beginArray() {
+	if d.expect('[') {
+		d.first = true
+	}
+}
+
+// returns false at the end of the array
+func (d *codonJSONReader) nextElem() bool {
+	if d.err != nil {
+		return false
+	}
+	if d.peek() == ']' {
+		d.pos++
+		d.first = false
+		return false
+	}
+	if !d.first && !d.expect(',') {
+		return false
+	}
+	d.first = false
+	return true
+}
+
+// the returned slice may share the memory of the input
+func (d *codonJSONReader) stringBytes() []byte {
+	if !d.expect('"') {
+		return nil
+	}
+	start := d.pos
+	for i := start; i < len(d.bz); i++ {
+		c := d.bz[i]
+		if c == '"' {
+			d.pos = i + 1
+			return d.bz[start:i]
+		}
+		if c == '\\' || c < 0x20 || c >= 0x80 {
+			return d.unquote(start - 1)
+		}
+	}
+	d.pos = len(d.bz)
+	d.unexpected("'\"'")
+	return nil
+}
+
+// unquotes the string beginning at start with encoding/json, which handles the escapes and invalid UTF-8
+func (d *codonJSONReader) unquote(start int) []byte {
+	end := start + 1
+	for ; end < len(d.bz) && d.bz[end] != '"'; end++ {
+		if d.bz[end] == '\\' {
+			end++
+		}
+	}
+	if end >= len(d.bz) {
+		d.pos = len(d.bz)
+		d.unexpected("'\"'")
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(d.bz[start:end+1], &s); err != nil {
+		d.fail(err)
+		return nil
+	}
+	d.pos = end + 1
+	return []byte(s)
+}
+
+func (d *codonJSONReader) string() string {
+	return string(d.stringBytes())
+}
+
+// reads base64 bytes, an empty string is read as nil
+func (d *codonJSONReader) bytes() []byte {
+	s := d.stringBytes()
+	if d.err != nil || len(s) == 0 {
+		return nil
+	}
+	res, err := base64.StdEncoding.AppendDecode(nil, s)
+	if err != nil {
+		d.fail(err)
+		return nil
+	}
+	return res
+}
+
+// reads base64 bytes into a byte array, whose length must match
+func (d *codonJSONReader) byteArray(a []byte) {
+	bz := d.bytes()
+	if d.err == nil && len(bz) != len(a) {
+		d.failf("byte-length mismatch, got %d want %d", len(bz), len(a))
+		return
+	}
+	copy(a, bz)
+}
+
+func (d *codonJSONReader) bool() bool {
+	if d.err != nil {
+		return false
+	}
+	switch d.peek() {
+	case 't':
+		d.literal("true")
+		return d.err == nil
+	case 'f':
+		d.literal("false")
+	default:
+		d.unexpected("a boolean")
+	}
+	return false
+}
+
+// returns the end of the JSON number beginning at start, or start if there is no number
+func codonScanJSONNumber(bz []byte, start int) int {
+	isDigit := func(i int) bool {
+		return i < len(bz) && bz[i] >= '0' && bz[i] <= '9'
+	}
+	i := start
+	if i < len(bz) && bz[i] == '-' {
+		i++
+	}
+	if i < len(bz) && bz[i] == '0' {
+		i++
+	} else if isDigit(i) {
+		for i++; isDigit(i); i++ {
+		}
+	} else {
+		return start
+	}
+	if i < len(bz) && bz[i] == '.' && isDigit(i+1) {
+		for i += 2; isDigit(i); i++ {
+		}
+	}
+	if i < len(bz) && (bz[i] == 'e' || bz[i] == 'E') {
+		j := i + 1
+		if j < len(bz) && (bz[j] == '+' || bz[j] == '-') {
+			j++
+		}
+		if isDigit(j) {
+			for i = j + 1; isDigit(i); i++ {
+			}
+		}
+	}
+	return i
+}
+
+func (d *codonJSONReader) number() string {
+	if d.err != nil {
+		return ""
+	}
+	d.peek()
+	end := codonScanJSONNumber(d.bz, d.pos)
+	if end == d.pos {
+		d.unexpected("a number")
+		return ""
+	}
+	s := string(d.bz[d.pos:end])
+	d.pos = end
+	return s
+}
+
+// 64-bit integers must be quoted
+func (d *codonJSONReader) quoted() string {
+	s := d.stringBytes()
+	if d.err == nil && (len(s) == 0 || codonScanJSONNumber(s, 0) != len(s)) {
+		d.failf("invalid quoted number %q", s)
+	}
+	return string(s)
+}
+
+func (d *codonJSONReader) parseInt(s string, bitSize int) int64 {
+	if d.err != nil {
+		return 0
+	}
+	i, err := strconv.ParseInt(s, 10, bitSize)
+	if err != nil {
+		d.fail(err)
+	}
+	return i
+}
+
+func (d *codonJSONReader) parseUint(s string, bitSize int) uint64 {
+	if d.err != nil {
+		return 0
+	}
+	u, err := strconv.ParseUint(s, 10, bitSize)
+	if err != nil {
+		d.fail(err)
+	}
+	return u
+}
+
+func (d *codonJSONReader) int(bitSize int) int64 {
+	return d.parseInt(d.number(), bitSize)
+}
+
+func (d *codonJSONReader) uint(bitSize int) uint64 {
+	return d.parseUint(d.number(), bitSize)
+}
+
+func (d *codonJSONReader) quotedInt() int64 {
+	return d.parseInt(d.quoted(), 64)
+}
+
+func (d *codonJSONReader) quotedUint() uint64 {
+	return d.parseUint(d.quoted(), 64)
+}
+
+func (d *codonJSONReader) float(bitSize int) float64 {
+	s := d.number()
+	if d.err != nil {
+		return 0
+	}
+	f, err := strconv.ParseFloat(s, bitSize)
+	if err != nil {
+		d.fail(err)
+	}
+	return f
+}
+
+// the keys of maps are strings, even for integers and bools
+func (d *codonJSONReader) keyBool() bool {
+	switch string(d.key) {
+	case "true":
+		return true
+	case "false":
+	default:
+		d.failf("invalid bool key %q", d.key)
+	}
+	return false
+}
+
+func (d *codonJSONReader) keyInt(bitSize int) int64 {
+	return d.parseInt(string(d.key), bitSize)
+}
+
+func (d *codonJSONReader) keyUint(bitSize int) uint64 {
+	return d.parseUint(string(d.key), bitSize)
+}
+
+const codonMaxJSONDepth = 10000
+
+// skips the next value and checks its syntax
+func (d *codonJSONReader) skip() {
+	d.skipValue(0)
+}
+
+func (d *codonJSONReader) skipValue(depth int) {
+	if depth > codonMaxJSONDepth {
+		d.failf("exceeded max depth %d", codonMaxJSONDepth)
+		return
+	}
+	switch d.peek() {
+	case '{':
+		d.beginObject()
+		for d.nextMember() {
+			d.skipValue(depth + 1)
+		}
+	case '[':
+		d.beginArray()
+		for d.nextElem() {
+			d.skipValue(depth + 1)
+		}
+	case '"':
+		d.stringBytes()
+	case 't', 'f':
+		d.bool()
+	case 'n':
+		d.null()
+	default:
+		d.number()
+	}
+}
+
+// returns the bytes of the next value
+func (d *codonJSONReader) raw() []byte {
+	d.peek()
+	start := d.pos
+	d.skip()
+	if d.err != nil {
+		return nil
+	}
+	return d.bz[start:d.pos]
+}
+
+func (d *codonJSONReader) unmarshaler(u json.Unmarshaler) {
+	if raw := d.raw(); d.err == nil {
+		if err := u.UnmarshalJSON(raw); err != nil {
+			d.fail(err)
+		}
+	}
+}
+
+func (d *codonJSONReader) unmarshal(v interface{}) {
+	if raw := d.raw(); d.err == nil {
+		if err := json.Unmarshal(raw, v); err != nil {
+			d.fail(err)
+		}
+	}
+}
+
+// reads the wrapper of a registered type, and returns the type's name and a reader of its value.
+// The value is read after the name is known, so the order of "type" and "value" does not matter.
+func (d *codonJSONReader) typeValue() (name string, value *codonJSONReader) {
+	var raw []byte
+	d.beginObject()
+	for d.nextMember() {
+		switch string(d.key) {
+		case "type":
+			name = d.string()
+		case "value":
+			raw = d.raw()
+		default:
+			d.skip()
+		}
+	}
+	if d.err == nil && len(name) == 0 {
+		d.failf("JSON encoding of interfaces require non-empty type field")
+	} else if d.err == nil && len(raw) == 0 {
+		d.failf("interface JSON wrapper should have non-empty value field")
+	}
+	return name, &codonJSONReader{bz: raw, err: d.err}
+}
+
+// takes the error of the reader returned by typeValue
+func (d *codonJSONReader) merge(value *codonJSONReader) {
+	if value.err != nil {
+		d.fail(value.err)
+	}
+}
+
+// checks that only spaces follow the value, and returns the first error
+func (d *codonJSONReader) finish() error {
+	if d.err == nil {
+		d.peek()
+		if d.pos < len(d.bz) {
+			d.unexpected("the end of JSON input")
+		}
+	}
+	return d.err
+}
+`
+
+// Returns a struct member's name in JSON, and whether it is omitted when it is empty or omitted
+// at all. Just like amino, the name is decided by the json tag, and the unexported members are omitted.
+func jsonFieldName(field StructField) (name string, omitEmpty bool, skip bool) {
+	if len(field.PkgPath) != 0 || isUnrecognizedField(field) || isMutex(field.Type) {
+		return "", false, true
+	}
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false, true
+	}
+	parts := strings.Split(tag, ",")
+	name = field.Name
+	if len(parts[0]) != 0 {
+		name = parts[0]
+	}
+	return name, len(parts) > 1 && parts[1] == "omitempty", false
+}
+
+// returns the address of an addressable expression
+func addrExpr(x string) string {
+	if strings.HasPrefix(x, "(*") && strings.HasSuffix(x, ")") {
+		return x[2 : len(x)-1]
+	}
+	return "&" + x
+}
+
+// returns the alias of a registered named type, or "" if t is not registered
+func (ctx *context) registeredAlias(t Type) string {
+	if len(t.PkgPath()) == 0 {
+		return ""
+	}
+	return ctx.structPath2Alias[t.PkgPath()+"."+t.Name()]
+}
+
+func (ctx *context) isLeafType(t Type) bool {
+	_, ok := ctx.leafTypes[t.PkgPath()+"."+t.Name()]
+	return ok
+}
+
+// The structs which are neither registered nor leaf types can be members, but they cannot be
+// referred to in the generated code
+func (ctx *context) hasTypeExpr(t Type) bool {
+	return ctx.isLeafType(t) || len(ctx.registeredAlias(t)) != 0
+}
+
+// the zero value used when null is decoded, or "" if it cannot be expressed
+func (ctx *context) zeroExpr(t Type) string {
+	switch t.Kind() {
+	case reflect.Bool:
+		return "false"
+	case reflect.String:
+		return `""`
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+		return "nil"
+	case reflect.Array:
+		return ctx.getTypeExpr(t) + "{}"
+	case reflect.Struct:
+		if !ctx.hasTypeExpr(t) {
+			return ""
+		}
+		return ctx.getTypeExpr(t) + "{}"
+	default:
+		return "0"
+	}
+}
+
+// the condition under which an omitempty member is omitted, which follows amino's isEmpty
+func (ctx *context) emptyExpr(t Type, x string) string {
+	switch t.Kind() {
+	case reflect.Bool:
+		return "!" + x
+	case reflect.String, reflect.Slice:
+		return fmt.Sprintf("len(%s) == 0", x)
+	case reflect.Ptr, reflect.Interface, reflect.Map:
+		return x + " == nil"
+	case reflect.Array:
+		return fmt.Sprintf("%s == (%s{})", x, ctx.getTypeExpr(t))
+	case reflect.Struct:
+		if !ctx.hasTypeExpr(t) {
+			return fmt.Sprintf("reflect.DeepEqual(%s, reflect.Zero(reflect.TypeOf(%s)).Interface())", x, x)
+		}
+		return fmt.Sprintf("reflect.DeepEqual(%s, %s{})", x, ctx.getTypeExpr(t))
+	default:
+		return x + " == 0"
+	}
+}
+
+// converts expr, whose type is native, to t
+func (ctx *context) convExpr(t Type, expr, native string) string {
+	if len(t.PkgPath()) != 0 {
+		return ctx.getTypeName(t) + "(" + expr + ")"
+	}
+	if len(t.Name()) == 0 || t.Name() == native {
+		return expr
+	}
+	return t.Name() + "(" + expr + ")"
+}
+
+// returns the expression of a map key in JSON, which is always a string
+func jsonKeyExpr(keyT Type, key string) string {
+	switch keyT.Kind() {
+	case reflect.String:
+		return "string(" + key + ")"
+	case reflect.Bool:
+		return "strconv.FormatBool(bool(" + key + "))"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "strconv.FormatInt(int64(" + key + "), 10)"
+	default:
+		return "strconv.FormatUint(uint64(" + key + "), 10)"
+	}
+}
+
+// the bit size of an integer or a float in strconv's functions
+func bitSize(t Type) int {
+	switch t.Kind() {
+	case reflect.Int8, reflect.Uint8:
+		return 8
+	case reflect.Int16, reflect.Uint16:
+		return 16
+	case reflect.Int32, reflect.Uint32, reflect.Float32:
+		return 32
+	default:
+		return 64
+	}
+}
+
+func (ctx *context) generateStructJSONFunc(alias string, t Type) []string {
+	lines := make([]string, 0, 1000)
+	// the members of a struct are accessed through the pointer v
+	x := "(*v)"
+	if t.Kind() == reflect.Struct {
+		x = "v"
+	}
+
+	lines = append(lines, fmt.Sprintf("func EncodeJSON%s(v %s) ([]byte, error) {", alias, alias))
+	lines = append(lines, "w := &codonJSONWriter{}")
+	lines = append(lines, fmt.Sprintf("encodeJSON%s(w, &v)", alias))
+	lines = append(lines, "return w.result()")
+	lines = append(lines, "}")
+	lines = append(lines, fmt.Sprintf("func encodeJSON%s(w *codonJSONWriter, v *%s) {", alias, alias))
+	if ctx.isLeafType(t) {
+		lines = append(lines, "w.marshal(v)")
+	} else {
+		lines = append(lines, "if m, ok := interface{}(v).(json.Marshaler); ok {\nw.marshaler(m)\nreturn\n}")
+		ctx.genJSONEncBody(t, &lines, x, 0)
+	}
+	lines = append(lines, "} //End of EncodeJSON"+alias+"\n")
+
+	lines = append(lines, fmt.Sprintf("func DecodeJSON%s(bz []byte) (v %s, err error) {", alias, alias))
+	lines = append(lines, "d := &codonJSONReader{bz: bz}")
+	lines = append(lines, fmt.Sprintf("decodeJSON%s(d, &v)", alias))
+	lines = append(lines, "return v, d.finish()")
+	lines = append(lines, "}")
+	lines = append(lines, fmt.Sprintf("func decodeJSON%s(d *codonJSONReader, v *%s) {", alias, alias))
+	if zero := ctx.zeroExpr(t); len(zero) != 0 {
+		lines = append(lines, fmt.Sprintf("if d.null() {\n*v = %s\nreturn\n}", zero))
+	}
+	if ctx.isLeafType(t) {
+		lines = append(lines, "d.unmarshal(v)")
+	} else {
+		lines = append(lines, "if u, ok := interface{}(v).(json.Unmarshaler); ok {\nd.unmarshaler(u)\nreturn\n}")
+		ctx.genJSONDecBody(t, &lines, x, 0)
+	}
+	lines = append(lines, "} //End of DecodeJSON"+alias+"\n")
+	return lines
+}
+
+// x must be addressable, because the registered types and json.Marshaler are called with pointers
+func (ctx *context) genJSONEncLines(t Type, lines *[]string, x string, iterLevel int) {
+	switch {
+	case t.Kind() == reflect.Ptr:
+		*lines = append(*lines, fmt.Sprintf("if %s == nil {\nw.raw(\"null\")\n} else {", x))
+		ctx.genJSONEncLines(t.Elem(), lines, "(*"+x+")", iterLevel)
+		*lines = append(*lines, "}")
+	case t.Kind() == reflect.Interface:
+		typePath := t.PkgPath() + "." + t.Name()
+		alias, ok := ctx.ifcPath2Alias[typePath]
+		if !ok {
+			panic("Cannot find alias for:" + typePath)
+		}
+		*lines = append(*lines, fmt.Sprintf("encodeJSON%s(w, %s)", alias, x))
+	case ctx.isLeafType(t):
+		*lines = append(*lines, fmt.Sprintf("w.marshal(%s)", addrExpr(x)))
+	case len(ctx.registeredAlias(t)) != 0:
+		*lines = append(*lines, fmt.Sprintf("encodeJSON%s(w, %s)", ctx.registeredAlias(t), addrExpr(x)))
+	case len(t.PkgPath()) != 0:
+		*lines = append(*lines, fmt.Sprintf("if m, ok := interface{}(%s).(json.Marshaler); ok {\nw.marshaler(m)\n} else {", addrExpr(x)))
+		ctx.genJSONEncBody(t, lines, x, iterLevel)
+		*lines = append(*lines, "}")
+	default:
+		ctx.genJSONEncBody(t, lines, x, iterLevel)
+	}
+}
+
+func (ctx *context) genJSONEncBody(t Type, lines *[]string, x string, iterLevel int) {
+	var line string
+	switch t.Kind() {
+	case reflect.Bool:
+		line = fmt.Sprintf("w.bool(bool(%s))", x)
+	case reflect.Int, reflect.Int64:
+		line = fmt.Sprintf("w.quotedInt(int64(%s))", x)
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		line = fmt.Sprintf("w.int(int64(%s))", x)
+	case reflect.Uint, reflect.Uint64:
+		line = fmt.Sprintf("w.quotedUint(uint64(%s))", x)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		line = fmt.Sprintf("w.uint(uint64(%s))", x)
+	case reflect.Float32:
+		line = fmt.Sprintf("w.marshal(float32(%s))", x)
+	case reflect.Float64:
+		line = fmt.Sprintf("w.marshal(float64(%s))", x)
+	case reflect.String:
+		line = fmt.Sprintf("w.string(string(%s))", x)
+	case reflect.Array:
+		if t.Elem().Kind() != reflect.Uint8 {
+			panic("ByteArray is the only supported array type")
+		}
+		line = fmt.Sprintf("w.bytes(%s[:])", x)
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			line = fmt.Sprintf("w.bytes(%s)", x)
+			break
+		}
+		iterVar := fmt.Sprintf("_%d", iterLevel)
+		*lines = append(*lines, fmt.Sprintf("if %s == nil {\nw.raw(\"null\")\n} else {", x))
+		*lines = append(*lines, "w.raw(\"[\")")
+		*lines = append(*lines, fmt.Sprintf("for %s := range %s {", iterVar, x))
+		*lines = append(*lines, "w.comma('[')")
+		ctx.enter("[]")
+		ctx.genJSONEncLines(t.Elem(), lines, x+"["+iterVar+"]", iterLevel+1)
+		ctx.leave()
+		*lines = append(*lines, "}")
+		line = "w.raw(\"]\")\n}"
+	case reflect.Map:
+		checkMapType(t)
+		key := fmt.Sprintf("key_%d", iterLevel)
+		value := fmt.Sprintf("value_%d", iterLevel)
+		*lines = append(*lines, "{ // map "+x)
+		*lines = append(*lines, "w.raw(\"{\")")
+		ctx.genSortedKeysLines(t.Key(), lines, x, iterLevel)
+		*lines = append(*lines, fmt.Sprintf("w.mapKey(%s)", jsonKeyExpr(t.Key(), key)))
+		*lines = append(*lines, fmt.Sprintf("%s := %s[%s]", value, x, key))
+		ctx.enter("[]")
+		ctx.genJSONEncLines(t.Elem(), lines, value, iterLevel+1)
+		ctx.leave()
+		*lines = append(*lines, "}")
+		*lines = append(*lines, "w.raw(\"}\")")
+		line = "} // end of " + x
+	case reflect.Struct:
+		ctx.genStructJSONEncLines(t, lines, x, iterLevel)
+		line = "// end of " + x
+	default:
+		panic(fmt.Sprintf("%s is not supported in JSON", t.Kind()))
+	}
+	*lines = append(*lines, line)
+}
+
+func (ctx *context) genStructJSONEncLines(t Type, lines *[]string, x string, iterLevel int) {
+	*lines = append(*lines, "w.raw(\"{\")")
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, omitEmpty, skip := jsonFieldName(field)
+		if skip {
+			continue
+		}
+		fieldName := x + "." + field.Name
+		key, _ := json.Marshal(name)
+		*lines = append(*lines, fieldMark(fieldName))
+		ctx.guard("."+field.Name, fieldKey(t, field), func() {
+			if omitEmpty {
+				*lines = append(*lines, fmt.Sprintf("if !(%s) {", ctx.emptyExpr(field.Type, fieldName)))
+			}
+			*lines = append(*lines, fmt.Sprintf("w.key(%s)", strconv.Quote(string(key)+":")))
+			ctx.genJSONEncLines(field.Type, lines, fieldName, iterLevel)
+			if omitEmpty {
+				*lines = append(*lines, "}")
+			}
+		})
+		*lines = append(*lines, endMark())
+	}
+	*lines = append(*lines, "w.raw(\"}\")")
+}
+
+// amino decodes null to the zero value of any type
+func (ctx *context) genJSONDecLines(t Type, lines *[]string, x string, iterLevel int) {
+	switch {
+	case t.Kind() == reflect.Ptr:
+		*lines = append(*lines, fmt.Sprintf("if d.null() {\n%s = nil\n} else {", x))
+		*lines = append(*lines, fmt.Sprintf("%s = new(%s)", x, ctx.getTypeExpr(t.Elem())))
+		ctx.genJSONDecLines(t.Elem(), lines, "(*"+x+")", iterLevel)
+		*lines = append(*lines, "}")
+	case t.Kind() == reflect.Interface:
+		typePath := t.PkgPath() + "." + t.Name()
+		alias, ok := ctx.ifcPath2Alias[typePath]
+		if !ok {
+			panic("Cannot find alias for:" + typePath)
+		}
+		*lines = append(*lines, fmt.Sprintf("decodeJSON%s(d, &%s)", alias, x))
+	case ctx.isLeafType(t):
+		*lines = append(*lines, fmt.Sprintf("if d.null() {\n%s = %s\n} else {", x, ctx.zeroExpr(t)))
+		*lines = append(*lines, fmt.Sprintf("d.unmarshal(%s)", addrExpr(x)))
+		*lines = append(*lines, "}")
+	case len(ctx.registeredAlias(t)) != 0:
+		*lines = append(*lines, fmt.Sprintf("decodeJSON%s(d, %s)", ctx.registeredAlias(t), addrExpr(x)))
+	default:
+		if zero := ctx.zeroExpr(t); len(zero) != 0 {
+			*lines = append(*lines, fmt.Sprintf("if d.null() {\n%s = %s\n} else {", x, zero))
+		} else {
+			*lines = append(*lines, "if !d.null() {")
+		}
+		if len(t.PkgPath()) != 0 {
+			*lines = append(*lines, fmt.Sprintf("if u, ok := interface{}(%s).(json.Unmarshaler); ok {\nd.unmarshaler(u)\n} else {", addrExpr(x)))
+			ctx.genJSONDecBody(t, lines, x, iterLevel)
+			*lines = append(*lines, "}")
+		} else {
+			ctx.genJSONDecBody(t, lines, x, iterLevel)
+		}
+		*lines = append(*lines, "}")
+	}
+}
+
+func (ctx *context) genJSONDecBody(t Type, lines *[]string, x string, iterLevel int) {
+	var line string
+	switch t.Kind() {
+	case reflect.Bool:
+		line = fmt.Sprintf("%s = %s", x, ctx.convExpr(t, "d.bool()", "bool"))
+	case reflect.Int, reflect.Int64:
+		line = fmt.Sprintf("%s = %s", x, ctx.convExpr(t, "d.quotedInt()", "int64"))
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		line = fmt.Sprintf("%s = %s", x, ctx.convExpr(t, fmt.Sprintf("d.int(%d)", bitSize(t)), "int64"))
+	case reflect.Uint, reflect.Uint64:
+		line = fmt.Sprintf("%s = %s", x, ctx.convExpr(t, "d.quotedUint()", "uint64"))
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		line = fmt.Sprintf("%s = %s", x, ctx.convExpr(t, fmt.Sprintf("d.uint(%d)", bitSize(t)), "uint64"))
+	case reflect.Float32, reflect.Float64:
+		line = fmt.Sprintf("%s = %s", x, ctx.convExpr(t, fmt.Sprintf("d.float(%d)", bitSize(t)), "float64"))
+	case reflect.String:
+		line = fmt.Sprintf("%s = %s", x, ctx.convExpr(t, "d.string()", "string"))
+	case reflect.Array:
+		if t.Elem().Kind() != reflect.Uint8 {
+			panic("ByteArray is the only supported array type")
+		}
+		line = fmt.Sprintf("d.byteArray(%s[:])", x)
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			line = fmt.Sprintf("%s = %s", x, ctx.convExpr(t, "d.bytes()", ""))
+			break
+		}
+		tmp := fmt.Sprintf("tmp_%d", iterLevel)
+		*lines = append(*lines, fmt.Sprintf("%s = nil", x))
+		*lines = append(*lines, "d.beginArray()")
+		*lines = append(*lines, "for d.nextElem() {")
+		*lines = append(*lines, fmt.Sprintf("var %s %s", tmp, ctx.getTypeExpr(t.Elem())))
+		ctx.enter("[]")
+		ctx.genJSONDecLines(t.Elem(), lines, tmp, iterLevel+1)
+		ctx.leave()
+		*lines = append(*lines, fmt.Sprintf("%s = append(%s, %s)", x, x, tmp))
+		line = "}"
+	case reflect.Map:
+		checkMapType(t)
+		keyT := t.Key()
+		key := fmt.Sprintf("key_%d", iterLevel)
+		value := fmt.Sprintf("value_%d", iterLevel)
+		*lines = append(*lines, fmt.Sprintf("%s = make(%s)", x, ctx.getTypeExpr(t)))
+		*lines = append(*lines, "d.beginObject()")
+		*lines = append(*lines, "for d.nextMember() {")
+		switch keyT.Kind() {
+		case reflect.String:
+			*lines = append(*lines, fmt.Sprintf("%s := %s", key, ctx.convExpr(keyT, "string(d.key)", "string")))
+		case reflect.Bool:
+			*lines = append(*lines, fmt.Sprintf("%s := %s", key, ctx.convExpr(keyT, "d.keyBool()", "bool")))
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			*lines = append(*lines, fmt.Sprintf("%s := %s", key, ctx.convExpr(keyT, fmt.Sprintf("d.keyInt(%d)", bitSize(keyT)), "int64")))
+		default:
+			*lines = append(*lines, fmt.Sprintf("%s := %s", key, ctx.convExpr(keyT, fmt.Sprintf("d.keyUint(%d)", bitSize(keyT)), "uint64")))
+		}
+		*lines = append(*lines, fmt.Sprintf("var %s %s", value, ctx.getTypeExpr(t.Elem())))
+		ctx.enter("[]")
+		ctx.genJSONDecLines(t.Elem(), lines, value, iterLevel+1)
+		ctx.leave()
+		*lines = append(*lines, fmt.Sprintf("%s[%s] = %s", x, key, value))
+		line = "} // end of " + x
+	case reflect.Struct:
+		ctx.genStructJSONDecLines(t, lines, x, iterLevel)
+		line = "// end of " + x
+	default:
+		panic(fmt.Sprintf("%s is not supported in JSON", t.Kind()))
+	}
+	*lines = append(*lines, line)
+}
+
+// the unknown members are skipped, and the missing members keep their values
+func (ctx *context) genStructJSONDecLines(t Type, lines *[]string, x string, iterLevel int) {
+	*lines = append(*lines, "d.beginObject()")
+	*lines = append(*lines, "for d.nextMember() {")
+	*lines = append(*lines, "switch string(d.key) {")
+	name2field := make(map[string]string, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, skip := jsonFieldName(field)
+		if skip {
+			continue
+		}
+		fieldName := x + "." + field.Name
+		*lines = append(*lines, fieldMark(fieldName))
+		ctx.guard("."+field.Name, fieldKey(t, field), func() {
+			if other, ok := name2field[name]; ok {
+				panic(fmt.Sprintf("JSON name %s is used by both %s and %s", name, other, field.Name))
+			}
+			name2field[name] = field.Name
+			*lines = append(*lines, fmt.Sprintf("case %s:", strconv.Quote(name)))
+			ctx.genJSONDecLines(field.Type, lines, fieldName, iterLevel)
+		})
+		*lines = append(*lines, endMark())
+	}
+	*lines = append(*lines, "default:\nd.skip()")
+	*lines = append(*lines, "} // end switch")
+	*lines = append(*lines, "} // end for")
+}
+
+func (ctx *context) generateIfcJSONFunc(ifc string, t Type) []string {
+	structPaths := ctx.ifcPath2StructPaths[t.PkgPath()+"."+t.Name()]
+	aliases := make([]string, 0, len(structPaths))
+	for _, structPath := range structPaths {
+		aliases = append(aliases, ctx.structPath2Alias[structPath])
+	}
+	sort.Strings(aliases)
+	lines := ctx.generateIfcJSONDecodeFunc(ifc, ifc, t, aliases)
+	return append(lines, ctx.generateIfcJSONEncodeFunc(ifc, aliases, "")...)
+}
+
+// returns the sorted aliases of the registered interfaces
+func (ctx *context) ifcAliases() []string {
+	aliases := make([]string, 0, len(ctx.ifcPath2Alias))
+	for _, alias := range ctx.ifcPath2Alias {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	return aliases
+}
+
+// A registered type in an interface is wrapped as {"type":name,"value":...}. If wrap is not empty,
+// it is the expression which decides whether to wrap.
+func (ctx *context) generateIfcJSONEncodeFunc(name string, aliases []string, wrap string) []string {
+	lines := make([]string, 0, 1000)
+	param, arg := "", ""
+	if len(wrap) != 0 {
+		param, arg = ", "+wrap+" bool", ", true"
+	}
+	lines = append(lines, fmt.Sprintf("func EncodeJSON%s(x interface{}) ([]byte, error) {", name))
+	lines = append(lines, "w := &codonJSONWriter{}")
+	lines = append(lines, fmt.Sprintf("encodeJSON%s(w, x%s)", name, arg))
+	lines = append(lines, "return w.result()")
+	lines = append(lines, "}")
+	lines = append(lines, fmt.Sprintf("func encodeJSON%s(w *codonJSONWriter, x interface{}%s) {", name, param))
+	lines = append(lines, "switch v := x.(type) {")
+	for _, alias := range aliases {
+		begin := fmt.Sprintf("w.beginType(%s)", strconv.Quote(ctx.structAlias2Name[alias]))
+		end := "w.raw(\"}\")"
+		if len(wrap) != 0 {
+			begin = fmt.Sprintf("if %s {\n%s\n}", wrap, begin)
+			end = fmt.Sprintf("if %s {\n%s\n}", wrap, end)
+		}
+		lines = append(lines, fmt.Sprintf("case %s:", alias))
+		lines = append(lines, begin)
+		lines = append(lines, fmt.Sprintf("encodeJSON%s(w, &v)", alias))
+		lines = append(lines, end)
+		lines = append(lines, fmt.Sprintf("case *%s:", alias))
+		lines = append(lines, begin)
+		lines = append(lines, fmt.Sprintf("encodeJSON%s(w, v)", alias))
+		lines = append(lines, end)
+	}
+	lines = append(lines, "case nil:\nw.raw(\"null\")")
+	lines = append(lines, "default:")
+	lines = append(lines, `w.fail(fmt.Errorf("Unknown Type %T", x))`)
+	lines = append(lines, "} // end of switch")
+	lines = append(lines, "} // end of func")
+	return lines
+}
+
+// The registered type is looked up by the name in the wrapper
+func (ctx *context) generateIfcJSONDecodeFunc(name, decTypeName string, decType Type, aliases []string) []string {
+	lines := make([]string, 0, 1000)
+	lines = append(lines, fmt.Sprintf("func DecodeJSON%s(bz []byte) (v %s, err error) {", name, decTypeName))
+	lines = append(lines, "d := &codonJSONReader{bz: bz}")
+	lines = append(lines, fmt.Sprintf("decodeJSON%s(d, &v)", name))
+	lines = append(lines, "return v, d.finish()")
+	lines = append(lines, "}")
+	lines = append(lines, fmt.Sprintf("func decodeJSON%s(d *codonJSONReader, v *%s) {", name, decTypeName))
+	lines = append(lines, "if d.null() {\n*v = nil\nreturn\n}")
+	lines = append(lines, "name, value := d.typeValue()")
+	lines = append(lines, "switch name {")
+	for _, alias := range aliases {
+		structType, ok := ctx.structAlias2Type[alias]
+		if !ok {
+			panic("Can not find type for " + alias)
+		}
+		lines = append(lines, fmt.Sprintf("case %s:", strconv.Quote(ctx.structAlias2Name[alias])))
+		lines = append(lines, fmt.Sprintf("var tmp %s", alias))
+		lines = append(lines, fmt.Sprintf("decodeJSON%s(value, &tmp)", alias))
+		if decType == nil || structType.Implements(decType) {
+			lines = append(lines, "*v = tmp")
+		} else if structType.PtrImplements(decType) {
+			lines = append(lines, "*v = &tmp")
+		}
+	}
+	lines = append(lines, "default:")
+	lines = append(lines, fmt.Sprintf("d.failf(\"Unknown type name %%q when decoding %s\", name)", decTypeName))
+	lines = append(lines, "} // end of switch")
+	lines = append(lines, "d.merge(value)")
+	lines = append(lines, "} // end of DecodeJSON"+name)
+	return lines
+}
+
+// decodeJSONPtr decodes into a pointer to a registered type, and returns false for the other pointers
+func (ctx *context) generateDecodeJSONPtrFunc(aliases, ifcAliases []string) []string {
+	lines := make([]string, 0, 1000)
+	lines = append(lines, "func decodeJSONPtr(d *codonJSONReader, ptr interface{}) bool {")
+	lines = append(lines, "switch p := ptr.(type) {")
+	for _, alias := range append(aliases, ifcAliases...) {
+		lines = append(lines, fmt.Sprintf("case *%s:", alias))
+		lines = append(lines, fmt.Sprintf("decodeJSON%s(d, p)", alias))
+	}
+	lines = append(lines, "default:\nreturn false")
+	lines = append(lines, "} // end of switch")
+	lines = append(lines, "return true")
+	lines = append(lines, "} // end of decodeJSONPtr")
+	return lines
+}
@@ -0,0 +1,136 @@
+package codon
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const lockFileHeader = `# This file records the magic numbers assigned to the registered types, and codon makes sure
+# they never change. Changing a magic number changes the encoded bytes of the type, so do not edit
+# this file by hand. Entries of the removed types are kept, such that their numbers are not reused.
+# alias magic_number type
+`
+
+type lockEntry struct {
+	magicNum uint32
+	typePath string
+}
+
+// the type's full name, or its description for unnamed types
+func typePathOf(t Type) string {
+	if len(t.PkgPath()) == 0 {
+		return t.String()
+	}
+	return t.PkgPath() + "." + t.Name()
+}
+
+// returns an empty lock if the file does not exist
+func readLockFile(fname string) (map[string]lockEntry, error) {
+	lock := make(map[string]lockEntry)
+	f, err := os.Open(fname)
+	if os.IsNotExist(err) {
+		return lock, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("%s:%d: expected 'alias magic_number type'", fname, lineNum)
+		}
+		magicNum, err := strconv.ParseUint(fields[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid magic number '%s'", fname, lineNum, fields[1])
+		}
+		if _, ok := lock[fields[0]]; ok {
+			return nil, fmt.Errorf("%s:%d: duplicated alias %s", fname, lineNum, fields[0])
+		}
+		lock[fields[0]] = lockEntry{magicNum: uint32(magicNum), typePath: fields[2]}
+	}
+	return lock, scanner.Err()
+}
+
+func writeLockFile(fname string, lock map[string]lockEntry) error {
+	aliases := make([]string, 0, len(lock))
+	for alias := range lock {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	var sb strings.Builder
+	sb.WriteString(lockFileHeader)
+	for _, alias := range aliases {
+		sb.WriteString(fmt.Sprintf("%s %d %s\n", alias, lock[alias].magicNum, lock[alias].typePath))
+	}
+	return ioutil.WriteFile(fname, []byte(sb.String()), 0644)
+}
+
+// checkLock compares the registered types' magic numbers with the locked ones, and returns the
+// updated lock which also contains the new types. A locked number must not change, and a new
+// number must not collide with a locked one. When a type's alias is renamed, its locked number
+// must be kept with an explicit TypeEntry.MagicNum, then the old alias is replaced in the lock.
+func (ctx *context) checkLock(lock map[string]lockEntry) map[string]lockEntry {
+	updated := make(map[string]lockEntry, len(lock))
+	num2alias := make(map[uint32]string, len(lock))
+	path2aliases := make(map[string][]string)
+	for alias, entry := range lock {
+		updated[alias] = entry
+		num2alias[entry.magicNum] = alias
+		path2aliases[entry.typePath] = append(path2aliases[entry.typePath], alias)
+	}
+	isRegistered := func(alias string) bool {
+		_, ok := ctx.structAlias2MagicNum[alias]
+		return ok
+	}
+
+	aliases := make([]string, 0, len(ctx.structAlias2MagicNum))
+	for alias := range ctx.structAlias2MagicNum {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	for _, alias := range aliases {
+		magicNum := ctx.structAlias2MagicNum[alias]
+		typePath := typePathOf(ctx.structAlias2Type[alias])
+		if entry, ok := lock[alias]; ok {
+			if entry.magicNum != magicNum {
+				ctx.addErrorAt(alias, fmt.Sprintf("Magic number would change from %d to %d, set TypeEntry.MagicNum to %d to keep it",
+					entry.magicNum, magicNum, entry.magicNum))
+				continue
+			}
+			updated[alias] = lockEntry{magicNum: magicNum, typePath: typePath}
+			continue
+		}
+		if other, ok := num2alias[magicNum]; ok {
+			if lock[other].typePath == typePath && !isRegistered(other) { // renamed
+				delete(updated, other)
+				updated[alias] = lockEntry{magicNum: magicNum, typePath: typePath}
+			} else {
+				ctx.addErrorAt(alias, fmt.Sprintf("Magic number %d collides with %s in the lock file", magicNum, other))
+			}
+			continue
+		}
+		renamed := false
+		for _, other := range path2aliases[typePath] {
+			if !isRegistered(other) {
+				ctx.addErrorAt(alias, fmt.Sprintf("%s was locked as %s with magic number %d, set TypeEntry.MagicNum to %d to keep it",
+					typePath, other, lock[other].magicNum, lock[other].magicNum))
+				renamed = true
+				break
+			}
+		}
+		if !renamed {
+			updated[alias] = lockEntry{magicNum: magicNum, typePath: typePath}
+		}
+	}
+	return updated
+}
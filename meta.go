@@ -1,38 +1,38 @@
 package codon
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"sort"
+	"strings"
 	"unicode"
 )
 
 func ShowInfoForVar(leafTypes map[string]string, v interface{}) {
-	t := reflect.TypeOf(v)
-	if t.Kind() == reflect.Ptr {
-		t = t.Elem()
-	}
+	t := derefPtr(v)
 	// Print the information header
 	fmt.Printf("======= %v '%s' '%s' == \n", t, t.PkgPath(), t.Name())
 	showInfo(leafTypes, "", t)
 }
 
-func structHasPrivateField(t reflect.Type) bool {
+func structHasPrivateField(t Type) bool {
 	for i := 0; i < t.NumField(); i++ {
-		field := t.Field(i)
-		var isPrivate bool
-		for _, r := range field.Name {
-			isPrivate = unicode.IsLower(r)
-			break
-		}
-		if isPrivate {
+		if isPrivateField(t.Field(i)) {
 			return true
 		}
 	}
 	return false
 }
 
-func showInfo(leafTypes map[string]string, indent string, t reflect.Type) {
+func isPrivateField(field StructField) bool {
+	for _, r := range field.Name {
+		return unicode.IsLower(r)
+	}
+	return false
+}
+
+func showInfo(leafTypes map[string]string, indent string, t Type) {
 	ending := ""
 	indentP := indent + "    "
 	switch t.Kind() {
@@ -75,7 +75,10 @@ func showInfo(leafTypes map[string]string, indent string, t reflect.Type) {
 	case reflect.Interface:
 		fmt.Printf("interface (%s %s)!", t.PkgPath(), t.Name())
 	case reflect.Map:
-		fmt.Printf("map!")
+		fmt.Printf("map[%s] {\n", t.Key().Kind())
+		fmt.Printf("%s", indentP)
+		showInfo(leafTypes, indentP, t.Elem())
+		ending = indent + "} //map"
 	case reflect.Ptr:
 		path := t.Elem().PkgPath() + "." + t.Elem().Name()
 		if _, ok := leafTypes[path]; ok { // Stop when meeting a leaf type
@@ -131,7 +134,7 @@ func showInfo(leafTypes map[string]string, indent string, t reflect.Type) {
 	fmt.Printf("%s\n", ending)
 }
 
-func getAllStructTypes(leafTypes map[string]string, t reflect.Type, name2type map[string]reflect.Type) {
+func getAllStructTypes(leafTypes map[string]string, t Type, name2type map[string]Type) {
 	for i := 0; i < t.NumField(); i++ {
 		field := t.Field(i)
 		switch field.Type.Kind() {
@@ -141,7 +144,7 @@ func getAllStructTypes(leafTypes map[string]string, t reflect.Type, name2type ma
 				name2type[field.Type.Name()] = field.Type
 				getAllStructTypes(leafTypes, field.Type, name2type)
 			}
-		case reflect.Ptr, reflect.Slice:
+		case reflect.Ptr, reflect.Slice, reflect.Map:
 			t := field.Type
 			if t.Elem().Kind() == reflect.Struct {
 				path := t.Elem().PkgPath() + "." + t.Elem().Name()
@@ -154,10 +157,60 @@ func getAllStructTypes(leafTypes map[string]string, t reflect.Type, name2type ma
 	}
 }
 
-func dumpProtoForMemberTypes(leafTypes map[string]string, indent string, t reflect.Type) {
+func dumpProtoForMemberTypes(leafTypes map[string]string, indent string, t Type) {
+}
+
+// returns the .proto type of a signed integer, which must agree with how it is encoded
+// With TwosComplementInts, int8 and int16 are also two's complement varints, declared as int32. Note that
+// go-amino writes them as zigzag varints.
+func (ctx *context) signedProtoType(t Type) string {
+	name := "int32"
+	if t.Kind() == reflect.Int || t.Kind() == reflect.Int64 {
+		name = "int64"
+	}
+	if ctx.opts.TwosComplementInts {
+		return name
+	}
+	return "s" + name
 }
 
-func dumpField(leafTypes map[string]string, prefix string, fieldName string, fieldType reflect.Type, fieldNum int) {
+// returns the type name used by map<K,V> in .proto files
+func (ctx *context) protoTypeName(t Type) string {
+	leafTypes := ctx.leafTypes
+	switch t.Kind() {
+	case reflect.Bool:
+		return "bool"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return ctx.signedProtoType(t)
+	case reflect.Uint, reflect.Uint64:
+		return "uint64"
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return "uint32"
+	case reflect.Float32:
+		return "float"
+	case reflect.Float64:
+		return "double"
+	case reflect.String:
+		return "string"
+	case reflect.Array, reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return "bytes"
+		}
+	case reflect.Ptr:
+		return ctx.protoTypeName(t.Elem())
+	case reflect.Struct:
+		if _, ok := leafTypes[t.PkgPath()+"."+t.Name()]; ok {
+			return "bytes"
+		}
+		return t.Name()
+	case reflect.Interface:
+		return t.Name()
+	}
+	panic(fmt.Sprintf("%s is not supported in map", t))
+}
+
+func (ctx *context) dumpField(prefix string, fieldName string, fieldType Type, fieldNum int) {
+	leafTypes := ctx.leafTypes
 	switch fieldType.Kind() {
 	case reflect.Uintptr:
 		panic("Uintptr is not supported")
@@ -165,28 +218,17 @@ func dumpField(leafTypes map[string]string, prefix string, fieldName string, fie
 		panic("complex64 is not supported")
 	case reflect.Complex128:
 		panic("complex128 is not supported")
-	case reflect.Float32:
-		panic("float32 is not supported")
-	case reflect.Float64:
-		panic("float64 is not supported")
 	case reflect.Chan:
 		panic("chan is not suported")
 	case reflect.Func:
 		panic("func is not suported")
 	case reflect.Map:
-		panic("map is not suported")
+		fmt.Printf(prefix+"map<%s, %s> %s = %d;\n", ctx.protoTypeName(fieldType.Key()),
+			ctx.protoTypeName(fieldType.Elem()), fieldName, fieldNum)
 	case reflect.Bool:
 		fmt.Printf(prefix+"bool %s = %d;\n", fieldName, fieldNum)
-	case reflect.Int:
-		fmt.Printf(prefix+"int64 %s = %d;\n", fieldName, fieldNum)
-	case reflect.Int8:
-		fmt.Printf(prefix+"int32 %s = %d;\n", fieldName, fieldNum)
-	case reflect.Int16:
-		fmt.Printf(prefix+"int32 %s = %d;\n", fieldName, fieldNum)
-	case reflect.Int32:
-		fmt.Printf(prefix+"int32 %s = %d;\n", fieldName, fieldNum)
-	case reflect.Int64:
-		fmt.Printf(prefix+"int64 %s = %d;\n", fieldName, fieldNum)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		fmt.Printf(prefix+"%s %s = %d;\n", ctx.signedProtoType(fieldType), fieldName, fieldNum)
 	case reflect.Uint:
 		fmt.Printf(prefix+"uint64 %s = %d;\n", fieldName, fieldNum)
 	case reflect.Uint8:
@@ -197,6 +239,10 @@ func dumpField(leafTypes map[string]string, prefix string, fieldName string, fie
 		fmt.Printf(prefix+"uint32 %s = %d;\n", fieldName, fieldNum)
 	case reflect.Uint64:
 		fmt.Printf(prefix+"uint64 %s = %d;\n", fieldName, fieldNum)
+	case reflect.Float32:
+		fmt.Printf(prefix+"float %s = %d;\n", fieldName, fieldNum)
+	case reflect.Float64:
+		fmt.Printf(prefix+"double %s = %d;\n", fieldName, fieldNum)
 	case reflect.Struct:
 		path := fieldType.PkgPath() + "." + fieldType.Name()
 		if _, ok := leafTypes[path]; ok {
@@ -232,38 +278,75 @@ func dumpField(leafTypes map[string]string, prefix string, fieldName string, fie
 	}
 }
 
-func dumpProto(leafTypes map[string]string, indent string, t reflect.Type) {
+func (ctx *context) dumpProto(indent string, t Type) {
+	leafTypes := ctx.leafTypes
 	if t.Kind() != reflect.Struct {
 		panic("Only accept struct types")
 	}
-	if structHasPrivateField(t) {
-		panic("Cannot support structs with private fields")
-	}
 	fmt.Printf(indent+"message %s {\n", t.Name())
 	dumpProtoForMemberTypes(leafTypes, indent+"    ", t)
 
+	fieldNums := getFieldNums(t)
 	for i := 0; i < t.NumField(); i++ {
 		field := t.Field(i)
-		fieldNum := i + 1
-		if field.Type.Kind() == reflect.Slice {
-			if field.Type.Elem().Kind() == reflect.Uint8 {
-				fmt.Printf(indent+"    bytes %s = %d;\n", field.Name, fieldNum)
-			} else {
-				t := field.Type.Elem()
-				if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
-					fmt.Printf(indent+"    repeated bytes %s = %d;\n", field.Name, fieldNum)
+		fieldNum := fieldNums[i]
+		if isUnrecognizedField(field) {
+			continue
+		}
+		// every private field is recorded, and the other fields are still checked
+		if isPrivateField(field) {
+			ctx.addErrorAt("."+field.Name, "Cannot support private fields")
+			continue
+		}
+		dumpFieldComments(indent+"    ", field)
+		ctx.guard("."+field.Name, "", func() {
+			if field.Type.Kind() == reflect.Slice {
+				if field.Type.Elem().Kind() == reflect.Uint8 {
+					fmt.Printf(indent+"    bytes %s = %d;\n", field.Name, fieldNum)
 				} else {
-					prefix := indent+"    repeated "
-					dumpField(leafTypes, prefix, field.Name, t, fieldNum)
+					t := field.Type.Elem()
+					if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+						fmt.Printf(indent+"    repeated bytes %s = %d;\n", field.Name, fieldNum)
+					} else {
+						prefix := indent + "    repeated "
+						ctx.dumpField(prefix, field.Name, t, fieldNum)
+					}
 				}
+			} else {
+				ctx.dumpField(indent+"    ", field.Name, field.Type, fieldNum)
 			}
-		} else {
-			dumpField(leafTypes, indent+"    ", field.Name, field.Type, fieldNum)
-		}
+		})
 	}
 	fmt.Printf(indent+"} // %s\n\n", t.Name())
 }
 
+// writes the field's doc comment, and the constants of its enum type, which are only known by the
+// source-based front end
+func dumpFieldComments(indent string, field StructField) {
+	for _, line := range strings.Split(field.Doc, "\n") {
+		if len(line) != 0 {
+			fmt.Printf(indent+"// %s\n", line)
+		}
+	}
+	t := field.Type
+	if t.Kind() == reflect.Slice || t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	enumType, ok := t.(EnumType)
+	if !ok {
+		return
+	}
+	consts := enumType.EnumConsts()
+	if len(consts) == 0 {
+		return
+	}
+	values := make([]string, len(consts))
+	for i, c := range consts {
+		values[i] = c.Name + " = " + c.Value
+	}
+	fmt.Printf(indent+"// %s: %s\n", t.Name(), strings.Join(values, ", "))
+}
+
 func (ctx *context) dumpIfcProto() {
 	ifcPathList := make([]string, 0, len(ctx.ifcPath2Type))
 	for name := range ctx.ifcPath2Type {
@@ -286,9 +369,9 @@ func (ctx *context) dumpIfcProto() {
 }
 
 func (ctx *context) dumpStructProto(typeEntryList []TypeEntry) {
-	name2type := make(map[string]reflect.Type)
+	name2type := make(map[string]Type)
 	for _, entry := range typeEntryList {
-		t := derefPtr(entry.Value)
+		t := entry.getType()
 		path := t.PkgPath() + "." + t.Name()
 		if _, ok := ctx.leafTypes[path]; ok {
 			continue
@@ -311,22 +394,26 @@ func (ctx *context) dumpStructProto(typeEntryList []TypeEntry) {
 	sort.Strings(names)
 	for _, name := range names {
 		t := name2type[name]
-		if t.Kind() == reflect.Struct {
-			dumpProto(ctx.leafTypes, "", t)
-		} else if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
-			fmt.Printf("message %s {\n", name)
-			fmt.Printf("    bytes %s = %d;\n", name+"_var", 1)
-			fmt.Printf("}\n")
-		} else if t.Kind() == reflect.Slice {
-			fmt.Printf("// %s is ignored (slice of %v)\n", name, t.Elem())
-		} else if t.Kind() != reflect.Interface {
-			fmt.Printf("message %s {\n", name)
-			dumpField(ctx.leafTypes, "    ", name+"_var", t, 1)
-			fmt.Printf("}\n")
-		}
+		ctx.guard(name, "", func() {
+			if t.Kind() == reflect.Struct {
+				ctx.dumpProto("", t)
+			} else if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+				fmt.Printf("message %s {\n", name)
+				fmt.Printf("    bytes %s = %d;\n", name+"_var", 1)
+				fmt.Printf("}\n")
+			} else if t.Kind() == reflect.Slice {
+				fmt.Printf("// %s is ignored (slice of %v)\n", name, t.Elem())
+			} else if t.Kind() != reflect.Interface {
+				fmt.Printf("message %s {\n", name)
+				ctx.dumpField("    ", name+"_var", t, 1)
+				fmt.Printf("}\n")
+			}
+		})
 	}
 }
 
+// DumpProtoFile prints the .proto file to stdout. If the registered types have unsupported fields
+// or other problems, the printed file is incomplete and all of the problems are returned as GenerateErrors.
 func DumpProtoFile(
 	// contains the types which should be regarded as leaf types
 	// Key is the full type name, Value is the short type name
@@ -335,17 +422,42 @@ func DumpProtoFile(
 	// Key is struct's alias and Value is interface's alias
 	ignoreImpl map[string]string,
 	// The types for which we will generate code
-	typeEntryList []TypeEntry) {
+	typeEntryList []TypeEntry) error {
+	return DumpProtoFileWithOptions(GeneratorOptions{}, leafTypes, ignoreImpl, typeEntryList)
+}
+
+// The dumped types of the signed integers follow opts.TwosComplementInts, so the .proto file matches the
+// bytes written by the codec generated with the same options. The interfaces are dumped as oneofs whose
+// field numbers are the magic numbers, which the amino-compatible mode does not write, so an error is
+// returned if opts.AminoCompatible is set.
+func DumpProtoFileWithOptions(
+	// options for the generated code
+	opts GeneratorOptions,
+	// contains the types which should be regarded as leaf types
+	// Key is the full type name, Value is the short type name
+	leafTypes map[string]string,
+	// Some struct->interface implementation relationship must be ignored
+	// Key is struct's alias and Value is interface's alias
+	ignoreImpl map[string]string,
+	// The types for which we will generate code
+	typeEntryList []TypeEntry) error {
+
+	if opts.AminoCompatible {
+		return errors.New("The .proto file can not describe the amino-compatible mode")
+	}
 
 	// Now initialize the context
 	ctx := newContext(leafTypes, ignoreImpl)
+	ctx.opts.TwosComplementInts = opts.TwosComplementInts
 	for _, entry := range typeEntryList {
-		ctx.register(entry.Alias, entry.Name, entry.Value)
+		ctx.guard(entry.Alias, "", func() {
+			ctx.register(entry.Alias, entry.Name, entry.getType(), entry.MagicNum)
+		})
 	}
 	ctx.analyzeIfc()
 
 	fmt.Printf("syntax = \"proto3\";\n")
 	ctx.dumpStructProto(typeEntryList)
 	ctx.dumpIfcProto()
+	return ctx.err()
 }
-
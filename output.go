@@ -0,0 +1,128 @@
+package codon
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/scanner"
+	"io"
+	"strings"
+)
+
+const (
+	DefaultPackageName = "codec"
+	DefaultBanner      = "Code generated by codon. DO NOT EDIT."
+)
+
+// A generated line starting with markPrefix is not written out. It marks the beginning of the code
+// generated for a type or a field, and a bare markPrefix marks the end. With the marks, a syntax error
+// in the generated code can be traced back to the type and field which produced it.
+const markPrefix = "\x00codon:"
+
+func beginMark(where string) string {
+	return markPrefix + where
+}
+
+func endMark() string {
+	return markPrefix
+}
+
+// the field paths in the generated code start with "v" (or are empty for DeepCopy), which is removed
+func fieldMark(fieldName string) string {
+	if strings.HasPrefix(fieldName, "v.") {
+		fieldName = fieldName[1:]
+	}
+	return beginMark("field " + strings.TrimPrefix(fieldName, "."))
+}
+
+// The beginning of a generated file: the banner, the build constraint and the package clause
+func (opts GeneratorOptions) writeHeader(w io.Writer) {
+	banner := opts.Banner
+	if len(banner) == 0 {
+		banner = DefaultBanner
+	}
+	for _, line := range strings.Split(banner, "\n") {
+		w.Write([]byte("// " + line + "\n"))
+	}
+	w.Write([]byte("\n"))
+	if len(opts.BuildTag) != 0 {
+		w.Write([]byte("//go:build " + opts.BuildTag + "\n\n"))
+	}
+	pkgName := opts.PackageName
+	if len(pkgName) == 0 {
+		pkgName = DefaultPackageName
+	}
+	// in the directive form, such that go/format does not turn it into a plain doc comment "// nolint"
+	w.Write([]byte("//nolint:all\npackage " + pkgName + "\n"))
+}
+
+type origin struct {
+	line  int // the first line (starting from 1) produced by this origin
+	where []string
+}
+
+// sourceBuffer collects a generated file before it is formatted, and remembers where its lines come from
+type sourceBuffer struct {
+	buf     bytes.Buffer
+	lineNum int
+	stack   []string
+	origins []origin
+}
+
+func (sb *sourceBuffer) Write(p []byte) (int, error) {
+	sb.lineNum += bytes.Count(p, []byte{'\n'})
+	return sb.buf.Write(p)
+}
+
+func (sb *sourceBuffer) mark(line string) {
+	if where := line[len(markPrefix):]; len(where) != 0 {
+		sb.stack = append(sb.stack, where)
+	} else if len(sb.stack) != 0 {
+		sb.stack = sb.stack[:len(sb.stack)-1]
+	}
+	where := make([]string, len(sb.stack))
+	copy(where, sb.stack)
+	sb.origins = append(sb.origins, origin{line: sb.lineNum + 1, where: where})
+}
+
+// returns the description of the type and field which produced the line
+func (sb *sourceBuffer) whereIs(line int) string {
+	var where []string
+	for _, o := range sb.origins {
+		if o.line > line {
+			break
+		}
+		where = o.where
+	}
+	switch len(where) {
+	case 0:
+		return "the fixed part of the file"
+	case 1:
+		return where[0]
+	}
+	// the outermost one is the type, and the innermost one is the field
+	return where[0] + ", " + where[len(where)-1]
+}
+
+func (sb *sourceBuffer) getLine(line int) string {
+	lines := strings.SplitN(sb.buf.String(), "\n", line+1)
+	if line < 1 || line > len(lines) {
+		return ""
+	}
+	return strings.TrimSpace(lines[line-1])
+}
+
+// formats the collected source code with go/format and writes it to w
+func (sb *sourceBuffer) writeTo(w io.Writer) error {
+	out, err := format.Source(sb.buf.Bytes())
+	if err != nil {
+		if errList, ok := err.(scanner.ErrorList); ok && len(errList) != 0 {
+			pos := errList[0].Pos
+			return fmt.Errorf("syntax error in the code generated for %s: %s (line %d: %s)",
+				sb.whereIs(pos.Line), errList[0].Msg, pos.Line, sb.getLine(pos.Line))
+		}
+		return fmt.Errorf("cannot format the generated code: %v", err)
+	}
+	_, err = w.Write(out)
+	return err
+}
@@ -8,7 +8,7 @@ import (
 
 var serializationTemplate = `
 func (ptr *AAA) ToBytes() []byte {
-	wBuf := make([]byte, 0, 64)
+	var wBuf []byte
 	EncodeAAA(&wBuf, *ptr)
 	return wBuf
 }
@@ -44,33 +44,64 @@ func GenerateSerializableImpl(
 	// extra logics to put in the generated code
 	extraLogics string,
 	// extra imported packages to put in the generated code
-	extraImports []string) {
+	extraImports []string) error {
+	return GenerateSerializableImplWithOptions(w, GeneratorOptions{}, leafTypes, ignoreImpl, typeEntryList, extraLogics, extraImports)
+}
 
-	// The beginning of the generated file
-	w.Write([]byte("//nolint\npackage codec\nimport (\n"))
-	for _, p := range extraImports {
-		w.Write([]byte(p + "\n"))
-	}
-	w.Write([]byte("\"encoding/binary\"\n\"errors\"\n)\n"))
-	w.Write([]byte(headerLogics))
-	w.Write([]byte(extraLogics))
+// The options must be the same as the codec file's, such that both of them write the same bytes
+func GenerateSerializableImplWithOptions(
+	//output target
+	w io.Writer,
+	// options for the generated code
+	opts GeneratorOptions,
+	// contains the types which should be regarded as leaf types
+	// Key is the full type name, Value is the short type name
+	leafTypes map[string]string,
+	// Some struct->interface implementation relationship must be ignored
+	// Key is struct's alias and Value is interface's alias
+	ignoreImpl map[string]string,
+	// The types for which we will generate code
+	typeEntryList []TypeEntry,
+	// extra logics to put in the generated code
+	extraLogics string,
+	// extra imported packages to put in the generated code
+	extraImports []string) error {
 
 	// Now initialize the context
 	ctx := newContext(leafTypes, ignoreImpl)
+	ctx.opts = opts
 	for _, entry := range typeEntryList {
-		ctx.register(entry.Alias, entry.Name, entry.Value)
+		ctx.guard(entry.Alias, "", func() {
+			ctx.register(entry.Alias, entry.Name, entry.getType(), entry.MagicNum)
+		})
 	}
 	ctx.analyzeIfc()
 
 	// Generate functions for structs
+	var lines []string
 	for _, entry := range typeEntryList {
-		t := derefPtr(entry.Value)
+		t := entry.getType()
 		if t.Kind() != reflect.Interface {
-			w.Write([]byte("// Non-Interface\n"))
-			lines := ctx.generateStructFunc(entry.Alias, t)
-			writeLines(w, lines)
+			lines = append(lines, "// Non-Interface", beginMark("type "+entry.Alias))
+			ctx.guard(entry.Alias, "", func() {
+				lines = append(lines, ctx.generateStructFunc(entry.Alias, t)...)
+			})
 			line := strings.Replace(serializationTemplate, "AAA", entry.Alias, -1)
-			writeLines(w, []string{line})
+			lines = append(lines, line, endMark())
 		}
 	}
+
+	// The generated functions are collected before the beginning of the file, such that only the
+	// default packages they use are imported
+	out := w
+	w = &sourceBuffer{}
+	opts.writeHeader(w)
+	writeImports(w, extraImports, headerLogics+extraLogics+strings.Join(lines, "\n"))
+	w.Write([]byte(headerLogics))
+	writeLines(w, []string{beginMark("the extra logics"), extraLogics, endMark()})
+	writeLines(w, lines)
+	if err := ctx.err(); err != nil {
+		return err
+	}
+	return w.(*sourceBuffer).writeTo(out)
 }
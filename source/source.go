@@ -0,0 +1,308 @@
+// Package source is a front end of codon which gets the type information from the source code
+// through go/packages and go/types, instead of runtime reflection. So the registered types can
+// be analyzed without compiling a program which imports all of their packages.
+package source
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/coinexchain/codon"
+)
+
+// Entry locates a type to register in the source code
+type Entry struct {
+	Alias string
+	Name  string
+	// The full type name, such as "github.com/cosmos/cosmos-sdk/x/bank.MsgSend"
+	Type string
+	// The explicit magic number, optional
+	MagicNum uint32
+}
+
+// splits a full type name into the package path and the short type name
+func splitTypePath(typePath string) (string, string, error) {
+	pos := strings.LastIndex(typePath, ".")
+	if pos <= 0 || pos == len(typePath)-1 || strings.LastIndex(typePath, "/") > pos {
+		return "", "", fmt.Errorf("invalid full type name '%s'", typePath)
+	}
+	return typePath[:pos], typePath[pos+1:], nil
+}
+
+// Load parses and type-checks the packages containing the entries' types, which are searched from
+// the directory dir (the current directory if it is empty). The returned list can be passed to
+// codon.GenerateCodecFile and the other generator functions.
+func Load(dir string, entries []Entry) ([]codon.TypeEntry, error) {
+	pkgPaths := make([]string, 0, len(entries))
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		pkgPath, _, err := splitTypePath(entry.Type)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[pkgPath] {
+			seen[pkgPath] = true
+			pkgPaths = append(pkgPaths, pkgPath)
+		}
+	}
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedSyntax,
+		Dir:  dir,
+	}
+	pkgs, err := packages.Load(cfg, pkgPaths...)
+	if err != nil {
+		return nil, err
+	}
+	if packages.PrintErrors(pkgs) > 0 {
+		return nil, fmt.Errorf("failed to load packages")
+	}
+	ld := &loader{docs: make(map[token.Pos]string)}
+	path2pkg := make(map[string]*packages.Package, len(pkgs))
+	for _, pkg := range pkgs {
+		path2pkg[pkg.PkgPath] = pkg
+		for _, file := range pkg.Syntax {
+			ld.collectDocs(file)
+		}
+	}
+
+	result := make([]codon.TypeEntry, 0, len(entries))
+	for _, entry := range entries {
+		pkgPath, name, _ := splitTypePath(entry.Type)
+		pkg, ok := path2pkg[pkgPath]
+		if !ok {
+			return nil, fmt.Errorf("cannot load package %s", pkgPath)
+		}
+		obj, ok := pkg.Types.Scope().Lookup(name).(*types.TypeName)
+		if !ok {
+			return nil, fmt.Errorf("cannot find type %s", entry.Type)
+		}
+		if !obj.Exported() {
+			return nil, fmt.Errorf("type %s is not exported", entry.Type)
+		}
+		result = append(result, codon.TypeEntry{
+			Alias:    entry.Alias,
+			Name:     entry.Name,
+			Type:     ld.newType(obj.Type()),
+			MagicNum: entry.MagicNum,
+		})
+	}
+	return result, nil
+}
+
+type loader struct {
+	// maps the positions of struct fields to their doc comments
+	docs map[token.Pos]string
+}
+
+func (ld *loader) collectDocs(file *ast.File) {
+	ast.Inspect(file, func(node ast.Node) bool {
+		st, ok := node.(*ast.StructType)
+		if !ok {
+			return true
+		}
+		for _, field := range st.Fields.List {
+			doc := field.Doc.Text()
+			if len(doc) == 0 {
+				doc = field.Comment.Text()
+			}
+			if len(doc) == 0 {
+				continue
+			}
+			doc = strings.TrimSpace(doc)
+			for _, ident := range field.Names {
+				ld.docs[ident.Pos()] = doc
+			}
+			if len(field.Names) == 0 { // an embedded field, whose position is its type's
+				ld.docs[field.Type.Pos()] = doc
+			}
+		}
+		return true
+	})
+}
+
+func (ld *loader) newType(t types.Type) goType {
+	return goType{t: types.Unalias(t), ld: ld}
+}
+
+// goType implements codon.Type with go/types
+type goType struct {
+	t  types.Type
+	ld *loader
+}
+
+var basicKinds = map[types.BasicKind]reflect.Kind{
+	types.Bool:          reflect.Bool,
+	types.Int:           reflect.Int,
+	types.Int8:          reflect.Int8,
+	types.Int16:         reflect.Int16,
+	types.Int32:         reflect.Int32,
+	types.Int64:         reflect.Int64,
+	types.Uint:          reflect.Uint,
+	types.Uint8:         reflect.Uint8,
+	types.Uint16:        reflect.Uint16,
+	types.Uint32:        reflect.Uint32,
+	types.Uint64:        reflect.Uint64,
+	types.Uintptr:       reflect.Uintptr,
+	types.Float32:       reflect.Float32,
+	types.Float64:       reflect.Float64,
+	types.Complex64:     reflect.Complex64,
+	types.Complex128:    reflect.Complex128,
+	types.String:        reflect.String,
+	types.UnsafePointer: reflect.UnsafePointer,
+}
+
+func (gt goType) Kind() reflect.Kind {
+	switch u := gt.t.Underlying().(type) {
+	case *types.Basic:
+		return basicKinds[u.Kind()]
+	case *types.Pointer:
+		return reflect.Ptr
+	case *types.Slice:
+		return reflect.Slice
+	case *types.Array:
+		return reflect.Array
+	case *types.Map:
+		return reflect.Map
+	case *types.Struct:
+		return reflect.Struct
+	case *types.Interface:
+		return reflect.Interface
+	case *types.Chan:
+		return reflect.Chan
+	case *types.Signature:
+		return reflect.Func
+	}
+	return reflect.Invalid
+}
+
+// Like reflect, a predeclared type's name is its own name and an unnamed type's name is empty
+func (gt goType) Name() string {
+	switch t := gt.t.(type) {
+	case *types.Named:
+		return t.Obj().Name()
+	case *types.Basic:
+		return types.Typ[t.Kind()].Name() // "uint8" instead of "byte"
+	}
+	return ""
+}
+
+func (gt goType) PkgPath() string {
+	if named, ok := gt.t.(*types.Named); ok && named.Obj().Pkg() != nil {
+		return named.Obj().Pkg().Path()
+	}
+	return ""
+}
+
+func (gt goType) String() string {
+	return types.TypeString(gt.t, func(pkg *types.Package) string { return pkg.Name() })
+}
+
+func (gt goType) Elem() codon.Type {
+	switch u := gt.t.Underlying().(type) {
+	case *types.Pointer:
+		return gt.ld.newType(u.Elem())
+	case *types.Slice:
+		return gt.ld.newType(u.Elem())
+	case *types.Array:
+		return gt.ld.newType(u.Elem())
+	case *types.Map:
+		return gt.ld.newType(u.Elem())
+	case *types.Chan:
+		return gt.ld.newType(u.Elem())
+	}
+	panic("Elem of invalid type " + gt.String())
+}
+
+func (gt goType) Key() codon.Type {
+	if u, ok := gt.t.Underlying().(*types.Map); ok {
+		return gt.ld.newType(u.Key())
+	}
+	panic("Key of non-map type " + gt.String())
+}
+
+func (gt goType) Len() int {
+	if u, ok := gt.t.Underlying().(*types.Array); ok {
+		return int(u.Len())
+	}
+	panic("Len of non-array type " + gt.String())
+}
+
+func (gt goType) structType() *types.Struct {
+	if u, ok := gt.t.Underlying().(*types.Struct); ok {
+		return u
+	}
+	panic("Field of non-struct type " + gt.String())
+}
+
+func (gt goType) NumField() int {
+	return gt.structType().NumFields()
+}
+
+func (gt goType) Field(i int) codon.StructField {
+	st := gt.structType()
+	v := st.Field(i)
+	field := codon.StructField{
+		Name: v.Name(),
+		Type: gt.ld.newType(v.Type()),
+		Tag:  reflect.StructTag(st.Tag(i)),
+		Doc:  gt.ld.docs[v.Pos()],
+	}
+	if !v.Exported() && v.Pkg() != nil {
+		field.PkgPath = v.Pkg().Path()
+	}
+	return field
+}
+
+// EnumConsts implements codon.EnumType. The constants must be declared in the package of the named
+// integer type.
+func (gt goType) EnumConsts() []codon.EnumConst {
+	named, ok := gt.t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return nil
+	}
+	if basic, ok := named.Underlying().(*types.Basic); !ok || basic.Info()&types.IsInteger == 0 {
+		return nil
+	}
+	var consts []*types.Const
+	scope := named.Obj().Pkg().Scope()
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if ok && types.Identical(c.Type(), named) {
+			consts = append(consts, c)
+		}
+	}
+	sort.Slice(consts, func(i, j int) bool {
+		return consts[i].Pos() < consts[j].Pos()
+	})
+	var res []codon.EnumConst
+	for _, c := range consts {
+		res = append(res, codon.EnumConst{Name: c.Name(), Value: c.Val().ExactString()})
+	}
+	return res
+}
+
+func (gt goType) interfaceOf(ifc codon.Type) (*types.Interface, bool) {
+	other, ok := ifc.(goType)
+	if !ok {
+		return nil, false
+	}
+	iface, ok := other.t.Underlying().(*types.Interface)
+	return iface, ok
+}
+
+func (gt goType) Implements(ifc codon.Type) bool {
+	iface, ok := gt.interfaceOf(ifc)
+	return ok && types.Implements(gt.t, iface)
+}
+
+func (gt goType) PtrImplements(ifc codon.Type) bool {
+	iface, ok := gt.interfaceOf(ifc)
+	return ok && types.Implements(types.NewPointer(gt.t), iface)
+}
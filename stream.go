@@ -0,0 +1,269 @@
+package codon
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// The runtime of the generated stream functions, which write and read a sequence of length-prefixed
+// values in the format of MarshalBinaryLengthPrefixed
+var streamLogics = `
+// Encoder writes a sequence of length-prefixed values to an io.Writer. The encoded bytes of a value
+// are written in chunks, so a big value does not need a big buffer.
+type Encoder struct {
+	w   *bufio.Writer
+	buf []byte
+}
+
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: bufio.NewWriter(w)}
+}
+
+// Encode writes a registered value with its length prefix, just like MarshalBinaryLengthPrefixed
+func (e *Encoder) Encode(v interface{}) error {
+	if _, ok := getMagicNumOfVar(v); !ok {
+		return errors.New("Not Supported Type")
+	}
+	s := &codonSizes{out: e.w}
+	size := sizeAny(v, s)
+	e.buf = e.buf[:0]
+	codonWriteUvarint(&e.buf, uint64(size))
+	encodeAny(&e.buf, v, s)
+	if s.err == nil {
+		_, s.err = e.w.Write(e.buf)
+	}
+	return s.err
+}
+
+// Flush writes the buffered bytes to the underlying io.Writer
+func (e *Encoder) Flush() error {
+	return e.w.Flush()
+}
+
+// Decoder reads the values written by Encoder from an io.Reader. After an error, the rest of the stream
+// cannot be read.
+type Decoder struct {
+	// The limits used when decoding each value. MaxTotalBytes also limits the length prefixes.
+	DecodeOptions DecodeOptions
+	r             *bufio.Reader
+	buf           []byte
+	// the number of bytes left in the struct read by a DecodeEach function
+	remaining uint64
+}
+
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: bufio.NewReader(r)}
+}
+
+// Decode reads a value written by Encoder.Encode. io.EOF is returned at the end of the stream.
+func (dec *Decoder) Decode() (interface{}, error) {
+	length, err := dec.readLength()
+	if err != nil {
+		return nil, err
+	}
+	bz, err := dec.read(dec.buf[:0], length)
+	if err != nil {
+		return nil, err
+	}
+	dec.buf = bz
+	v, _, err := DecodeAnyWithOptions(bz, dec.DecodeOptions)
+	return v, err
+}
+
+func (dec *Decoder) readLength() (uint64, error) {
+	length, err := binary.ReadUvarint(dec.r)
+	if err != nil {
+		return 0, err
+	}
+	if max := dec.DecodeOptions.MaxTotalBytes; max > 0 && length > uint64(max) {
+		return 0, &ErrLimitExceeded{Limit: "MaxTotalBytes", Max: max, Actual: int(length)}
+	}
+	return length, nil
+}
+
+// appends n bytes read from the stream to buf
+func (dec *Decoder) read(buf []byte, n uint64) ([]byte, error) {
+	start := len(buf)
+	if uint64(cap(buf)-start) < n {
+		newBuf := make([]byte, start, uint64(start)+n)
+		copy(newBuf, buf)
+		buf = newBuf
+	}
+	buf = buf[:uint64(start)+n]
+	_, err := io.ReadFull(dec.r, buf[start:])
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return buf, err
+}
+
+// appends a varint read from the stream to buf, and returns its value
+func (dec *Decoder) readVarint(buf []byte) ([]byte, uint64, error) {
+	start := len(buf)
+	for i := 0; i < binary.MaxVarintLen64; i++ {
+		b, err := dec.r.ReadByte()
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return buf, 0, err
+		}
+		buf = append(buf, b)
+		if b < 0x80 {
+			v, n := binary.Uvarint(buf[start:])
+			if n <= 0 {
+				return buf, 0, errors.New("EOF decoding varint")
+			}
+			return buf, v, nil
+		}
+	}
+	return buf, 0, errors.New("EOF decoding varint")
+}
+
+// begins to read a struct written by Encoder.Encode, and checks its magic number (or its prefix bytes
+// in the amino-compatible mode) against the alias. Then its fields can be read by nextField.
+func (dec *Decoder) beginStruct(magicNum uint32, aminoCompatible bool, alias string) error {
+	length, err := dec.readLength()
+	if err != nil {
+		return err
+	}
+	var header []byte
+	var found uint32
+	if aminoCompatible {
+		if length < 4 {
+			return errors.New("Prefix Bytes Too Short")
+		}
+		if header, err = dec.read(nil, 4); err != nil {
+			return err
+		}
+		found = binary.BigEndian.Uint32(header)
+		dec.remaining = length - 4
+	} else {
+		var tag, size uint64
+		if header, tag, err = dec.readVarint(nil); err != nil {
+			return err
+		}
+		if header, size, err = dec.readVarint(header); err != nil {
+			return err
+		}
+		if tag&7 != 2 || uint64(len(header)) > length || size != length-uint64(len(header)) {
+			return errors.New("Length Mismatch")
+		}
+		found = uint32(tag >> 3)
+		dec.remaining = size
+	}
+	if found != magicNum {
+		return &ErrTypeMismatch{MagicNum: found, Alias: getAliasOfMagicNum(found), Target: alias}
+	}
+	return nil
+}
+
+// reads the next field of the struct begun by beginStruct, and returns its tag and the bytes after
+// the tag. io.EOF is returned at the end of the struct.
+func (dec *Decoder) nextField() (tag uint64, bz []byte, err error) {
+	if dec.remaining == 0 {
+		return 0, nil, io.EOF
+	}
+	var tagBuf [binary.MaxVarintLen64]byte
+	var tagBz []byte
+	if tagBz, tag, err = dec.readVarint(tagBuf[:0]); err != nil {
+		return
+	}
+	bz = dec.buf[:0]
+	switch tag & 7 {
+	case 0: // varint
+		bz, _, err = dec.readVarint(bz)
+	case 1: // fixed64
+		bz, err = dec.read(bz, 8)
+	case 2: // length-delimited
+		var length uint64
+		if bz, length, err = dec.readVarint(bz); err != nil {
+			return
+		}
+		if length > dec.remaining {
+			err = errors.New("Not enough bytes to read")
+			return
+		}
+		bz, err = dec.read(bz, length)
+	case 5: // fixed32
+		bz, err = dec.read(bz, 4)
+	default:
+		err = fmt.Errorf("Unsupported wire type %d", tag&7)
+	}
+	if err != nil {
+		return
+	}
+	dec.buf = bz
+	if n := uint64(len(tagBz) + len(bz)); n <= dec.remaining {
+		dec.remaining -= n
+	} else {
+		err = errors.New("Length Mismatch")
+	}
+	return
+}
+`
+
+func isRepeated(t Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8
+}
+
+// DecodeEach<Alias> reads a struct from a Decoder field by field. Each element of the repeated fields is
+// passed to fn as soon as it is decoded, instead of being appended to the returned struct.
+func (ctx *context) generateDecodeEachFunc(alias string, t Type) []string {
+	lines := make([]string, 0, 1000)
+	if t.Kind() != reflect.Struct || ctx.isLeafType(t) {
+		return lines
+	}
+	hasRepeated := false
+	for i := 0; i < t.NumField(); i++ {
+		hasRepeated = hasRepeated || isRepeated(t.Field(i).Type)
+	}
+	if !hasRepeated {
+		return lines
+	}
+	lines = append(lines, fmt.Sprintf("func (dec *Decoder) DecodeEach%s(fn func(field string, elem interface{}) error) (v %s, err error) {", alias, alias))
+	lines = append(lines, fmt.Sprintf("if err = dec.beginStruct(%d, %v, \"%s\"); err != nil {\nreturn\n}",
+		ctx.structAlias2MagicNum[alias], ctx.opts.AminoCompatible, alias))
+	lines = append(lines, "d := &codonDecoder{opts: dec.DecodeOptions}")
+	lines = append(lines, "if err = d.enter(); err != nil {\nreturn\n}")
+	lines = append(lines, "var n, total int")
+	// the elements of a repeated field may come with several tags, so they are counted across the tags
+	lines = append(lines, "counts := make(map[string]int)")
+	lines = append(lines, "for {")
+	lines = append(lines, "tag, bz, e := dec.nextField()")
+	lines = append(lines, "if e == io.EOF {\nbreak\n}")
+	lines = append(lines, "if e != nil {\nerr = e\nreturn\n}")
+	lines = append(lines, "switch tag >> 3 {")
+	fieldNums := getFieldNums(t)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if isUnrecognizedField(field) {
+			continue
+		}
+		fieldName := "v." + field.Name
+		lines = append(lines, fieldMark(fieldName))
+		lines = append(lines, fmt.Sprintf("case %d: // %s", fieldNums[i], fieldName))
+		ctx.guard("."+field.Name, fieldKey(t, field), func() {
+			if !isRepeated(field.Type) {
+				ctx.genFieldDecLines(fieldNums[i], field.Type, &lines, fieldName, 0)
+				return
+			}
+			// the elements in this field are decoded into elems, and then passed to fn
+			lines = append(lines, fmt.Sprintf("var elems %s", ctx.getTypeExpr(field.Type)))
+			ctx.genFieldDecLines(fieldNums[i], field.Type, &lines, "elems", 0)
+			lines = append(lines, "for _, elem := range elems {")
+			lines = append(lines, fmt.Sprintf("counts[\"%s\"]++", field.Name))
+			lines = append(lines, fmt.Sprintf("if err = d.checkSliceLength(counts[\"%s\"]); err != nil {\nreturn\n}", field.Name))
+			lines = append(lines, fmt.Sprintf("if err = fn(\"%s\", elem); err != nil {\nreturn\n}", field.Name))
+			lines = append(lines, "}")
+		})
+		lines = append(lines, endMark())
+	}
+	ctx.genUnknownFieldLines(&lines, ctx.getUnrecognized(t, "v"))
+	lines = append(lines, "} // end for")
+	lines = append(lines, "_ = total")
+	lines = append(lines, "d.leave()")
+	lines = append(lines, "return v, nil")
+	lines = append(lines, "} //End of DecodeEach"+alias+"\n")
+	return lines
+}
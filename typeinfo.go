@@ -0,0 +1,88 @@
+package codon
+
+import (
+	"reflect"
+)
+
+// Type is the type information used by the generator. The runtime type of TypeEntry.Value
+// provides it through reflection, and the source-based front end provides it through go/types.
+// The methods have the same meanings as reflect.Type's.
+type Type interface {
+	Kind() reflect.Kind
+	Name() string
+	PkgPath() string
+	String() string
+	Elem() Type
+	Key() Type
+	Len() int
+	NumField() int
+	Field(i int) StructField
+	// Whether this type implements the interface type ifc
+	Implements(ifc Type) bool
+	// Whether the pointer to this type implements the interface type ifc
+	PtrImplements(ifc Type) bool
+}
+
+// StructField describes a field of a struct, like reflect.StructField
+type StructField struct {
+	Name string
+	// Empty for exported fields, and the package path for unexported fields
+	PkgPath string
+	Type    Type
+	Tag     reflect.StructTag
+	// The field's doc comment, which is only available from the source-based front end
+	Doc string
+}
+
+// EnumConst is a constant declared with a named integer type, such as a member of an enum
+type EnumConst struct {
+	Name string
+	// The exact value in Go's syntax, such as "-1"
+	Value string
+}
+
+// EnumType is implemented by the Types which know the constants declared with them. The source-based
+// front end implements it, while reflection cannot see the constants.
+type EnumType interface {
+	Type
+	// The constants in the order of their declarations, or nil if there is none
+	EnumConsts() []EnumConst
+}
+
+type reflectType struct {
+	t reflect.Type
+}
+
+// TypeOfReflect returns the Type which provides the type information of t through reflection
+func TypeOfReflect(t reflect.Type) Type {
+	return reflectType{t: t}
+}
+
+func (rt reflectType) Kind() reflect.Kind { return rt.t.Kind() }
+func (rt reflectType) Name() string       { return rt.t.Name() }
+func (rt reflectType) PkgPath() string    { return rt.t.PkgPath() }
+func (rt reflectType) String() string     { return rt.t.String() }
+func (rt reflectType) Elem() Type         { return reflectType{t: rt.t.Elem()} }
+func (rt reflectType) Key() Type          { return reflectType{t: rt.t.Key()} }
+func (rt reflectType) Len() int           { return rt.t.Len() }
+func (rt reflectType) NumField() int      { return rt.t.NumField() }
+
+func (rt reflectType) Field(i int) StructField {
+	field := rt.t.Field(i)
+	return StructField{
+		Name:    field.Name,
+		PkgPath: field.PkgPath,
+		Type:    reflectType{t: field.Type},
+		Tag:     field.Tag,
+	}
+}
+
+func (rt reflectType) Implements(ifc Type) bool {
+	other, ok := ifc.(reflectType)
+	return ok && rt.t.Implements(other.t)
+}
+
+func (rt reflectType) PtrImplements(ifc Type) bool {
+	other, ok := ifc.(reflectType)
+	return ok && reflect.PtrTo(rt.t).Implements(other.t)
+}
@@ -1,8 +1,9 @@
 package amino
 
 import (
-	"io"
+	"errors"
 	aminoOrig "github.com/tendermint/go-amino"
+	"io"
 )
 
 const Typ3_ByteLength = aminoOrig.Typ3_ByteLength
@@ -30,10 +31,27 @@ type Sealer interface {
 	SealImp()
 }
 
+// JSONCodec is implemented by the CodecIfc which also supports amino's JSON format, such as codon's CodecImp.
+// Its methods are not named MarshalJSON and UnmarshalJSON, which belong to json.Marshaler and json.Unmarshaler.
+type JSONCodec interface {
+	MarshalJSONAny(o interface{}) ([]byte, error)
+	MarshalJSONIndent(o interface{}, prefix, indent string) ([]byte, error)
+	UnmarshalJSONAny(bz []byte, ptr interface{}) error
+}
+
+// ErrUnsupportedType is returned by a JSONCodec for the types it does not support, and then
+// the original amino is used instead
+var ErrUnsupportedType = errors.New("Not Supported Type")
+
 type Codec struct {
 	onlyOrig bool
-	imp CodecIfc
-	cdc *aminoOrig.Codec
+	imp      CodecIfc
+	cdc      *aminoOrig.Codec
+
+	// for the verification mode, see EnableVerification
+	verify   bool
+	strict   bool
+	reporter func(*Mismatch)
 }
 
 type StubIfc interface {
@@ -83,50 +101,133 @@ func NewCodec() *Codec {
 	if Stub == nil { // use the orignal amino
 		cdc := aminoOrig.NewCodec()
 		return &Codec{
-			imp: cdc,
-			cdc: cdc,
+			imp:      cdc,
+			cdc:      cdc,
 			onlyOrig: true,
 		}
 	}
 	return &Codec{
-		imp: Stub.NewCodecImp(),
-		cdc: aminoOrig.NewCodec(),
+		imp:      Stub.NewCodecImp(),
+		cdc:      aminoOrig.NewCodec(),
 		onlyOrig: false,
 	}
 }
 
 func (cdc *Codec) MarshalBinaryBare(o interface{}) ([]byte, error) {
+	if cdc.verifying() {
+		return cdc.verifyMarshal("MarshalBinaryBare", o, cdc.cdc.MarshalBinaryBare, cdc.imp.MarshalBinaryBare,
+			cdc.cdc.UnmarshalBinaryBare)
+	}
 	return cdc.imp.MarshalBinaryBare(o)
 }
 func (cdc *Codec) MarshalBinaryLengthPrefixed(o interface{}) ([]byte, error) {
+	if cdc.verifying() {
+		return cdc.verifyMarshal("MarshalBinaryLengthPrefixed", o, cdc.cdc.MarshalBinaryLengthPrefixed,
+			cdc.imp.MarshalBinaryLengthPrefixed, cdc.cdc.UnmarshalBinaryLengthPrefixed)
+	}
 	return cdc.imp.MarshalBinaryLengthPrefixed(o)
 }
 func (cdc *Codec) MarshalBinaryLengthPrefixedWriter(w io.Writer, o interface{}) (n int64, err error) {
+	if cdc.verifying() {
+		bz, err := cdc.MarshalBinaryLengthPrefixed(o)
+		if err != nil {
+			return 0, err
+		}
+		_n, err := w.Write(bz)
+		return int64(_n), err
+	}
 	return cdc.imp.MarshalBinaryLengthPrefixedWriter(w, o)
 }
+func (cdc *Codec) jsonImp() (JSONCodec, bool) {
+	if cdc.onlyOrig {
+		return nil, false
+	}
+	j, ok := cdc.imp.(JSONCodec)
+	return j, ok
+}
 func (cdc *Codec) MarshalJSON(o interface{}) ([]byte, error) {
-	return cdc.cdc.MarshalJSON(o)
+	j, ok := cdc.jsonImp()
+	if !ok {
+		return cdc.cdc.MarshalJSON(o)
+	}
+	if cdc.verifying() {
+		return cdc.verifyMarshal("MarshalJSON", o, cdc.cdc.MarshalJSON, j.MarshalJSONAny, cdc.cdc.UnmarshalJSON)
+	}
+	bz, err := j.MarshalJSONAny(o)
+	if err == ErrUnsupportedType {
+		return cdc.cdc.MarshalJSON(o)
+	}
+	return bz, err
 }
 func (cdc *Codec) MarshalJSONIndent(o interface{}, prefix, indent string) ([]byte, error) {
-	return cdc.cdc.MarshalJSONIndent(o, prefix, indent)
+	j, ok := cdc.jsonImp()
+	if !ok {
+		return cdc.cdc.MarshalJSONIndent(o, prefix, indent)
+	}
+	if cdc.verifying() {
+		orig := func(o interface{}) ([]byte, error) {
+			return cdc.cdc.MarshalJSONIndent(o, prefix, indent)
+		}
+		imp := func(o interface{}) ([]byte, error) {
+			return j.MarshalJSONIndent(o, prefix, indent)
+		}
+		return cdc.verifyMarshal("MarshalJSONIndent", o, orig, imp, cdc.cdc.UnmarshalJSON)
+	}
+	bz, err := j.MarshalJSONIndent(o, prefix, indent)
+	if err == ErrUnsupportedType {
+		return cdc.cdc.MarshalJSONIndent(o, prefix, indent)
+	}
+	return bz, err
 }
 func (cdc *Codec) MustMarshalBinaryBare(o interface{}) []byte {
+	if cdc.verifying() {
+		bz, err := cdc.MarshalBinaryBare(o)
+		if err != nil {
+			panic(err)
+		}
+		return bz
+	}
 	return cdc.imp.MustMarshalBinaryBare(o)
 }
 func (cdc *Codec) MustMarshalBinaryLengthPrefixed(o interface{}) []byte {
+	if cdc.verifying() {
+		bz, err := cdc.MarshalBinaryLengthPrefixed(o)
+		if err != nil {
+			panic(err)
+		}
+		return bz
+	}
 	return cdc.imp.MustMarshalBinaryLengthPrefixed(o)
 }
 func (cdc *Codec) MustMarshalJSON(o interface{}) []byte {
-	return cdc.cdc.MustMarshalJSON(o)
+	bz, err := cdc.MarshalJSON(o)
+	if err != nil {
+		panic(err)
+	}
+	return bz
 }
 func (cdc *Codec) MustUnmarshalBinaryBare(bz []byte, ptr interface{}) {
+	if cdc.verifying() {
+		if err := cdc.UnmarshalBinaryBare(bz, ptr); err != nil {
+			panic(err)
+		}
+		return
+	}
 	cdc.imp.MustUnmarshalBinaryBare(bz, ptr)
 }
 func (cdc *Codec) MustUnmarshalBinaryLengthPrefixed(bz []byte, ptr interface{}) {
+	if cdc.verifying() {
+		if err := cdc.UnmarshalBinaryLengthPrefixed(bz, ptr); err != nil {
+			panic(err)
+		}
+		return
+	}
 	cdc.imp.MustUnmarshalBinaryLengthPrefixed(bz, ptr)
 }
 func (cdc *Codec) MustUnmarshalJSON(bz []byte, ptr interface{}) {
-	cdc.cdc.MustUnmarshalJSON(bz, ptr)
+	if err := cdc.UnmarshalJSON(bz, ptr); err != nil {
+		panic(err)
+	}
 }
 func (cdc *Codec) PrintTypes(out io.Writer) error {
 	return cdc.imp.PrintTypes(out)
@@ -159,18 +260,37 @@ func (cdc *Codec) Seal() *Codec {
 	return cdc
 }
 func (cdc *Codec) UnmarshalBinaryBare(bz []byte, ptr interface{}) error {
+	if cdc.verifying() {
+		return cdc.verifyUnmarshal("UnmarshalBinaryBare", bz, ptr, cdc.cdc.UnmarshalBinaryBare, cdc.imp.UnmarshalBinaryBare)
+	}
 	return cdc.imp.UnmarshalBinaryBare(bz, ptr)
 }
 func (cdc *Codec) UnmarshalBinaryLengthPrefixed(bz []byte, ptr interface{}) error {
+	if cdc.verifying() {
+		return cdc.verifyUnmarshal("UnmarshalBinaryLengthPrefixed", bz, ptr, cdc.cdc.UnmarshalBinaryLengthPrefixed,
+			cdc.imp.UnmarshalBinaryLengthPrefixed)
+	}
 	return cdc.imp.UnmarshalBinaryLengthPrefixed(bz, ptr)
 }
 func (cdc *Codec) UnmarshalBinaryLengthPrefixedReader(r io.Reader, ptr interface{}, maxSize int64) (n int64, err error) {
+	if cdc.verifying() {
+		return cdc.verifyUnmarshalReader(r, ptr, maxSize)
+	}
 	return cdc.imp.UnmarshalBinaryLengthPrefixedReader(r, ptr, maxSize)
 }
 func (cdc *Codec) UnmarshalJSON(bz []byte, ptr interface{}) error {
-	//fmt.Printf("cdc : %v\n", cdc)
-	//fmt.Printf("cdc.cdc : %v\n", cdc.cdc)
-	return cdc.cdc.UnmarshalJSON(bz, ptr)
+	j, ok := cdc.jsonImp()
+	if !ok {
+		return cdc.cdc.UnmarshalJSON(bz, ptr)
+	}
+	if cdc.verifying() {
+		return cdc.verifyUnmarshal("UnmarshalJSON", bz, ptr, cdc.cdc.UnmarshalJSON, j.UnmarshalJSONAny)
+	}
+	err := j.UnmarshalJSONAny(bz, ptr)
+	if err == ErrUnsupportedType {
+		return cdc.cdc.UnmarshalJSON(bz, ptr)
+	}
+	return err
 }
 
 ////////////////////////////////////////////////////////////////////////////////////
@@ -196,7 +316,6 @@ func UvarintSize(u uint64) int {
 	return Stub.UvarintSize(u)
 }
 
-
 func EncodeByteSlice(w io.Writer, bz []byte) (err error) {
 	err = Stub.EncodeByteSlice(w, bz)
 	return
@@ -0,0 +1,167 @@
+package amino
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// Mismatch describes a call whose results from codon and from the original amino are different
+type Mismatch struct {
+	// The called method, such as "MarshalBinaryBare"
+	Method string
+	// The object passed to a marshal method, or the pointer passed to an unmarshal method
+	Object interface{}
+	// The bytes encoded by the original amino and by codon. For the unmarshal methods, both
+	// of them are the decoded bytes.
+	OrigBytes []byte
+	ImpBytes  []byte
+	// The values decoded by the original amino and by codon, only for the unmarshal methods
+	OrigValue interface{}
+	ImpValue  interface{}
+	OrigErr   error
+	ImpErr    error
+}
+
+func (m *Mismatch) Error() string {
+	switch {
+	case (m.OrigErr == nil) != (m.ImpErr == nil):
+		return fmt.Sprintf("%s of %T: amino returns error %v but codon returns error %v",
+			m.Method, m.Object, m.OrigErr, m.ImpErr)
+	case m.OrigValue != nil || m.ImpValue != nil:
+		return fmt.Sprintf("%s of %T: amino decodes %+v but codon decodes %+v from %X",
+			m.Method, m.Object, m.OrigValue, m.ImpValue, m.OrigBytes)
+	default:
+		return fmt.Sprintf("%s of %T: amino encodes %X but codon encodes %X",
+			m.Method, m.Object, m.OrigBytes, m.ImpBytes)
+	}
+}
+
+// EnableVerification runs codon in shadow mode: every Marshal/Unmarshal call goes through both codon
+// and the original amino, and the results of the original amino are returned. When the results are different,
+// the Mismatch is passed to reporter (if it is not nil), and then it is panicked in the strict mode.
+// A panic inside codon is reported as an error of codon. The types which codon's JSON does not support
+// (ErrUnsupportedType) are not compared.
+//
+// codon writes the members with default values, which amino omits, so the encoded bytes are regarded as
+// matched if amino decodes them to the same value.
+func (cdc *Codec) EnableVerification(reporter func(*Mismatch), strict bool) *Codec {
+	cdc.verify = true
+	cdc.reporter = reporter
+	cdc.strict = strict
+	return cdc
+}
+
+func (cdc *Codec) verifying() bool {
+	return cdc.verify && !cdc.onlyOrig
+}
+
+func (cdc *Codec) report(m *Mismatch) {
+	if cdc.reporter != nil {
+		cdc.reporter(m)
+	}
+	if cdc.strict {
+		panic(m)
+	}
+}
+
+// calls f and turns its panic into an error
+func callImp(f func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return f()
+}
+
+// returns a new pointer to the type which ptr points to
+func newPtrLike(ptr interface{}) interface{} {
+	return reflect.New(reflect.TypeOf(ptr).Elem()).Interface()
+}
+
+// returns a new pointer to o's type, after dereferencing
+func newPtrTo(o interface{}) interface{} {
+	t := reflect.TypeOf(o)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return reflect.New(t).Interface()
+}
+
+// returns the value which ptr points to. When ptr points to an interface, the pointer held in
+// the interface is dereferenced, because amino stores a struct value there but codon stores a
+// pointer to the struct.
+func derefIfc(ptr interface{}) interface{} {
+	v := reflect.ValueOf(ptr).Elem()
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+		for v.Kind() == reflect.Ptr && !v.IsNil() {
+			v = v.Elem()
+		}
+	}
+	return v.Interface()
+}
+
+type marshalFunc func(o interface{}) ([]byte, error)
+type unmarshalFunc func(bz []byte, ptr interface{}) error
+
+func (cdc *Codec) verifyMarshal(method string, o interface{}, orig, imp marshalFunc, decode unmarshalFunc) ([]byte, error) {
+	origBz, origErr := orig(o)
+	var impBz []byte
+	impErr := callImp(func() (err error) {
+		impBz, err = imp(o)
+		return
+	})
+	if impErr == ErrUnsupportedType {
+		return origBz, origErr
+	}
+	matched := (origErr == nil) == (impErr == nil)
+	if matched && origErr == nil && !bytes.Equal(origBz, impBz) {
+		origPtr, impPtr := newPtrTo(o), newPtrTo(o)
+		matched = decode(origBz, origPtr) == nil && decode(impBz, impPtr) == nil &&
+			reflect.DeepEqual(origPtr, impPtr)
+	}
+	if !matched {
+		cdc.report(&Mismatch{Method: method, Object: o, OrigBytes: origBz, ImpBytes: impBz,
+			OrigErr: origErr, ImpErr: impErr})
+	}
+	return origBz, origErr
+}
+
+func (cdc *Codec) verifyUnmarshal(method string, bz []byte, ptr interface{}, orig, imp unmarshalFunc) error {
+	origErr := orig(bz, ptr)
+	impPtr := newPtrLike(ptr)
+	impErr := callImp(func() error {
+		return imp(bz, impPtr)
+	})
+	if impErr == ErrUnsupportedType {
+		return origErr
+	}
+	origValue := reflect.ValueOf(ptr).Elem().Interface()
+	impValue := reflect.ValueOf(impPtr).Elem().Interface()
+	if (origErr == nil) != (impErr == nil) {
+		cdc.report(&Mismatch{Method: method, Object: ptr, OrigBytes: bz, ImpBytes: bz,
+			OrigErr: origErr, ImpErr: impErr})
+	} else if origErr == nil && !reflect.DeepEqual(derefIfc(ptr), derefIfc(impPtr)) {
+		cdc.report(&Mismatch{Method: method, Object: ptr, OrigBytes: bz, ImpBytes: bz,
+			OrigValue: origValue, ImpValue: impValue})
+	}
+	return origErr
+}
+
+// the bytes read by the original amino are decoded by codon again. If the original amino fails to read,
+// nothing is compared.
+func (cdc *Codec) verifyUnmarshalReader(r io.Reader, ptr interface{}, maxSize int64) (int64, error) {
+	var buf bytes.Buffer
+	n, err := cdc.cdc.UnmarshalBinaryLengthPrefixedReader(io.TeeReader(r, &buf), ptr, maxSize)
+	if err != nil {
+		return n, err
+	}
+	origDecode := func(bz []byte, p interface{}) error {
+		return nil // ptr is decoded already
+	}
+	return n, cdc.verifyUnmarshal("UnmarshalBinaryLengthPrefixedReader", buf.Bytes(), ptr, origDecode,
+		cdc.imp.UnmarshalBinaryLengthPrefixed)
+}
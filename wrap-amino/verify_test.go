@@ -0,0 +1,91 @@
+package amino
+
+import (
+	"testing"
+
+	aminoOrig "github.com/tendermint/go-amino"
+)
+
+type msg interface {
+	Route() string
+}
+
+type msgB struct {
+	Amount int64
+	Memo   string
+}
+
+func (m msgB) Route() string { return "b" }
+
+// ptrImp behaves like codon, which stores a pointer to the struct in an interface
+type ptrImp struct {
+	*aminoOrig.Codec
+}
+
+func (imp ptrImp) UnmarshalBinaryBare(bz []byte, ptr interface{}) error {
+	if err := imp.Codec.UnmarshalBinaryBare(bz, ptr); err != nil {
+		return err
+	}
+	if p, ok := ptr.(*msg); ok {
+		if m, ok := (*p).(msgB); ok {
+			*p = &m
+		}
+	}
+	return nil
+}
+
+func newVerifyingCodec(reporter func(*Mismatch)) *Codec {
+	cdc := &Codec{imp: ptrImp{aminoOrig.NewCodec()}, cdc: aminoOrig.NewCodec()}
+	cdc.RegisterInterface((*msg)(nil), nil)
+	cdc.RegisterConcrete(msgB{}, "test/msgB", nil)
+	return cdc.EnableVerification(reporter, false)
+}
+
+func TestVerifyUnmarshalIntoInterface(t *testing.T) {
+	var mismatches []*Mismatch
+	cdc := newVerifyingCodec(func(m *Mismatch) { mismatches = append(mismatches, m) })
+	bz, err := cdc.MarshalBinaryBare(msg(msgB{Amount: 7, Memo: "memo"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m msg
+	if err := cdc.UnmarshalBinaryBare(bz, &m); err != nil {
+		t.Fatal(err)
+	}
+	if m != (msgB{Amount: 7, Memo: "memo"}) {
+		t.Errorf("amino's result is not returned: %#v", m)
+	}
+	if len(mismatches) != 0 {
+		t.Errorf("unexpected mismatch: %v", mismatches[0])
+	}
+}
+
+func TestVerifyUnmarshalReportsMismatch(t *testing.T) {
+	var mismatches []*Mismatch
+	cdc := newVerifyingCodec(func(m *Mismatch) { mismatches = append(mismatches, m) })
+	bz, err := cdc.MarshalBinaryBare(msg(msgB{Amount: 7}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cdc.imp = wrongImp{cdc.imp}
+	var m msg
+	if err := cdc.UnmarshalBinaryBare(bz, &m); err != nil {
+		t.Fatal(err)
+	}
+	if len(mismatches) != 1 || mismatches[0].Method != "UnmarshalBinaryBare" {
+		t.Errorf("the mismatch is not reported: %v", mismatches)
+	}
+}
+
+// wrongImp changes the decoded value
+type wrongImp struct {
+	CodecIfc
+}
+
+func (imp wrongImp) UnmarshalBinaryBare(bz []byte, ptr interface{}) error {
+	if err := imp.CodecIfc.UnmarshalBinaryBare(bz, ptr); err != nil {
+		return err
+	}
+	*ptr.(*msg) = &msgB{Amount: 8}
+	return nil
+}